@@ -3,9 +3,14 @@ package ante
 import (
 	errorsmod "cosmossdk.io/errors"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
 // NewAnteHandler returns an ante handler responsible for attempting to route an
@@ -31,6 +36,11 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				case "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
 					anteHandler = NewCosmosAnteHandler(options)
+				case feeabstractiontypes.ExtensionOptionPreferredFeeTokenTypeURL,
+					feeabstractiontypes.ExtensionOptionFeeSponsorTypeURL,
+					feeabstractiontypes.ExtensionOptionERC20PermitTypeURL:
+					// cosmos-sdk tx with a fee abstraction extension
+					anteHandler = NewCosmosAnteHandler(options)
 				default:
 					return ctx, errorsmod.Wrapf(
 						errortypes.ErrUnknownExtensionOptions,
@@ -53,3 +63,12 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		return anteHandler(ctx, tx, sim)
 	}
 }
+
+// HasSupportedExtensionOption checks if the extension option is supported on Cosmos txs
+// Supported options are the dynamic fee, the preferred fee token, the fee sponsor and the ERC20 permit options
+func HasSupportedExtensionOption(any *codectypes.Any) bool {
+	return cosmosevmtypes.HasDynamicFeeExtensionOption(any) ||
+		feeabstractiontypes.HasPreferredFeeTokenExtensionOption(any) ||
+		feeabstractiontypes.HasFeeSponsorExtensionOption(any) ||
+		feeabstractiontypes.HasERC20PermitExtensionOption(any)
+}
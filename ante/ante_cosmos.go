@@ -61,6 +61,7 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				options.TxFeeChecker,
 			),
 			options.OracleKeeper,
+			options.FeeAbstractionKeeper,
 		)
 
 		// Add to the ante decorators
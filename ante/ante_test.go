@@ -0,0 +1,136 @@
+package ante_test
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/app/helpers"
+	"github.com/kiichain/kiichain/v5/app/params"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestAnteHandlerExtensionOptions tests the routing of the fee abstraction extension options
+// The txs are sent through the app ante handler, built with NewAnteHandler
+func TestAnteHandlerExtensionOptions(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+	anteHandler := app.AnteHandler()
+
+	// Create the signer account and the sponsor contract
+	privKey := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, signer))
+	contract := apptesting.RandomAccountAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", 875000000000000))
+
+	// The msg calling the sponsor contract
+	execMsg := &wasmtypes.MsgExecuteContract{Sender: signer.String(), Contract: contract.String(), Msg: []byte("{}")}
+
+	// Set the different test cases
+	testCases := []struct {
+		name        string
+		extOpt      func() (*codectypes.Any, error)
+		errIs       error
+		errContains string
+		check       func(sdk.Context)
+	}{
+		{
+			name: "success - fee sponsor option",
+			extOpt: func() (*codectypes.Any, error) {
+				return codectypes.NewAnyWithValue(&feeabstractiontypes.ExtensionOptionFeeSponsor{Sponsor: contract.String()})
+			},
+			check: func(ctx sdk.Context) {
+				// The sponsor paid the fee
+				sponsor, err := app.FeeAbstractionKeeper.GetSponsor(ctx, contract)
+				require.NoError(t, err)
+				require.True(t, sponsor.Balance.IsZero())
+				require.Equal(t, fee.String(), app.BankKeeper.GetAllBalances(ctx, signer).String())
+			},
+		},
+		{
+			name: "success - ERC20 permit option",
+			extOpt: func() (*codectypes.Any, error) {
+				permit := feeabstractiontypes.NewERC20Permit(common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"), math.OneInt(), 0, 27, [32]byte{}, [32]byte{})
+				return codectypes.NewAnyWithValue(permit)
+			},
+			check: func(ctx sdk.Context) {
+				// The signer paid the fee on the native denom
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, signer).IsZero())
+			},
+		},
+		{
+			name: "fail - invalid ERC20 permit option",
+			extOpt: func() (*codectypes.Any, error) {
+				permit := feeabstractiontypes.NewERC20Permit(common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"), math.ZeroInt(), 0, 27, [32]byte{}, [32]byte{})
+				return codectypes.NewAnyWithValue(permit)
+			},
+			errIs:       errortypes.ErrTxDecode,
+			errContains: "value must be positive",
+		},
+		{
+			name: "fail - unsupported extension option",
+			extOpt: func() (*codectypes.Any, error) {
+				return codectypes.NewAnyWithValue(&banktypes.MsgSend{})
+			},
+			errIs:       errortypes.ErrUnknownExtensionOptions,
+			errContains: "rejecting tx with unsupported extension option",
+		},
+	}
+
+	// Iterate and run the tests
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// Fund the signer and register the sponsor with the same balance
+			require.NoError(t, app.BankKeeper.MintCoins(cachedCtx, evmtypes.ModuleName, fee.Add(fee...)))
+			require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(cachedCtx, evmtypes.ModuleName, signer, fee))
+			require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(cachedCtx, evmtypes.ModuleName, feeabstractiontypes.ModuleName, fee))
+			policy := feeabstractiontypes.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+			sponsor := feeabstractiontypes.NewSponsor(contract.String(), contract.String(), policy)
+			sponsor.Balance = fee
+			require.NoError(t, app.FeeAbstractionKeeper.Sponsors.Set(cachedCtx, contract, sponsor))
+
+			// Build the tx with the extension option as the first option
+			extOpt, err := tc.extOpt()
+			require.NoError(t, err)
+			tx, err := helpers.BuildTxFromMsgsWithExtensionOptions(signer, nil, fee, 1000000, []*codectypes.Any{extOpt}, execMsg)
+			require.NoError(t, err)
+
+			// Set an empty signature, the signature is not verified on simulations
+			txBuilder, err := params.MakeEncodingConfig().TxConfig.WrapTxBuilder(tx)
+			require.NoError(t, err)
+			require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey:   privKey.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: 0,
+			}))
+
+			// Call the ante handler
+			_, err = anteHandler(cachedCtx, txBuilder.GetTx(), true)
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(cachedCtx)
+		})
+	}
+}
@@ -2,12 +2,12 @@ package ante
 
 import (
 	"errors"
-	"math"
 
 	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	antetypes "github.com/kiichain/kiichain/v5/ante/types"
 	oraclekeeper "github.com/kiichain/kiichain/v5/x/oracle/keeper"
 	oracletypes "github.com/kiichain/kiichain/v5/x/oracle/types"
 )
@@ -18,16 +18,19 @@ type FeelessDecorator struct {
 	feeDecorator sdk.AnteDecorator
 	// oracleKeeper is one of the modules that has feeless transactions
 	oracleKeeper *oraclekeeper.Keeper
+	// feeAbstractionKeeper defines the priority given to feeless transactions
+	feeAbstractionKeeper antetypes.FeeAbstractionKeeper
 }
 
 // Type assertion for the FeelessDecorator
 var _ sdk.AnteDecorator = FeelessDecorator{}
 
 // NewFeelessDecorator creates a new FeelessDecorator
-func NewFeelessDecorator(feeDecorator sdk.AnteDecorator, oracleKeeper *oraclekeeper.Keeper) FeelessDecorator {
+func NewFeelessDecorator(feeDecorator sdk.AnteDecorator, oracleKeeper *oraclekeeper.Keeper, feeAbstractionKeeper antetypes.FeeAbstractionKeeper) FeelessDecorator {
 	return FeelessDecorator{
-		feeDecorator: feeDecorator,
-		oracleKeeper: oracleKeeper,
+		feeDecorator:         feeDecorator,
+		oracleKeeper:         oracleKeeper,
+		feeAbstractionKeeper: feeAbstractionKeeper,
 	}
 }
 
@@ -41,9 +44,13 @@ func (gd FeelessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 		return ctx, err
 	}
 
-	// If feeless, ignore fee deduction
+	// If feeless, ignore fee deduction and use the feeless priority
 	if isFeeless {
-		ctx = ctx.WithPriority(math.MaxInt64)
+		priority, err := gd.feeAbstractionKeeper.GetFeelessPriority(ctx)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = ctx.WithPriority(priority)
 		return next(ctx, tx, simulate)
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/kiichain/kiichain/v5/ante"
 	"github.com/kiichain/kiichain/v5/app/apptesting"
 	"github.com/kiichain/kiichain/v5/app/helpers"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 	oracletypes "github.com/kiichain/kiichain/v5/x/oracle/types"
 )
 
@@ -58,6 +59,7 @@ func TestFeelessDecorator(t *testing.T) {
 			nil,
 		),
 		&app.OracleKeeper,
+		app.FeeAbstractionKeeper,
 	)
 
 	// Wrap into the sdk ante decorator
@@ -199,9 +201,14 @@ func TestFeelessDecorator(t *testing.T) {
 			}
 
 			// Execute the ante handler
-			_, err = anteHandler(cachedCtx, tx, false)
+			newCtx, err := anteHandler(cachedCtx, tx, false)
 			require.NoError(t, err)
 
+			// Feeless txs get the feeless priority
+			if tc.balanceDiff.IsZero() {
+				require.Equal(t, feeabstractiontypes.DefaultFeelessPriority, newCtx.Priority())
+			}
+
 			// Check the balance difference, since we are using the same denom we can just subtract the amounts
 			balanceAfter := app.BankKeeper.GetBalance(cachedCtx, funder, "stake")
 			balanceDiff := balanceBefore.Amount.Sub(balanceAfter.Amount)
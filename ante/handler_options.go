@@ -33,7 +33,7 @@ type HandlerOptions struct {
 	FeeMarketKeeper        anteinterfaces.FeeMarketKeeper
 	EvmKeeper              anteinterfaces.EVMKeeper
 	FeeAbstractionKeeper   antetypes.FeeAbstractionKeeper
-	FeegrantKeeper         ante.FeegrantKeeper
+	FeegrantKeeper         antetypes.FeegrantKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
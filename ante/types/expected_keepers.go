@@ -1,10 +1,30 @@
 package types
 
 import (
+	"context"
+
+	"cosmossdk.io/x/feegrant"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // FeeAbstractionKeeper defines the required interface for the Fee Abstraction module
 type FeeAbstractionKeeper interface {
 	ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error)
+	ConvertNativeFeeWithPreference(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins, preferredDenom string) (sdk.Coins, error)
+	ConvertNativeFeeWithFeeToken(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins, denom string) (sdk.Coins, error)
+	GetBankFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error)
+	IsSponsor(ctx sdk.Context, contract sdk.AccAddress) (bool, error)
+	ChargeSponsor(ctx sdk.Context, contract, user sdk.AccAddress, msgs []sdk.Msg, fees sdk.Coins, gasLimit uint64) (sdk.Coins, error)
+	GetFeePriority(ctx sdk.Context, priority int64, nativeFees, chargedFees sdk.Coins, gas uint64) (int64, error)
+	GetFeelessPriority(ctx sdk.Context) (int64, error)
+	SetChargedFee(ctx sdk.Context, account sdk.AccAddress, nativeFees, chargedFees sdk.Coins) error
+	SetSponsoredFee(ctx sdk.Context, account, sponsor sdk.AccAddress, nativeFees, chargedFees sdk.Coins) error
+}
+
+// FeegrantKeeper defines the required interface for the Feegrant module
+// The allowance is read to find the fee token it is spent on
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 }
@@ -62,13 +62,16 @@ import (
 	cosmosevmante "github.com/cosmos/evm/ante/evm"
 	evmencoding "github.com/cosmos/evm/encoding"
 	srvflags "github.com/cosmos/evm/server/flags"
-	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	kiiante "github.com/kiichain/kiichain/v5/ante"
 	"github.com/kiichain/kiichain/v5/app/keepers"
+	"github.com/kiichain/kiichain/v5/app/params"
 	"github.com/kiichain/kiichain/v5/app/upgrades"
 	v5_0 "github.com/kiichain/kiichain/v5/app/upgrades/v5_0"
+	v6_0 "github.com/kiichain/kiichain/v5/app/upgrades/v6_0"
 	"github.com/kiichain/kiichain/v5/client/docs"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+	rewardspost "github.com/kiichain/kiichain/v5/x/rewards/post"
 )
 
 var (
@@ -78,6 +81,7 @@ var (
 	// Upgrades is a list of all the upgrades that are available for the application.
 	Upgrades = []upgrades.Upgrade{
 		v5_0.Upgrade,
+		v6_0.Upgrade,
 	}
 )
 
@@ -274,6 +278,9 @@ func NewKiichainApp(
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 	app.setAnteHandler(app.txConfig, maxGasWanted, appOpts)
 
+	// Set the post handler
+	app.setPostHandler()
+
 	if manager := app.SnapshotManager(); manager != nil {
 		err = manager.RegisterExtensions(wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.AppKeepers.WasmKeeper))
 		if err != nil {
@@ -323,7 +330,7 @@ func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 		Cdc:                    app.appCodec,
 		AccountKeeper:          &app.AccountKeeper,
 		BankKeeper:             app.BankKeeper,
-		ExtensionOptionChecker: cosmosevmtypes.HasDynamicFeeExtensionOption,
+		ExtensionOptionChecker: kiiante.HasSupportedExtensionOption,
 		EvmKeeper:              app.EVMKeeper,
 		FeeAbstractionKeeper:   app.FeeAbstractionKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
@@ -345,6 +352,14 @@ func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 	app.SetAnteHandler(kiiante.NewAnteHandler(options))
 }
 
+// setPostHandler sets the post handler on the app
+func (app *KiichainApp) setPostHandler() {
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		// Record the fees paid for the contracts for the developer revenue
+		rewardspost.NewRevenueDecorator(app.RewardsKeeper, params.BaseDenom),
+	))
+}
+
 // Name returns the name of the App
 func (app *KiichainApp) Name() string { return app.BaseApp.Name() }
 
@@ -402,6 +417,8 @@ func (app *KiichainApp) ModuleAccountAddrs() map[string]bool {
 func (app *KiichainApp) BlockedModuleAccountAddrs(modAccAddrs map[string]bool) map[string]bool {
 	// remove module accounts that are ALLOWED to received funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// The fee abstraction reserve is funded by governance through community pool spends
+	delete(modAccAddrs, authtypes.NewModuleAddress(feeabstractiontypes.ReserveName).String())
 
 	return modAccAddrs
 }
@@ -16,6 +16,7 @@ import (
 
 	kiichain "github.com/kiichain/kiichain/v5/app"
 	kiihelpers "github.com/kiichain/kiichain/v5/app/helpers"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
 type EmptyAppOptions struct{}
@@ -43,6 +44,7 @@ func TestKiichainApp_BlockedModuleAccountAddrs(t *testing.T) {
 	blockedAddrs := app.BlockedModuleAccountAddrs(moduleAccountAddresses)
 
 	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(feeabstractiontypes.ReserveName).String())
 }
 
 func TestKiichainApp_Export(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -245,6 +246,11 @@ func SetupWithContext(t *testing.T) (*kiichain.KiichainApp, sdk.Context) {
 
 // BuildTxFromMsgs builds a tx from a set of messages
 func BuildTxFromMsgs(feePayer sdk.AccAddress, feeGranter sdk.AccAddress, fee sdk.Coins, gasLimit uint64, msgs ...sdk.Msg) (xauthsigning.Tx, error) {
+	return BuildTxFromMsgsWithExtensionOptions(feePayer, feeGranter, fee, gasLimit, nil, msgs...)
+}
+
+// BuildTxFromMsgsWithExtensionOptions builds a tx from a set of messages and extension options
+func BuildTxFromMsgsWithExtensionOptions(feePayer sdk.AccAddress, feeGranter sdk.AccAddress, fee sdk.Coins, gasLimit uint64, extOpts []*codectypes.Any, msgs ...sdk.Msg) (xauthsigning.Tx, error) {
 	// Start the tx builder
 	encodingConfig := params.MakeEncodingConfig()
 	txBuilder := encodingConfig.TxConfig.NewTxBuilder()
@@ -263,5 +269,14 @@ func BuildTxFromMsgs(feePayer sdk.AccAddress, feeGranter sdk.AccAddress, fee sdk
 	txBuilder.SetGasLimit(gasLimit)
 	txBuilder.SetFeeAmount(fee)
 
+	// Set the extension options
+	if len(extOpts) > 0 {
+		extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+		if !ok {
+			return nil, fmt.Errorf("tx builder does not support extension options")
+		}
+		extBuilder.SetExtensionOptions(extOpts...)
+	}
+
 	return txBuilder.GetTx(), nil
 }
@@ -377,7 +377,11 @@ func NewAppKeeper(
 	appKeepers.RewardsKeeper = rewardskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[rewardstypes.StoreKey]),
+		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
+		&appKeepers.FeeAbstractionKeeper, // The fee abstraction keeper is created after, only its pointer is used
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
 	)
@@ -397,7 +401,8 @@ func NewAppKeeper(
 		appCodec, appKeepers.keys[evmtypes.StoreKey], appKeepers.tkeys[evmtypes.TransientKey], appKeepers.keys,
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		appKeepers.AccountKeeper,
-		appKeepers.BankKeeper,
+		// The unused gas is refunded on the fee token charged for the tx
+		feeabstractionkeeper.NewRefundBankKeeper(appKeepers.BankKeeper, &appKeepers.FeeAbstractionKeeper),
 		appKeepers.StakingKeeper,
 		appKeepers.FeeMarketKeeper,
 		&appKeepers.ConsensusParamsKeeper,
@@ -448,26 +453,34 @@ func NewAppKeeper(
 		tokenFactoryCapabilities,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	wasmOpts = append(
-		wasmOpts,
-		wasmbinding.RegisterCustomPlugins(
-			appKeepers.BankKeeper,
-			&appKeepers.TokenFactoryKeeper,
-			appKeepers.EVMKeeper,
-			appKeepers.OracleKeeper,
-		)...,
-	)
-
 	// FeeAbstractionKeeper must be created after EVMKeeper and Erc20Keeper
+	// and before the wasm bindings, since its queries are exposed to contracts
 	appKeepers.FeeAbstractionKeeper = feeabstractionkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[feeabstractiontypes.StoreKey]),
+		runtime.NewTransientStoreService(appKeepers.tkeys[feeabstractiontypes.TStoreKey]),
 		appKeepers.Erc20Keeper,
 		appKeepers.BankKeeper,
 		appKeepers.OracleKeeper,
+		appKeepers.FeeMarketKeeper,
+		appKeepers.EVMKeeper,
+		&appKeepers.WasmKeeper, // The wasm keeper is created after, only its pointer is used
+		appKeepers.DistrKeeper,
+		appKeepers.TransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	wasmOpts = append(
+		wasmOpts,
+		wasmbinding.RegisterCustomPlugins(
+			appKeepers.BankKeeper,
+			&appKeepers.TokenFactoryKeeper,
+			appKeepers.EVMKeeper,
+			appKeepers.OracleKeeper,
+			appKeepers.FeeAbstractionKeeper,
+		)...,
+	)
+
 	// Must be called on PFMRouter AFTER TransferKeeper initialized
 	appKeepers.PFMRouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
@@ -585,6 +598,8 @@ func NewAppKeeper(
 		appKeepers.EvidenceKeeper,
 		appKeepers.WasmKeeper,
 		appKeepers.OracleKeeper,
+		appKeepers.FeeAbstractionKeeper,
+		appKeepers.RewardsKeeper,
 		appCodec,
 	)
 	appKeepers.EVMKeeper.WithStaticPrecompiles(
@@ -77,6 +77,8 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		// EVM keys
 		evmtypes.TransientKey,
 		feemarkettypes.TransientKey,
+		// Custom modules
+		feeabstractiontypes.TStoreKey,
 	)
 }
 
@@ -37,9 +37,13 @@ import (
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 
+	"github.com/kiichain/kiichain/v5/precompiles/feeabstraction"
 	"github.com/kiichain/kiichain/v5/precompiles/ibc"
 	"github.com/kiichain/kiichain/v5/precompiles/oracle"
+	"github.com/kiichain/kiichain/v5/precompiles/rewards"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v5/x/oracle/keeper"
+	rewardskeeper "github.com/kiichain/kiichain/v5/x/rewards/keeper"
 )
 
 // Optionals define some optional params that can be applied to _some_ precompiles.
@@ -104,6 +108,8 @@ func NewAvailableStaticPrecompiles(
 	evidenceKeeper evidencekeeper.Keeper,
 	wasmdKeeper wasmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	feeAbstractionKeeper feeabstractionkeeper.Keeper,
+	rewardsKeeper rewardskeeper.Keeper,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -184,6 +190,18 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate oracle precompile: %w", err))
 	}
 
+	// Prepare the fee abstraction precompile
+	feeAbstractionPrecompile, err := feeabstraction.NewPrecompile(feeAbstractionKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate fee abstraction precompile: %w", err))
+	}
+
+	// Prepare the rewards precompile
+	rewardsPrecompile, err := rewards.NewPrecompile(rewardsKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate rewards precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -197,6 +215,8 @@ func NewAvailableStaticPrecompiles(
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
 	precompiles[ibcPrecompile.Address()] = ibcPrecompile
 	precompiles[oraclePrecompile.Address()] = oraclePrecompile
+	precompiles[feeAbstractionPrecompile.Address()] = feeAbstractionPrecompile
+	precompiles[rewardsPrecompile.Address()] = rewardsPrecompile
 
 	// Return the precompiles
 	return precompiles
@@ -80,10 +80,12 @@ var maccPerms = map[string][]string{
 	feemarkettypes.ModuleName: nil,                                  // Fee market doesn't need permissions
 	erc20types.ModuleName:     {authtypes.Minter, authtypes.Burner}, // Allows erc20 module to mint/burn for token pairs
 	// Custom modules
-	tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
-	rewardstypes.ModuleName:        nil,
-	oracletypes.ModuleName:         nil,
-	feeabstractiontypes.ModuleName: nil,
+	tokenfactorytypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+	rewardstypes.ModuleName:          nil,
+	oracletypes.ModuleName:           nil,
+	feeabstractiontypes.ModuleName:   {authtypes.Burner},
+	feeabstractiontypes.TreasuryName: nil,
+	feeabstractiontypes.ReserveName:  nil,
 }
 
 func appModules(
@@ -113,7 +115,7 @@ func appModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
-		rewards.NewAppModule(app.RewardsKeeper, app.BankKeeper),
+		rewards.NewAppModule(app.RewardsKeeper, app.AccountKeeper, app.BankKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		sdkparams.NewAppModule(app.ParamsKeeper), //nolint:staticcheck
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
@@ -169,6 +171,8 @@ func simulationModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		app.TransferModule,
 		app.ICAModule,
+		// The rewards genesis funds the module account on the bank genesis, so it goes after the bank
+		rewards.NewAppModule(app.RewardsKeeper, app.AccountKeeper, app.BankKeeper),
 	}
 }
 
@@ -208,6 +212,7 @@ func orderBeginBlockers() []string {
 		wasmtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		oracletypes.ModuleName,
+		// Fee token prices are calculated after the oracle
 		feeabstractiontypes.ModuleName,
 	}
 }
@@ -243,6 +248,7 @@ func orderEndBlockers() []string {
 		wasmtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		oracletypes.ModuleName,
+		// Fee revenue is routed at the end of the block, before distribution runs on the next block
 		feeabstractiontypes.ModuleName,
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulation2 "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
@@ -27,6 +28,7 @@ import (
 	"github.com/kiichain/kiichain/v5/ante"
 	kiichain "github.com/kiichain/kiichain/v5/app"
 	"github.com/kiichain/kiichain/v5/app/sim"
+	rewardskeeper "github.com/kiichain/kiichain/v5/x/rewards/keeper"
 )
 
 // AppChainID hardcoded chainID for simulation
@@ -42,6 +44,28 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// withRewardsInvariants wraps the operations to assert the rewards invariants after each of them
+// There is no crisis module, so the invariants are checked on every simulated block this way
+func withRewardsInvariants(app *kiichain.KiichainApp, ops []simulation2.WeightedOperation) []simulation2.WeightedOperation {
+	wrapped := make([]simulation2.WeightedOperation, len(ops))
+	for i, op := range ops {
+		wrapped[i] = simulation.NewWeightedOperation(op.Weight(), func(
+			r *rand.Rand, baseApp *baseapp.BaseApp, ctx sdk.Context, accs []simulation2.Account, chainID string,
+		) (simulation2.OperationMsg, []simulation2.FutureOperation, error) {
+			opMsg, futureOps, err := op.Op()(r, baseApp, ctx, accs, chainID)
+			if err != nil {
+				return opMsg, futureOps, err
+			}
+
+			if msg, broken := rewardskeeper.AllInvariants(app.RewardsKeeper)(ctx); broken {
+				return opMsg, futureOps, fmt.Errorf("rewards invariant broken after %s at height %d: %s", opMsg.Route, ctx.BlockHeight(), msg)
+			}
+			return opMsg, futureOps, nil
+		})
+	}
+	return wrapped
+}
+
 // TODO: Make another test for the fuzzer itself, which just has noOp txs
 // and doesn't depend on the application.
 func TestAppStateDeterminism(t *testing.T) {
@@ -120,13 +144,17 @@ func TestAppStateDeterminism(t *testing.T) {
 				app.BaseApp,
 				simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.ModuleBasics.DefaultGenesis(app.AppCodec())),
 				simulation2.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
-				simtestutil.SimulationOperations(app, app.AppCodec(), config),
+				withRewardsInvariants(app, simtestutil.SimulationOperations(app, app.AppCodec(), config)),
 				blockedAddresses,
 				config,
 				app.AppCodec(),
 			)
 			require.NoError(t, err)
 
+			// Check the rewards pool accounting didn't drift from the bank balances after the last block
+			msg, broken := rewardskeeper.AllInvariants(app.RewardsKeeper)(app.NewContext(true))
+			require.False(t, broken, msg)
+
 			if config.Commit {
 				sim.PrintStats(db)
 			}
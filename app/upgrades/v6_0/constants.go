@@ -0,0 +1,20 @@
+package v600
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/kiichain/kiichain/v5/app/upgrades"
+)
+
+const (
+	// UpgradeName is the name of the upgrade
+	UpgradeName = "v6.0.0"
+)
+
+// Upgrade defines the upgrade
+// This adds the new precompiles into the precompiles list for the EVM module
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
@@ -0,0 +1,158 @@
+package v600
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/app/keepers"
+	"github.com/kiichain/kiichain/v5/app/upgrades/utils"
+	"github.com/kiichain/kiichain/v5/precompiles/feeabstraction"
+	"github.com/kiichain/kiichain/v5/precompiles/rewards"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+	rewardstypes "github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// CreateUpgradeHandler creates the upgrade handler for the v6.0.0 upgrade
+// This install the new precompiles into the precompiles list for the EVM module
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// State the context and log
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting module migrations...")
+
+		// Run the module migrations
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// Migrate the fee abstraction state
+		err = MigrateFeeAbstraction(ctx, keepers)
+		if err != nil {
+			return vm, err
+		}
+
+		// Migrate the rewards params
+		err = MigrateRewards(ctx, keepers)
+		if err != nil {
+			return vm, err
+		}
+
+		// Install the new precompiles
+		err = utils.InstallNewPrecompiles(
+			ctx,
+			keepers,
+			[]common.Address{
+				common.HexToAddress(feeabstraction.FeeAbstractionPrecompileAddress),
+				common.HexToAddress(rewards.RewardsPrecompileAddress),
+			},
+		)
+		if err != nil {
+			return vm, err
+		}
+
+		// Log the upgrade completion
+		ctx.Logger().Info("Upgrade v6.0.0 complete")
+		return vm, nil
+	}
+}
+
+// MigrateFeeAbstraction sets the new fee abstraction params and fee token fields
+// Tokens disabled by missing oracle prices are moved into the auto-suspended state
+func MigrateFeeAbstraction(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	// Set the default reenable blocks
+	params, err := keepers.FeeAbstractionKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ReenableBlocks == 0 {
+		params.ReenableBlocks = feeabstractiontypes.DefaultReenableBlocks
+	}
+	if params.FeelessPriority == 0 {
+		params.FeelessPriority = feeabstractiontypes.DefaultFeelessPriority
+	}
+	if params.MinTwapCoverage.IsNil() {
+		params.MinTwapCoverage = feeabstractiontypes.DefaultMinTwapCoverage
+	}
+	if params.StatsRetentionDays == 0 {
+		params.StatsRetentionDays = feeabstractiontypes.DefaultStatsRetentionDays
+	}
+	if err := keepers.FeeAbstractionKeeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// Get the fee tokens
+	feeTokens, err := keepers.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, token := range feeTokens.Items {
+		// Tokens disabled with a zero price were disabled by the oracle checks, since
+		// governance can't register a zero price
+		if !token.Enabled && token.Price.IsZero() {
+			feeTokens.Items[i].Enabled = true
+			feeTokens.Items[i].Suspended = true
+			feeTokens.Items[i].ValidPriceBlocks = 0
+		}
+
+		// Set the default multiplier and volume caps
+		feeTokens.Items[i].PriceMultiplier = math.LegacyOneDec()
+		feeTokens.Items[i].MaxBlockVolume = math.ZeroInt()
+		feeTokens.Items[i].MaxDailyVolume = math.ZeroInt()
+	}
+
+	if err := keepers.FeeAbstractionKeeper.FeeTokens.Set(ctx, feeTokens); err != nil {
+		return err
+	}
+
+	// Add the burner permission to the fee abstraction module account, used to burn routed fees
+	moduleAccount, ok := keepers.AccountKeeper.GetModuleAccount(ctx, feeabstractiontypes.ModuleName).(*authtypes.ModuleAccount)
+	if ok && !moduleAccount.HasPermission(authtypes.Burner) {
+		moduleAccount.Permissions = append(moduleAccount.Permissions, authtypes.Burner)
+		keepers.AccountKeeper.SetModuleAccount(ctx, moduleAccount)
+	}
+
+	return nil
+}
+
+// MigrateRewards sets the new rewards params
+// The release cap, the halt handling and the developer revenue start disabled, the single token denom becomes the allowed denoms
+func MigrateRewards(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	params, err := keepers.RewardsKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxReleasePerBlock == nil {
+		params.MaxReleasePerBlock = sdk.Coins{}
+	}
+	if params.DeveloperShare.IsNil() {
+		params.DeveloperShare = math.LegacyZeroDec()
+	}
+	if params.MaxReleaseHistory == 0 {
+		params.MaxReleaseHistory = rewardstypes.DefaultMaxReleaseHistory
+	}
+
+	// Move the single token denom to the allowed denoms
+	if len(params.AllowedDenoms) == 0 {
+		denom := params.TokenDenom //nolint:staticcheck
+		if denom == "" {
+			denom = rewardstypes.DefaultParams().AllowedDenoms[0]
+		}
+		params.AllowedDenoms = []string{denom}
+	}
+	params.TokenDenom = "" //nolint:staticcheck
+	return keepers.RewardsKeeper.Params.Set(ctx, params)
+}
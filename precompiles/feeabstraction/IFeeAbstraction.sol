@@ -0,0 +1,92 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev IFeeAbstraction contract address
+address constant FEE_ABSTRACTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001004;
+
+/// @author Kiichain Team
+/// @title Fee Abstraction Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the Fee Abstraction module
+/// @custom:address 0x0000000000000000000000000000000000001004
+interface IFeeAbstraction {
+    /// @dev This event is emitted when an account sets its preferred fee token.
+    /// @param account The account that set the preference.
+    /// @param denom The preferred fee token denom, empty if the preference was cleared.
+    event SetPreferredFeeToken(address indexed account, string denom);
+
+    /// @dev Set the fee token that is tried first when paying the caller fees
+    /// @param denom The fee token denom, an empty denom clears the preference
+    /// @return success True if the preference was set
+    function setPreferredFeeToken(
+        string memory denom
+    ) external returns (bool success);
+
+    /// @dev Get the fee token preferred by an account
+    /// @param account The account to query
+    /// @return denom The preferred fee token denom, empty if no preference is set
+    function getPreferredFeeToken(
+        address account
+    ) external view returns (string memory denom);
+
+    /// @dev Get the registered fee tokens
+    /// @return denoms The fee token denoms, in the order they are tried
+    /// @return oracleDenoms The oracle denom of each fee token
+    /// @return decimals The decimals of each fee token
+    /// @return prices The price of each fee token, as fee tokens per native token
+    /// @return active True for each fee token that is enabled and not suspended
+    function getFeeTokens()
+        external
+        view
+        returns (
+            string[] memory denoms,
+            string[] memory oracleDenoms,
+            uint32[] memory decimals,
+            string[] memory prices,
+            bool[] memory active
+        );
+
+    /// @dev Get the price of a fee token
+    /// @param denom The fee token denom
+    /// @return price The fee token price, as fee tokens per native token
+    /// @return active True if the fee token is enabled and not suspended
+    function getFeeTokenPrice(
+        string memory denom
+    ) external view returns (string memory price, bool active);
+
+    /// @dev Get the fee abstraction params
+    /// @return nativeDenom The native denom the fees are charged on
+    /// @return nativeOracleDenom The oracle denom of the native token
+    /// @return clampFactor The max price change of the fee tokens per block
+    /// @return fallbackNativePrice The native token price used without an oracle price
+    /// @return twapLookbackWindow The lookback window of the oracle TWAPs, in seconds
+    /// @return enabled True if the fee abstraction is enabled
+    function getParams()
+        external
+        view
+        returns (
+            string memory nativeDenom,
+            string memory nativeOracleDenom,
+            string memory clampFactor,
+            string memory fallbackNativePrice,
+            uint64 twapLookbackWindow,
+            bool enabled
+        );
+
+    /// @dev Estimate the fee charged for a gas limit on the fee tokens
+    /// @param gasLimit The gas limit of the transaction
+    /// @param denom The fee token denom, an empty denom estimates every enabled fee token
+    /// @return nativeFee The fee charged on the native token
+    /// @return denoms The fee token denoms
+    /// @return amounts The fee charged on each fee token
+    function estimateFee(
+        uint64 gasLimit,
+        string memory denom
+    )
+        external
+        view
+        returns (
+            uint256 nativeFee,
+            string[] memory denoms,
+            uint256[] memory amounts
+        );
+}
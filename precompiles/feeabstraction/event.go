@@ -0,0 +1,48 @@
+package feeabstraction
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const (
+	// EventTypeSetPreferredFeeToken defines the event emitted when an account sets its preferred fee token
+	EventTypeSetPreferredFeeToken = "SetPreferredFeeToken"
+)
+
+// EmitEventSetPreferredFeeToken emits the SetPreferredFeeToken event
+func (p Precompile) EmitEventSetPreferredFeeToken(ctx sdk.Context, stateDB vm.StateDB, account common.Address, denom string) (err error) {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSetPreferredFeeToken]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is the signature of the event
+	topics[0] = event.ID
+
+	// The second topic is the account address
+	topics[1], err = cmn.MakeTopic(account)
+	if err != nil {
+		return err
+	}
+
+	// Parse the data
+	dataField, err := event.Inputs.NonIndexed().Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	// Write to the stateDB
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        dataField,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package feeabstraction
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
+)
+
+const (
+	// FeeAbstractionPrecompileAddress is the address of the fee abstraction precompile
+	FeeAbstractionPrecompileAddress = "0x0000000000000000000000000000000000001004"
+)
+
+// Precompile implements the PrecompiledContract interface
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed the json abi to the binary
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the struct for the fee abstraction precompile
+type Precompile struct {
+	cmn.Precompile
+	feeAbstractionKeeper feeabstractionkeeper.Keeper
+}
+
+// LoadABI loads the ABI from the embedded file for the fee abstraction precompile
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// NewPrecompile creates a new fee abstraction precompile instance
+func NewPrecompile(
+	feeAbstractionKeeper feeabstractionkeeper.Keeper,
+) (*Precompile, error) {
+	// Load the ABI
+	abi, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the precompile
+	precompile := &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  abi,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		feeAbstractionKeeper: feeAbstractionKeeper,
+	}
+
+	// Set the address of the precompile
+	precompile.SetAddress(common.HexToAddress(FeeAbstractionPrecompileAddress))
+
+	// Return the precompile
+	return precompile, nil
+}
+
+// RequiredGas returns the required gas for the precompile
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// This is a check to avoid panic
+	if len(input) < 4 {
+		return 0
+	}
+
+	// Get the method ID from the first 4 bytes
+	methodID := input[:4]
+
+	// Get the method from the ABI
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return 0
+	}
+
+	// Get the gas required for the method
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the fee abstraction precompile
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	// Initialize the context, db and chain data
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	// Now we call the method on the fee abstraction keeper
+	switch method.Name {
+	// Transactions
+	case SetPreferredFeeTokenMethod:
+		bz, err = p.SetPreferredFeeToken(ctx, method, stateDB, args, contract.Caller())
+	// Queries
+	case GetPreferredFeeTokenMethod:
+		bz, err = p.GetPreferredFeeToken(ctx, method, args)
+	case EstimateFeeMethod:
+		bz, err = p.EstimateFee(ctx, method, args)
+	case GetFeeTokensMethod:
+		bz, err = p.GetFeeTokens(ctx, method, args)
+	case GetFeeTokenPriceMethod:
+		bz, err = p.GetFeeTokenPrice(ctx, method, args)
+	case GetParamsMethod:
+		bz, err = p.GetParams(ctx, method, args)
+	default:
+		// If default error out
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the gas cost
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the method is a transaction
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case SetPreferredFeeTokenMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns the logger for the precompile
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "feeabstraction")
+}
@@ -0,0 +1,167 @@
+package feeabstraction
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+const (
+	// GetPreferredFeeTokenMethod is the method name for the preferred fee token query
+	GetPreferredFeeTokenMethod = "getPreferredFeeToken"
+	// EstimateFeeMethod is the method name for the fee estimation query
+	EstimateFeeMethod = "estimateFee"
+	// GetFeeTokensMethod is the method name for the fee tokens query
+	GetFeeTokensMethod = "getFeeTokens"
+	// GetFeeTokenPriceMethod is the method name for the fee token price query
+	GetFeeTokenPriceMethod = "getFeeTokenPrice"
+	// GetParamsMethod is the method name for the params query
+	GetParamsMethod = "getParams"
+)
+
+// GetPreferredFeeToken queries the fee token preferred by an account
+func (p Precompile) GetPreferredFeeToken(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetPreferredFeeTokenArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.PreferredFeeToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(res.Denom)
+}
+
+// EstimateFee estimates the fee charged for a gas limit on the fee tokens
+func (p Precompile) EstimateFee(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseEstimateFeeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.EstimateFee(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the fees into denoms and amounts
+	denoms := make([]string, len(res.Fees))
+	amounts := make([]*big.Int, len(res.Fees))
+	for i, fee := range res.Fees {
+		denoms[i] = fee.Denom
+		amounts[i] = fee.Amount.BigInt()
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(res.NativeFee.Amount.BigInt(), denoms, amounts)
+}
+
+// GetFeeTokens queries the registered fee tokens
+func (p Precompile) GetFeeTokens(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Validate the arguments
+	if err := ParseNoArgs(args); err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.FeeTokens(ctx, &feeabstractiontypes.QueryFeeTokensRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the fee tokens into the output arrays
+	items := res.FeeTokens.Items
+	denoms := make([]string, len(items))
+	oracleDenoms := make([]string, len(items))
+	decimals := make([]uint32, len(items))
+	prices := make([]string, len(items))
+	active := make([]bool, len(items))
+	for i, token := range items {
+		denoms[i] = token.Denom
+		oracleDenoms[i] = token.OracleDenom
+		decimals[i] = token.Decimals
+		prices[i] = token.Price.String()
+		active[i] = token.IsActive()
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(denoms, oracleDenoms, decimals, prices, active)
+}
+
+// GetFeeTokenPrice queries the price of a fee token
+func (p Precompile) GetFeeTokenPrice(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Parse the denom
+	denom, err := ParseGetFeeTokenPriceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.FeeTokens(ctx, &feeabstractiontypes.QueryFeeTokensRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the fee token
+	token, found := res.FeeTokens.GetByDenom(denom)
+	if !found {
+		return nil, errorsmod.Wrapf(feeabstractiontypes.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(token.Price.String(), token.IsActive())
+}
+
+// GetParams queries the fee abstraction params
+func (p Precompile) GetParams(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Validate the arguments
+	if err := ParseNoArgs(args); err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.Params(ctx, &feeabstractiontypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	params := res.Params
+	return method.Outputs.Pack(
+		params.NativeDenom,
+		params.NativeOracleDenom,
+		params.ClampFactor.String(),
+		params.FallbackNativePrice.String(),
+		params.TwapLookbackWindow,
+		params.Enabled,
+	)
+}
@@ -0,0 +1,268 @@
+package feeabstraction_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	feeabstractionprecompile "github.com/kiichain/kiichain/v5/precompiles/feeabstraction"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestGetPreferredFeeToken tests the GetPreferredFeeToken method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetPreferredFeeToken() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetPreferredFeeTokenMethod]
+
+	// Store a preference for the first account
+	account := s.keyring.GetKey(0)
+	err := s.App.FeeAbstractionKeeper.PreferredFeeTokens.Set(s.Ctx, account.AccAddr, "uusdc")
+	s.Require().NoError(err)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expected    string
+		errContains string
+	}{
+		{
+			name:     "valid - account with preference",
+			args:     []any{account.Addr},
+			expected: "uusdc",
+		},
+		{
+			name:     "valid - account without preference",
+			args:     []any{s.keyring.GetKey(1).Addr},
+			expected: "",
+		},
+		{
+			name:        "invalid - invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid - invalid account type",
+			args:        []any{"account"},
+			errContains: "invalid account type",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetPreferredFeeToken(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the response
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, out[0])
+			}
+		})
+	}
+}
+
+// TestEstimateFee tests the EstimateFee method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestEstimateFee() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.EstimateFeeMethod]
+
+	// Set a base fee of 10^13 per gas, so 100k gas costs 1 KII
+	feeMarketParams := s.App.FeeMarketKeeper.GetParams(s.Ctx)
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.BaseFee = math.LegacyNewDec(10_000_000_000_000)
+	feeMarketParams.MinGasPrice = math.LegacyZeroDec()
+	s.Require().NoError(s.App.FeeMarketKeeper.SetParams(s.Ctx, feeMarketParams))
+
+	// Register a fee token with the same value as the native token
+	feeTokens := feeabstractiontypes.NewFeeTokenMetadataCollection(
+		feeabstractiontypes.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+	)
+	s.Require().NoError(s.App.FeeAbstractionKeeper.FeeTokens.Set(s.Ctx, *feeTokens))
+
+	// The expected native fee, 1 KII
+	oneKii := new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+	// Create the test cases
+	tc := []struct {
+		name            string
+		args            []any
+		expectedDenoms  []string
+		expectedAmounts []*big.Int
+		errContains     string
+	}{
+		{
+			name:            "valid - all fee tokens",
+			args:            []any{uint64(100_000), ""},
+			expectedDenoms:  []string{"uusdc"},
+			expectedAmounts: []*big.Int{big.NewInt(1_000_000)},
+		},
+		{
+			name:            "valid - single fee token",
+			args:            []any{uint64(100_000), "uusdc"},
+			expectedDenoms:  []string{"uusdc"},
+			expectedAmounts: []*big.Int{big.NewInt(1_000_000)},
+		},
+		{
+			name:        "invalid - unknown fee token",
+			args:        []any{uint64(100_000), "unknown"},
+			errContains: "unknown fee token",
+		},
+		{
+			name:        "invalid - zero gas limit",
+			args:        []any{uint64(0), ""},
+			errContains: "gas limit must be positive",
+		},
+		{
+			name:        "invalid - invalid number of arguments",
+			args:        []any{uint64(100_000)},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid - invalid gas limit type",
+			args:        []any{"100000", ""},
+			errContains: "invalid gasLimit type",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.EstimateFee(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the response
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(oneKii, out[0])
+				s.Require().Equal(tc.expectedDenoms, out[1])
+				s.Require().Equal(tc.expectedAmounts, out[2])
+			}
+		})
+	}
+}
+
+// TestGetFeeTokens tests the GetFeeTokens method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetFeeTokens() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetFeeTokensMethod]
+
+	// Register an active and a disabled fee token
+	disabled := feeabstractiontypes.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.5"))
+	disabled.Enabled = false
+	feeTokens := feeabstractiontypes.NewFeeTokenMetadataCollection(
+		feeabstractiontypes.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+		disabled,
+	)
+	s.Require().NoError(s.App.FeeAbstractionKeeper.FeeTokens.Set(s.Ctx, *feeTokens))
+
+	// Invalid arguments are rejected
+	_, err := s.Precompile.GetFeeTokens(s.Ctx, &method, []any{"uusdc"})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	// Query the fee tokens
+	res, err := s.Precompile.GetFeeTokens(s.Ctx, &method, []any{})
+	s.Require().NoError(err)
+
+	// Unpack the response
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"uusdc", "uatom"}, out[0])
+	s.Require().Equal([]string{"usdcoracle", "atomoracle"}, out[1])
+	s.Require().Equal([]uint32{6, 6}, out[2])
+	s.Require().Equal([]string{math.LegacyOneDec().String(), math.LegacyMustNewDecFromStr("0.5").String()}, out[3])
+	s.Require().Equal([]bool{true, false}, out[4])
+}
+
+// TestGetFeeTokenPrice tests the GetFeeTokenPrice method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetFeeTokenPrice() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetFeeTokenPriceMethod]
+
+	// Register a fee token
+	feeTokens := feeabstractiontypes.NewFeeTokenMetadataCollection(
+		feeabstractiontypes.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyMustNewDecFromStr("1.5")),
+	)
+	s.Require().NoError(s.App.FeeAbstractionKeeper.FeeTokens.Set(s.Ctx, *feeTokens))
+
+	// Create the test cases
+	tc := []struct {
+		name          string
+		args          []any
+		expectedPrice string
+		errContains   string
+	}{
+		{
+			name:          "valid - registered fee token",
+			args:          []any{"uusdc"},
+			expectedPrice: math.LegacyMustNewDecFromStr("1.5").String(),
+		},
+		{
+			name:        "invalid - unknown fee token",
+			args:        []any{"unknown"},
+			errContains: "unknown fee token",
+		},
+		{
+			name:        "invalid - invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid - invalid denom type",
+			args:        []any{uint64(1)},
+			errContains: "invalid denom type",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetFeeTokenPrice(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the response
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedPrice, out[0])
+				s.Require().Equal(true, out[1])
+			}
+		})
+	}
+}
+
+// TestGetParams tests the GetParams method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetParams() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetParamsMethod]
+
+	// Get the params from the keeper
+	params, err := s.App.FeeAbstractionKeeper.Params.Get(s.Ctx)
+	s.Require().NoError(err)
+
+	// Query the params
+	res, err := s.Precompile.GetParams(s.Ctx, &method, []any{})
+	s.Require().NoError(err)
+
+	// Unpack the response
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+	s.Require().Equal(params.NativeDenom, out[0])
+	s.Require().Equal(params.NativeOracleDenom, out[1])
+	s.Require().Equal(params.ClampFactor.String(), out[2])
+	s.Require().Equal(params.FallbackNativePrice.String(), out[3])
+	s.Require().Equal(params.TwapLookbackWindow, out[4])
+	s.Require().Equal(params.Enabled, out[5])
+}
@@ -0,0 +1,46 @@
+package feeabstraction
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
+)
+
+const (
+	// SetPreferredFeeTokenMethod is the method name for setting the preferred fee token
+	SetPreferredFeeTokenMethod = "setPreferredFeeToken"
+)
+
+// SetPreferredFeeToken sets the fee token preferred by the caller
+func (p Precompile) SetPreferredFeeToken(ctx sdk.Context, method *abi.Method, stateDB vm.StateDB, args []any, caller common.Address) ([]byte, error) {
+	// Build and validate the message
+	msg, err := NewMsgSetPreferredFeeToken(caller, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"sender", msg.Sender,
+		"denom", msg.Denom,
+	)
+
+	// Execute the message through the msg server
+	msgServer := feeabstractionkeeper.NewMsgServer(p.feeAbstractionKeeper)
+	if _, err := msgServer.SetPreferredFeeToken(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	if err := p.EmitEventSetPreferredFeeToken(ctx, stateDB, caller, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
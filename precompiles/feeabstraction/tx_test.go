@@ -0,0 +1,80 @@
+package feeabstraction_test
+
+import (
+	"cosmossdk.io/math"
+
+	feeabstractionprecompile "github.com/kiichain/kiichain/v5/precompiles/feeabstraction"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestSetPreferredFeeToken tests the SetPreferredFeeToken method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestSetPreferredFeeToken() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.SetPreferredFeeTokenMethod]
+
+	// Register a fee token
+	err := s.App.FeeAbstractionKeeper.FeeTokens.Set(s.Ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Get the caller
+	caller := s.keyring.GetKey(0)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expected    string
+		errContains string
+	}{
+		{
+			name:     "valid - set the preferred fee token",
+			args:     []any{"uusdc"},
+			expected: "uusdc",
+		},
+		{
+			name:     "valid - clear the preferred fee token",
+			args:     []any{""},
+			expected: "",
+		},
+		{
+			name:        "invalid - unknown fee token",
+			args:        []any{"unknown"},
+			errContains: "is not registered as a fee token",
+		},
+		{
+			name:        "invalid - invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid - invalid denom type",
+			args:        []any{1},
+			errContains: "invalid denom",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.SetPreferredFeeToken(s.Ctx, &method, s.GetStateDB(), tc.args, caller.Addr)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the response
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(true, out[0])
+
+				// Check the stored preference
+				denom, err := s.App.FeeAbstractionKeeper.GetPreferredFeeToken(s.Ctx, caller.AccAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, denom)
+			}
+		})
+	}
+}
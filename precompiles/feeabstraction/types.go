@@ -0,0 +1,106 @@
+package feeabstraction
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// NewMsgSetPreferredFeeToken builds the set preferred fee token message from the arguments
+func NewMsgSetPreferredFeeToken(caller common.Address, args []any) (*feeabstractiontypes.MsgSetPreferredFeeToken, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the denom
+	// An empty denom clears the preference
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid denom")
+	}
+
+	// Build and validate the message
+	msg := feeabstractiontypes.NewMessageSetPreferredFeeToken(sdk.AccAddress(caller.Bytes()).String(), denom)
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ParseGetPreferredFeeTokenArgs parses the arguments for the GetPreferredFeeToken method
+func ParseGetPreferredFeeTokenArgs(args []any) (*feeabstractiontypes.QueryPreferredFeeTokenRequest, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the account
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	// Create the QueryPreferredFeeTokenRequest and return
+	return &feeabstractiontypes.QueryPreferredFeeTokenRequest{
+		Address: sdk.AccAddress(account.Bytes()).String(),
+	}, nil
+}
+
+// ParseEstimateFeeArgs parses the arguments for the EstimateFee method
+func ParseEstimateFeeArgs(args []any) (*feeabstractiontypes.QueryEstimateFeeRequest, error) {
+	// Check the number of arguments, should be 2
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Parse the first arg, the gas limit
+	gasLimit, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "gasLimit", uint64(0), args[0])
+	}
+
+	// Parse the second arg, the denom
+	// An empty denom estimates all the enabled fee tokens
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	// Create the QueryEstimateFeeRequest and return
+	return &feeabstractiontypes.QueryEstimateFeeRequest{
+		GasLimit: gasLimit,
+		Denom:    denom,
+	}, nil
+}
+
+// ParseNoArgs checks that no arguments were given to a method without arguments
+func ParseNoArgs(args []any) error {
+	if len(args) != 0 {
+		return fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+	return nil
+}
+
+// ParseGetFeeTokenPriceArgs parses the arguments for the GetFeeTokenPrice method
+func ParseGetFeeTokenPriceArgs(args []any) (string, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the denom
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[0])
+	}
+
+	return denom, nil
+}
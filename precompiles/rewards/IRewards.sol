@@ -0,0 +1,46 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev IRewards contract address
+address constant REWARDS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001005;
+
+/// @author Kiichain Team
+/// @title Rewards Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the Rewards module
+/// @custom:address 0x0000000000000000000000000000000000001005
+interface IRewards {
+    /// @dev Get the rewards projected to be released on a denom by the active schedules between two times
+    /// @param denom The reward denom
+    /// @param fromTime The start of the projection as a unix timestamp, times before the current block are projected from the current block
+    /// @param toTime The end of the projection as a unix timestamp
+    /// @return amount The total amount projected to be released
+    /// @return schedules The names of the schedules releasing on the period, the main schedule has no name
+    /// @return amounts The amounts projected for each schedule
+    function getProjectedRelease(
+        string memory denom,
+        int64 fromTime,
+        int64 toTime
+    )
+        external
+        view
+        returns (
+            uint256 amount,
+            string[] memory schedules,
+            uint256[] memory amounts
+        );
+
+    /// @dev Get the staking APR estimated from the rewards projected for the next year
+    /// @return apr The estimated staking APR as a decimal string
+    /// @return annualStakingRewards The rewards projected to reach the stakers on the next year
+    /// @return totalBonded The amount of bonded tokens
+    /// @return communityTax The distribution community tax as a decimal string
+    function getEstimatedStakingAPR()
+        external
+        view
+        returns (
+            string memory apr,
+            uint256 annualStakingRewards,
+            uint256 totalBonded,
+            string memory communityTax
+        );
+}
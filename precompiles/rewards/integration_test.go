@@ -0,0 +1,66 @@
+package rewards_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	app "github.com/kiichain/kiichain/v5/app"
+	"github.com/kiichain/kiichain/v5/app/helpers"
+	rewardsprecompile "github.com/kiichain/kiichain/v5/precompiles/rewards"
+)
+
+// RewardsPrecompileTestSuite is a test suite for the rewards precompile
+type RewardsPrecompileTestSuite struct {
+	suite.Suite
+
+	// App and context
+	App     *app.KiichainApp
+	Ctx     sdk.Context
+	keyring testkeyring.Keyring
+
+	// Precompile
+	Precompile *rewardsprecompile.Precompile
+}
+
+// TestRewardsPrecompileTestSuite runs all the tests under the rewards pre-compile test suite
+func TestRewardsPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(RewardsPrecompileTestSuite))
+}
+
+// SetupTest sets up the test suite
+func (s *RewardsPrecompileTestSuite) SetupTest() {
+	// Get the test context
+	t := s.T()
+
+	// Create the app and the context
+	s.App = helpers.Setup(t)
+	s.Ctx = s.App.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Start a new keyring
+	keyring := testkeyring.New(2)
+	s.keyring = keyring
+
+	// Start the precompile
+	pc, err := rewardsprecompile.NewPrecompile(s.App.RewardsKeeper)
+	s.Require().NoError(err)
+	s.Precompile = pc
+}
+
+// GetStateDB returns the state database for the precompile
+func (s *RewardsPrecompileTestSuite) GetStateDB() *statedb.StateDB {
+	return statedb.New(
+		s.Ctx,
+		s.App.EVMKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(s.Ctx.HeaderHash())),
+	)
+}
@@ -0,0 +1,73 @@
+package rewards
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardskeeper "github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	rewardstypes "github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+const (
+	// GetProjectedReleaseMethod is the method name for the projected release query
+	GetProjectedReleaseMethod = "getProjectedRelease"
+	// GetEstimatedStakingAPRMethod is the method name for the estimated staking APR query
+	GetEstimatedStakingAPRMethod = "getEstimatedStakingAPR"
+)
+
+// GetProjectedRelease queries the rewards projected to be released on a denom between two times
+func (p Precompile) GetProjectedRelease(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetProjectedReleaseArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.ProjectedRelease(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Split the schedules into names and amounts
+	schedules := make([]string, len(res.Schedules))
+	amounts := make([]*big.Int, len(res.Schedules))
+	for i, projection := range res.Schedules {
+		schedules[i] = projection.Name
+		amounts[i] = projection.Amount.Amount.BigInt()
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(res.Amount.AmountOf(req.Denom).BigInt(), schedules, amounts)
+}
+
+// GetEstimatedStakingAPR queries the staking APR estimated from the rewards projected for the next year
+func (p Precompile) GetEstimatedStakingAPR(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Validate the arguments
+	if err := ParseNoArgs(args); err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.EstimatedStakingAPR(ctx, &rewardstypes.QueryEstimatedStakingAPRRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(
+		res.Apr.String(),
+		res.AnnualStakingRewards.Amount.BigInt(),
+		res.TotalBonded.BigInt(),
+		res.CommunityTax.String(),
+	)
+}
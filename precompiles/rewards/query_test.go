@@ -0,0 +1,142 @@
+package rewards_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	rewardsprecompile "github.com/kiichain/kiichain/v5/precompiles/rewards"
+	rewardstypes "github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// createSchedule funds the reward pool and creates a schedule releasing 1000 tokens over four hours
+func (s *RewardsPrecompileTestSuite) createSchedule() string {
+	params, err := s.App.RewardsKeeper.Params.Get(s.Ctx)
+	s.Require().NoError(err)
+	amount := sdk.NewCoin(params.AllowedDenoms[0], math.NewInt(1000))
+
+	// Fund the pool from a funded account
+	funder := s.keyring.GetKey(0).AccAddr
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, evmtypes.ModuleName, sdk.NewCoins(amount)))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, evmtypes.ModuleName, funder, sdk.NewCoins(amount)))
+	s.Require().NoError(s.App.RewardsKeeper.FundCommunityPool(s.Ctx, amount, funder))
+
+	// Create the schedule
+	now := s.Ctx.BlockTime()
+	err = s.App.RewardsKeeper.CreateSchedule(s.Ctx, "test", amount, now, now.Add(4*time.Hour), nil, rewardstypes.EmissionCurve{})
+	s.Require().NoError(err)
+	return amount.Denom
+}
+
+// TestGetProjectedRelease tests the GetProjectedRelease method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetProjectedRelease() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetProjectedReleaseMethod]
+
+	// Create a schedule
+	denom := s.createSchedule()
+	now := s.Ctx.BlockTime()
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expected    *big.Int
+		schedules   []string
+		errContains string
+	}{
+		{
+			name:      "valid - whole schedule",
+			args:      []any{denom, now.Add(-time.Hour).Unix(), now.Add(5 * time.Hour).Unix()},
+			expected:  big.NewInt(1000),
+			schedules: []string{"test"},
+		},
+		{
+			name:      "valid - other denom",
+			args:      []any{"other", now.Add(-time.Hour).Unix(), now.Add(5 * time.Hour).Unix()},
+			expected:  big.NewInt(0),
+			schedules: []string{},
+		},
+		{
+			name:      "valid - after the end",
+			args:      []any{denom, now.Add(5 * time.Hour).Unix(), now.Add(6 * time.Hour).Unix()},
+			expected:  big.NewInt(0),
+			schedules: []string{},
+		},
+		{
+			name:        "invalid - end before the start",
+			args:        []any{denom, now.Add(2 * time.Hour).Unix(), now.Add(time.Hour).Unix()},
+			errContains: "must be after the start",
+		},
+		{
+			name:        "invalid - invalid number of arguments",
+			args:        []any{denom, now.Unix()},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid - empty denom",
+			args:        []any{"", now.Unix(), now.Add(time.Hour).Unix()},
+			errContains: "denom cannot be empty",
+		},
+		{
+			name:        "invalid - invalid from time type",
+			args:        []any{denom, "now", now.Unix()},
+			errContains: "invalid fromTime type",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetProjectedRelease(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the response
+				out, err := method.Outputs.Unpack(res)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, out[0])
+				s.Require().Equal(tc.schedules, out[1])
+			}
+		})
+	}
+}
+
+// TestGetEstimatedStakingAPR tests the GetEstimatedStakingAPR method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetEstimatedStakingAPR() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetEstimatedStakingAPRMethod]
+
+	// Create a schedule released to the fee collector
+	s.createSchedule()
+
+	// Arguments are not accepted
+	_, err := s.Precompile.GetEstimatedStakingAPR(s.Ctx, &method, []any{"arg"})
+	s.Require().ErrorContains(err, "invalid number of arguments")
+
+	// Get the APR
+	res, err := s.Precompile.GetEstimatedStakingAPR(s.Ctx, &method, []any{})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(res)
+	s.Require().NoError(err)
+
+	// The whole schedule reaches the stakers after the community tax
+	communityTax, err := s.App.DistrKeeper.GetCommunityTax(s.Ctx)
+	s.Require().NoError(err)
+	totalBonded, err := s.App.StakingKeeper.TotalBondedTokens(s.Ctx)
+	s.Require().NoError(err)
+	annualRewards := math.LegacyOneDec().Sub(communityTax).MulInt64(1000).TruncateInt()
+
+	s.Require().Equal(rewardstypes.CalculateStakingAPR(annualRewards, totalBonded).String(), out[0])
+	s.Require().Equal(annualRewards.BigInt(), out[1])
+	s.Require().Equal(totalBonded.BigInt(), out[2])
+	s.Require().Equal(communityTax.String(), out[3])
+}
@@ -0,0 +1,54 @@
+package rewards
+
+import (
+	"fmt"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	rewardstypes "github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// ParseGetProjectedReleaseArgs parses the arguments for the GetProjectedRelease method
+func ParseGetProjectedReleaseArgs(args []any) (*rewardstypes.QueryProjectedReleaseRequest, error) {
+	// Check the number of arguments, should be 3
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	// Parse the first arg, the reward denom
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[0])
+	}
+	if denom == "" {
+		return nil, fmt.Errorf("denom cannot be empty")
+	}
+
+	// Parse the second arg, the start of the projection
+	fromTime, ok := args[1].(int64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "fromTime", int64(0), args[1])
+	}
+
+	// Parse the third arg, the end of the projection
+	toTime, ok := args[2].(int64)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "toTime", int64(0), args[2])
+	}
+
+	// Create the QueryProjectedReleaseRequest and return
+	return &rewardstypes.QueryProjectedReleaseRequest{
+		FromTime: time.Unix(fromTime, 0).UTC(),
+		ToTime:   time.Unix(toTime, 0).UTC(),
+		Denom:    denom,
+	}, nil
+}
+
+// ParseNoArgs checks that no arguments were given to a method without arguments
+func ParseNoArgs(args []any) error {
+	if len(args) != 0 {
+		return fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+	return nil
+}
@@ -190,6 +190,8 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, de
 		"0x0000000000000000000000000000000000000806",
 		"0x0000000000000000000000000000000000001002",
 		"0x0000000000000000000000000000000000001003",
+		"0x0000000000000000000000000000000000001004",
+		"0x0000000000000000000000000000000000001005",
 	}
 
 	evmGenStateBz, err := cdc.MarshalJSON(evmGenesisState)
@@ -0,0 +1,78 @@
+package feeabstraction
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction/types"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// QueryPlugin is the query plugin object for the fee abstraction queries
+type QueryPlugin struct {
+	feeAbstractionKeeper  feeabstractionkeeper.Keeper
+	feeAbstractionQuerier feeabstractionkeeper.Querier
+}
+
+// NewQueryPlugin returns a new query plugin
+func NewQueryPlugin(feeAbstractionKeeper feeabstractionkeeper.Keeper) *QueryPlugin {
+	// Start the querier
+	feeAbstractionQuerier := feeabstractionkeeper.NewQuerier(feeAbstractionKeeper)
+
+	// Return the query plugin
+	return &QueryPlugin{
+		feeAbstractionKeeper:  feeAbstractionKeeper,
+		feeAbstractionQuerier: feeAbstractionQuerier,
+	}
+}
+
+// HandleFeeAbstractionQuery is a custom querier for the fee abstraction module
+func (qp *QueryPlugin) HandleFeeAbstractionQuery(ctx sdk.Context, feeAbstractionQuery feeabstractionbindingtypes.Query) ([]byte, error) {
+	// Match the query under the module
+	switch {
+	// The query is a fee estimation query
+	case feeAbstractionQuery.EstimateFee != nil:
+		// Apply the request
+		estimation, err := qp.HandleEstimateFee(ctx, *feeAbstractionQuery.EstimateFee)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		bz, err := json.Marshal(estimation)
+		if err != nil {
+			return nil, err
+		}
+		return bz, nil
+
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown fee abstraction query variant"}
+	}
+}
+
+// HandleEstimateFee handles the fee estimation query
+func (qp *QueryPlugin) HandleEstimateFee(ctx sdk.Context, query feeabstractionbindingtypes.EstimateFeeQuery) (*feeabstractiontypes.QueryEstimateFeeResponse, error) {
+	// Validate the query
+	if query.GasLimit == 0 {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gas limit must be positive"}
+	}
+
+	// Get the estimation from the keeper
+	estimation, err := qp.feeAbstractionQuerier.EstimateFee(
+		ctx,
+		&feeabstractiontypes.QueryEstimateFeeRequest{
+			GasLimit: query.GasLimit,
+			Denom:    query.Denom,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return estimation, nil
+}
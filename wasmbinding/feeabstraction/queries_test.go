@@ -0,0 +1,105 @@
+package feeabstraction_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction"
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction/types"
+	"github.com/kiichain/kiichain/v5/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestHandleFeeAbstractionQuery tests the HandleFeeAbstractionQuery function of the fee abstraction module
+func TestHandleFeeAbstractionQuery(t *testing.T) {
+	// Setup the app
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Set a base fee of 10^13 per gas, so 100k gas costs 1 KII
+	feeMarketParams := app.FeeMarketKeeper.GetParams(ctx)
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.BaseFee = math.LegacyNewDec(10_000_000_000_000)
+	feeMarketParams.MinGasPrice = math.LegacyZeroDec()
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+
+	// Register the fee tokens
+	feeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+		types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.5")),
+	)
+	require.NoError(t, app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *feeTokens))
+
+	// Set all the test cases
+	testCases := []struct {
+		name        string
+		query       feeabstractionbindingtypes.Query
+		expected    []byte
+		errContains string
+	}{
+		{
+			name: "valid - estimate all fee tokens",
+			query: feeabstractionbindingtypes.Query{
+				EstimateFee: &feeabstractionbindingtypes.EstimateFeeQuery{
+					GasLimit: 100_000,
+				},
+			},
+			expected: []byte(`{"native_fee":{"denom":"akii","amount":"1000000000000000000"},"fees":[{"denom":"uusdc","amount":"1000000"},{"denom":"uatom","amount":"500000"}]}`),
+		},
+		{
+			name: "valid - estimate a single fee token",
+			query: feeabstractionbindingtypes.Query{
+				EstimateFee: &feeabstractionbindingtypes.EstimateFeeQuery{
+					GasLimit: 100_000,
+					Denom:    "uatom",
+				},
+			},
+			expected: []byte(`{"native_fee":{"denom":"akii","amount":"1000000000000000000"},"fees":[{"denom":"uatom","amount":"500000"}]}`),
+		},
+		{
+			name: "invalid - zero gas limit",
+			query: feeabstractionbindingtypes.Query{
+				EstimateFee: &feeabstractionbindingtypes.EstimateFeeQuery{},
+			},
+			errContains: "invalid request: gas limit must be positive",
+		},
+		{
+			name: "invalid - unknown fee token",
+			query: feeabstractionbindingtypes.Query{
+				EstimateFee: &feeabstractionbindingtypes.EstimateFeeQuery{
+					GasLimit: 100_000,
+					Denom:    "unknown",
+				},
+			},
+			errContains: "unknown fee token",
+		},
+		{
+			name:        "invalid - unknown query",
+			query:       feeabstractionbindingtypes.Query{},
+			errContains: "unknown fee abstraction query variant",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start the query plugin
+			queryPlugin := feeabstraction.NewQueryPlugin(app.FeeAbstractionKeeper)
+
+			// Handle the query
+			bz, err := queryPlugin.HandleFeeAbstractionQuery(ctx, tc.query)
+
+			// Check for errors
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, bz)
+			}
+		})
+	}
+}
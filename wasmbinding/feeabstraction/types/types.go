@@ -0,0 +1,14 @@
+package types
+
+// Query defines the structure for fee abstraction queries
+type Query struct {
+	EstimateFee *EstimateFeeQuery `json:"estimate_fee,omitempty"`
+}
+
+// EstimateFeeQuery defines the structure for estimating the fee charged on the fee tokens
+type EstimateFeeQuery struct {
+	// GasLimit is the gas limit of the transaction
+	GasLimit uint64 `json:"gas_limit"`
+	// Denom is an optional fee token denom, empty estimates every enabled fee token
+	Denom string `json:"denom"`
+}
@@ -13,6 +13,8 @@ import (
 	bech32bindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/bech32/types"
 	"github.com/kiichain/kiichain/v5/wasmbinding/evm"
 	evmbindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/evm/types"
+	"github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction"
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction/types"
 	"github.com/kiichain/kiichain/v5/wasmbinding/oracle"
 	oraclebindingtypes "github.com/kiichain/kiichain/v5/wasmbinding/oracle/types"
 	"github.com/kiichain/kiichain/v5/wasmbinding/tokenfactory"
@@ -21,18 +23,20 @@ import (
 
 // KiichainQuery is the query type for all cosmwasm bindings
 type KiichainQuery struct {
-	TokenFactory *tfbindingtypes.Query     `json:"token_factory,omitempty"`
-	EVM          *evmbindingtypes.Query    `json:"evm,omitempty"`
-	Bech32       *bech32bindingtypes.Query `json:"bech32,omitempty"`
-	Oracle       *oraclebindingtypes.Query `json:"oracle,omitempty"`
+	TokenFactory   *tfbindingtypes.Query             `json:"token_factory,omitempty"`
+	EVM            *evmbindingtypes.Query            `json:"evm,omitempty"`
+	Bech32         *bech32bindingtypes.Query         `json:"bech32,omitempty"`
+	Oracle         *oraclebindingtypes.Query         `json:"oracle,omitempty"`
+	FeeAbstraction *feeabstractionbindingtypes.Query `json:"fee_abstraction,omitempty"`
 }
 
 // QueryPlugin is the query plugin for all cosmwasm bindings
 type QueryPlugin struct {
-	tokenfactoryHandler *tokenfactory.QueryPlugin
-	evmHandler          *evm.QueryPlugin
-	bech32Handler       *bech32.QueryPlugin
-	oracleHandler       *oracle.QueryPlugin
+	tokenfactoryHandler   *tokenfactory.QueryPlugin
+	evmHandler            *evm.QueryPlugin
+	bech32Handler         *bech32.QueryPlugin
+	oracleHandler         *oracle.QueryPlugin
+	feeAbstractionHandler *feeabstraction.QueryPlugin
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin
@@ -41,12 +45,14 @@ func NewQueryPlugin(
 	evm *evm.QueryPlugin,
 	bech32 *bech32.QueryPlugin,
 	oracle *oracle.QueryPlugin,
+	feeAbstraction *feeabstraction.QueryPlugin,
 ) *QueryPlugin {
 	return &QueryPlugin{
-		tokenfactoryHandler: th,
-		evmHandler:          evm,
-		bech32Handler:       bech32,
-		oracleHandler:       oracle,
+		tokenfactoryHandler:   th,
+		evmHandler:            evm,
+		bech32Handler:         bech32,
+		oracleHandler:         oracle,
+		feeAbstractionHandler: feeAbstraction,
 	}
 }
 
@@ -73,6 +79,9 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 		case contractQuery.Oracle != nil:
 			// Call the oracle custom querier
 			return qp.oracleHandler.HandleOracleQuery(ctx, *contractQuery.Oracle)
+		case contractQuery.FeeAbstraction != nil:
+			// Call the fee abstraction custom querier
+			return qp.feeAbstractionHandler.HandleFeeAbstractionQuery(ctx, *contractQuery.FeeAbstraction)
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown query variant"}
 		}
@@ -9,8 +9,10 @@ import (
 
 	"github.com/kiichain/kiichain/v5/wasmbinding/bech32"
 	evmwasmbinding "github.com/kiichain/kiichain/v5/wasmbinding/evm"
+	feeabstractionbinding "github.com/kiichain/kiichain/v5/wasmbinding/feeabstraction"
 	"github.com/kiichain/kiichain/v5/wasmbinding/oracle"
 	tfbinding "github.com/kiichain/kiichain/v5/wasmbinding/tokenfactory"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v5/x/oracle/keeper"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v5/x/tokenfactory/keeper"
 )
@@ -21,12 +23,14 @@ func RegisterCustomPlugins(
 	tokenFactory *tokenfactorykeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	feeAbstractionKeeper feeabstractionkeeper.Keeper,
 ) []wasmkeeper.Option {
 	// Register custom query plugins
 	tokenFactoryQueryPlugin := tfbinding.NewQueryPlugin(bank, tokenFactory)
 	evmQueryPlugin := evmwasmbinding.NewQueryPlugin(evmKeeper)
 	bech32QueryPlugin := bech32.NewQueryPlugin()
 	oracleQueryPlugin := oracle.NewQueryPlugin(oracleKeeper)
+	feeAbstractionQueryPlugin := feeabstractionbinding.NewQueryPlugin(feeAbstractionKeeper)
 
 	// Create the central query plugin
 	queryPlugin := NewQueryPlugin(
@@ -34,6 +38,7 @@ func RegisterCustomPlugins(
 		evmQueryPlugin,
 		bech32QueryPlugin,
 		oracleQueryPlugin,
+		feeAbstractionQueryPlugin,
 	)
 
 	// Register custom message handler decorators
@@ -2,6 +2,11 @@
 // The original implementation can be found at: `x/auth/ante/fee.go`
 // These are the main changes to the original implementation:
 // - The fee abstraction module is used to convert the fees from the native coin to a available coin
+// - The preferred fee token extension option is honoured before the account preference
+// - Txs naming a fee sponsor on the extension options have their fees paid by the sponsor
+// - The tx priority is adjusted to the native value of the charged fees
+// - Fees on ERC20 fee tokens are collected by the fee abstraction module, using the ERC20 permit option if set
+// - Fee grant allowances naming a fee token are spent on the fees converted to that token
 package cosmos
 
 import (
@@ -16,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	antetypes "github.com/kiichain/kiichain/v5/ante/types"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
@@ -25,13 +31,13 @@ import (
 type DeductFeeDecorator struct {
 	accountKeeper        ante.AccountKeeper
 	bankKeeper           types.BankKeeper
-	feegrantKeeper       ante.FeegrantKeeper
+	feegrantKeeper       antetypes.FeegrantKeeper
 	feeAbstractionKeeper antetypes.FeeAbstractionKeeper
 	txFeeChecker         ante.TxFeeChecker
 }
 
 // NewDeductFeeDecorator creates a new DeductFeeDecorator instance
-func NewDeductFeeDecorator(ak ante.AccountKeeper, bk types.BankKeeper, fk ante.FeegrantKeeper, fak antetypes.FeeAbstractionKeeper, tfc ante.TxFeeChecker) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak ante.AccountKeeper, bk types.BankKeeper, fk antetypes.FeegrantKeeper, fak antetypes.FeeAbstractionKeeper, tfc ante.TxFeeChecker) DeductFeeDecorator {
 	if tfc == nil {
 		// This is different from the original implementation
 		// Originally, we set as checkTxFeeWithValidatorMinGasPrices
@@ -76,10 +82,19 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		}
 	}
 	// Check and deduct the fees from the fee payer account
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	chargedFee, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
+	// Adjust the priority to the value of the charged fees
+	if !simulate {
+		priority, err = dfd.feeAbstractionKeeper.GetFeePriority(ctx, priority, fee, chargedFee, feeTx.GetGas())
+		if err != nil {
+			return ctx, err
+		}
+	}
+
 	// Set the TX priority
 	newCtx := ctx.WithPriority(priority)
 
@@ -87,16 +102,17 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 }
 
 // checkDeductFee checks if the fee payer has enough funds to pay for the fees and deducts the fees from the fee payer account
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+// It returns the fees charged after the fee abstraction conversion
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (sdk.Coins, error) {
 	// Parse the tx as a feeTx interface
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	// Check if the fee collector module account is set
 	if addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+		return nil, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
 	}
 
 	// Get the fee payer and the fee granter from the feeTx
@@ -104,18 +120,43 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	feeGranter := feeTx.FeeGranter()
 	deductFeesFrom := feePayer
 
+	// Read the fee sponsor from the tx extension options
+	sponsor, err := feeabstractiontypes.GetFeeSponsorFromTx(sdkTx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	// Sponsored txs have their fees paid by the sponsor balance
+	if sponsor != "" {
+		if feeGranter != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee sponsors can't be used with fee grants")
+		}
+		return dfd.deductSponsoredFee(ctx, feeTx, sponsor, feePayer, fee)
+	}
+
 	// if feegranter set deduct fee from feegranter account.
 	// this works with only when feegrant enabled.
+	var grantFeeToken string
 	if feeGranter != nil {
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
 		// If feegranter is set, we need to check if the feegrant module is enabled
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranterAddr, feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
+			// Read the fee token named by the allowance
+			allowance, err := dfd.feegrantKeeper.GetAllowance(ctx, feeGranterAddr, feePayer)
 			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+			}
+			grantFeeToken = feeabstractiontypes.GetAllowanceFeeToken(allowance)
+
+			// Allowances on the native denom are spent before the conversion
+			if grantFeeToken == "" {
+				err = dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+				}
 			}
 		}
 
@@ -126,24 +167,59 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	// Get the account of the fee payer
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// Deduct the fees
 	var convertedFee sdk.Coins
 	if !fee.IsZero() {
+		// Read the preferred fee token from the tx extension options
+		preferredDenom, err := feeabstractiontypes.GetPreferredFeeTokenFromTx(sdkTx)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		// Read the ERC20 permit from the tx extension options
+		// The permit is used by the fee abstraction module to collect the fees on ERC20 fee tokens
+		permit, err := feeabstractiontypes.GetERC20PermitFromTx(sdkTx)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		if permit != nil {
+			ctx = ctx.WithValue(feeabstractiontypes.ContextERC20PermitKey{}, permit)
+		}
+
 		// Apply the fee conversion from the fee abstraction module
 		// This is the only change from the original implementation
-		var err error
-		convertedFee, err = dfd.feeAbstractionKeeper.ConvertNativeFee(ctx, deductFeesFromAcc.GetAddress(), fee)
+		// Allowances naming a fee token are paid only with that token
+		if grantFeeToken != "" {
+			convertedFee, err = dfd.feeAbstractionKeeper.ConvertNativeFeeWithFeeToken(ctx, deductFeesFromAcc.GetAddress(), fee, grantFeeToken)
+		} else if preferredDenom != "" {
+			convertedFee, err = dfd.feeAbstractionKeeper.ConvertNativeFeeWithPreference(ctx, deductFeesFromAcc.GetAddress(), fee, preferredDenom)
+		} else {
+			convertedFee, err = dfd.feeAbstractionKeeper.ConvertNativeFee(ctx, deductFeesFromAcc.GetAddress(), fee)
+		}
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Deduct the fees from the fee payer account
-		err = ante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, convertedFee)
+		// Fees on ERC20 fee tokens were already collected by the conversion
+		bankFee, err := dfd.feeAbstractionKeeper.GetBankFees(ctx, convertedFee)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		err = ante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, bankFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Spend the allowance naming a fee token on the converted fees
+	if grantFeeToken != "" {
+		err := dfd.feegrantKeeper.UseGrantedFees(ctx, deductFeesFrom, feePayer, convertedFee, sdkTx.GetMsgs())
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
 		}
 	}
 
@@ -157,5 +233,32 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return convertedFee, nil
+}
+
+// deductSponsoredFee charges the fees to the sponsor named by the tx
+func (dfd DeductFeeDecorator) deductSponsoredFee(ctx sdk.Context, feeTx sdk.FeeTx, sponsor string, feePayer []byte, fee sdk.Coins) (sdk.Coins, error) {
+	// Parse the sponsor address
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid fee sponsor %s: %s", sponsor, err)
+	}
+
+	// Charge the sponsor
+	chargedFee, err := dfd.feeAbstractionKeeper.ChargeSponsor(ctx, sponsorAddr, feePayer, feeTx.GetMsgs(), fee, feeTx.GetGas())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "%s does not pay fees for %s", sponsor, sdk.AccAddress(feePayer))
+	}
+
+	// Emit the events
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, chargedFee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sponsorAddr.String()),
+		),
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return chargedFee, nil
 }
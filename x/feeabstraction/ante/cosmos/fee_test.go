@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
@@ -11,7 +12,9 @@ import (
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/feegrant"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtestutil "github.com/cosmos/cosmos-sdk/x/auth/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -55,13 +58,14 @@ func TestDeductFeeDecorator(t *testing.T) {
 
 	// Set the different test cases
 	testCases := []struct {
-		name        string
-		malleate    func(ctx sdk.Context)
-		fee         sdk.Coins
-		expected    sdk.Coins
-		feeGranter  sdk.AccAddress
-		errContains string
-		postCheck   func(ctx sdk.Context)
+		name           string
+		malleate       func(ctx sdk.Context)
+		fee            sdk.Coins
+		expected       sdk.Coins
+		feeGranter     sdk.AccAddress
+		preferredDenom string
+		errContains    string
+		postCheck      func(ctx sdk.Context)
 	}{
 		{
 			name: "success - valid fee deduction",
@@ -239,6 +243,119 @@ func TestDeductFeeDecorator(t *testing.T) {
 				require.Equal(t, big.NewInt(DefaultMinFeeValue/2), erc20Balance)
 			},
 		},
+		{
+			name: "fee abstraction - preferred fee token on the extension option",
+			malleate: func(ctx sdk.Context) {
+				// Set the pair on the fee abstraction keeper
+				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+
+				// Fund the fee payer with both the native and the preferred token
+				coins := sdk.NewCoins(
+					sdk.NewInt64Coin("akii", DefaultMinFeeValue),
+					sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10),
+				)
+				err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins)
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, founder, coins)
+				require.NoError(t, err)
+			},
+			preferredDenom: MockErc20Denom,
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			// The preferred token is used even if the native balance is enough
+			expected: sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)),
+		},
+		{
+			name: "fee abstraction - unknown preferred fee token falls back to the native token",
+			malleate: func(ctx sdk.Context) {
+				// Fund the account with enough funds to pay the fee
+				err := app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)))
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, founder, sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)))
+				require.NoError(t, err)
+			},
+			preferredDenom: "unknown",
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			expected:       sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+		},
+		{
+			name: "fee abstraction - fee grant allowance on a fee token",
+			malleate: func(ctx sdk.Context) {
+				// Set the pair on the fee abstraction keeper
+				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+
+				// Fund the fee granter with both the native and the fee token
+				coins := sdk.NewCoins(
+					sdk.NewInt64Coin("akii", DefaultMinFeeValue),
+					sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10),
+				)
+				err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins)
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feeGranter, coins)
+				require.NoError(t, err)
+
+				// Create the fee grant on the fee token
+				allowance, err := types.NewFeeTokenAllowance(&feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*20)),
+				}, MockErc20Denom)
+				require.NoError(t, err)
+				err = app.FeeGrantKeeper.GrantAllowance(ctx, feeGranter, founder, allowance)
+				require.NoError(t, err)
+			},
+			feeGranter: feeGranter,
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			// The fee token is used even if the granter native balance is enough
+			expected: sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)),
+		},
+		{
+			name: "fail - fee grant allowance on a fee token without limit on the token",
+			malleate: func(ctx sdk.Context) {
+				// Set the pair on the fee abstraction keeper
+				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+
+				// Fund the fee granter with the fee token
+				coins := sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10))
+				err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins)
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feeGranter, coins)
+				require.NoError(t, err)
+
+				// Create the fee grant on the fee token, limited on the native denom
+				allowance, err := types.NewFeeTokenAllowance(&feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+				}, MockErc20Denom)
+				require.NoError(t, err)
+				err = app.FeeGrantKeeper.GrantAllowance(ctx, feeGranter, founder, allowance)
+				require.NoError(t, err)
+			},
+			feeGranter:  feeGranter,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			expected:    sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)),
+			errContains: "fee limit exceeded",
+		},
 		{
 			name:        "fail - unauthorized fee grant",
 			feeGranter:  feeGranter,
@@ -294,12 +411,21 @@ func TestDeductFeeDecorator(t *testing.T) {
 			// Wrap into a ante decorator
 			anteHandler := sdk.ChainAnteDecorators(deductFeeDecorator)
 
+			// Prepare the preferred fee token extension option
+			var extOpts []*codectypes.Any
+			if tc.preferredDenom != "" {
+				extOpt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionPreferredFeeToken{Denom: tc.preferredDenom})
+				require.NoError(t, err)
+				extOpts = append(extOpts, extOpt)
+			}
+
 			// Build a TX
-			tx, err := helpers.BuildTxFromMsgs(
+			tx, err := helpers.BuildTxFromMsgsWithExtensionOptions(
 				founder,
 				tc.feeGranter,
 				tc.fee,
 				1000000,
+				extOpts,
 				banktypes.NewMsgSend(founder, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))),
 			)
 			require.NoError(t, err)
@@ -320,6 +446,214 @@ func TestDeductFeeDecorator(t *testing.T) {
 	}
 }
 
+// TestDeductFeeDecoratorSponsor tests the DeductFeeDecorator with a fee sponsor
+func TestDeductFeeDecoratorSponsor(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create a fee payer and the sponsor contract
+	founder := apptesting.RandomAccountAddress()
+	contract := apptesting.RandomAccountAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, founder))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue))
+
+	// Prepare a msg calling the sponsor contract and a msg that doesn't
+	execMsg := &wasmtypes.MsgExecuteContract{Sender: founder.String(), Contract: contract.String(), Msg: []byte("{}")}
+	sendMsg := banktypes.NewMsgSend(founder, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))))
+
+	// Set the different test cases
+	testCases := []struct {
+		name        string
+		feeGranter  sdk.AccAddress
+		sponsor     sdk.AccAddress
+		msg         sdk.Msg
+		errContains string
+	}{
+		{
+			name:    "success - sponsor pays the fee",
+			sponsor: contract,
+			msg:     execMsg,
+		},
+		{
+			name:        "fail - msg doesn't call the sponsor",
+			sponsor:     contract,
+			msg:         sendMsg,
+			errContains: "doesn't call the sponsor or an allowed contract",
+		},
+		{
+			name:        "fail - unknown sponsor",
+			sponsor:     apptesting.RandomAccountAddress(),
+			msg:         execMsg,
+			errContains: "unknown fee sponsor",
+		},
+		{
+			name:        "fail - sponsor with fee grant",
+			feeGranter:  apptesting.RandomAccountAddress(),
+			sponsor:     contract,
+			msg:         execMsg,
+			errContains: "fee sponsors can't be used with fee grants",
+		},
+	}
+
+	// Iterate and run the tests
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// Register the sponsor and fund it through the module account
+			policy := types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+			sponsor := types.NewSponsor(contract.String(), contract.String(), policy)
+			sponsor.Balance = fee
+			require.NoError(t, app.FeeAbstractionKeeper.Sponsors.Set(cachedCtx, contract, sponsor))
+			require.NoError(t, app.BankKeeper.MintCoins(cachedCtx, evmtypes.ModuleName, fee))
+			require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(cachedCtx, evmtypes.ModuleName, types.ModuleName, fee))
+
+			// Start up the DeductFeeDecorator
+			deductFeeDecorator := cosmos.NewDeductFeeDecorator(
+				app.AccountKeeper,
+				app.BankKeeper,
+				app.FeeGrantKeeper,
+				app.FeeAbstractionKeeper,
+				cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+			)
+
+			// Wrap into a ante decorator
+			anteHandler := sdk.ChainAnteDecorators(deductFeeDecorator)
+
+			// Prepare the fee sponsor extension option
+			extOpt, err := codectypes.NewAnyWithValue(&types.ExtensionOptionFeeSponsor{Sponsor: tc.sponsor.String()})
+			require.NoError(t, err)
+
+			// Build a TX
+			tx, err := helpers.BuildTxFromMsgsWithExtensionOptions(
+				founder,
+				tc.feeGranter,
+				fee,
+				1000000,
+				[]*codectypes.Any{extOpt},
+				tc.msg,
+			)
+			require.NoError(t, err)
+
+			// Call the ante handler
+			_, err = anteHandler(cachedCtx, tx, false)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			// The sponsor balance was used and the fee payer paid nothing
+			sponsor, err = app.FeeAbstractionKeeper.GetSponsor(cachedCtx, contract)
+			require.NoError(t, err)
+			require.True(t, sponsor.Balance.IsZero())
+			require.Equal(t, fee.String(), sponsor.Spent.String())
+			require.True(t, app.BankKeeper.GetAllBalances(cachedCtx, founder).IsZero())
+		})
+	}
+}
+
+// TestDeductFeeDecoratorERC20Permit tests the ERC20 permit extension option on the DeductFeeDecorator
+// The permit is set on the context for the fee abstraction keeper, the test contract has no permit method
+func TestDeductFeeDecoratorERC20Permit(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create a fee payer
+	founder := apptesting.RandomAccountAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, founder))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue))
+	amount := math.NewInt(DefaultMinFeeValue)
+
+	// Deploy the ERC20 fee token, 1 token per kii
+	contract, err := apptesting.DeployERC20(ctx, app)
+	require.NoError(t, err)
+	feeToken := types.NewFeeTokenMetadata(types.GetERC20FeeTokenDenom(contract), "erc20oracle", 18, math.LegacyOneDec())
+	feeToken.Erc20Address = contract.Hex()
+
+	// Set the different test cases
+	testCases := []struct {
+		name        string
+		permit      *types.ExtensionOptionERC20Permit
+		errIs       error
+		errContains string
+	}{
+		{
+			name:   "success - permit for another contract is ignored",
+			permit: types.NewERC20Permit(common.HexToAddress(DefaultFirstERC20), amount, 1000, 27, [32]byte{1}, [32]byte{2}),
+		},
+		{
+			name:        "fail - permit rejected by the fee token contract",
+			permit:      types.NewERC20Permit(contract, amount, 1000, 27, [32]byte{1}, [32]byte{2}),
+			errIs:       types.ErrInvalidERC20Permit,
+			errContains: "failed to apply the permit",
+		},
+		{
+			name:        "fail - invalid permit",
+			permit:      types.NewERC20Permit(contract, math.ZeroInt(), 1000, 27, [32]byte{1}, [32]byte{2}),
+			errIs:       sdkerrors.ErrTxDecode,
+			errContains: "value must be positive",
+		},
+	}
+
+	// Iterate and run the tests
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// Register the fee token, the fee payer holds it and approved the module address
+			require.NoError(t, app.FeeAbstractionKeeper.FeeTokens.Set(cachedCtx, *types.NewFeeTokenMetadataCollection(feeToken)))
+			owner := common.BytesToAddress(founder)
+			moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+			require.NoError(t, apptesting.MintERC20(cachedCtx, app, contract, owner, amount.BigInt()))
+			require.NoError(t, apptesting.CreateERC20Allowance(cachedCtx, app, contract, owner, moduleAddr, amount.BigInt()))
+
+			// Start up the DeductFeeDecorator
+			deductFeeDecorator := cosmos.NewDeductFeeDecorator(
+				app.AccountKeeper,
+				app.BankKeeper,
+				app.FeeGrantKeeper,
+				app.FeeAbstractionKeeper,
+				cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+			)
+
+			// Wrap into a ante decorator
+			anteHandler := sdk.ChainAnteDecorators(deductFeeDecorator)
+
+			// Prepare the ERC20 permit extension option
+			extOpt, err := codectypes.NewAnyWithValue(tc.permit)
+			require.NoError(t, err)
+
+			// Build a TX
+			tx, err := helpers.BuildTxFromMsgsWithExtensionOptions(
+				founder,
+				nil,
+				fee,
+				1000000,
+				[]*codectypes.Any{extOpt},
+				banktypes.NewMsgSend(founder, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))),
+			)
+			require.NoError(t, err)
+
+			// Call the ante handler
+			_, err = anteHandler(cachedCtx, tx, false)
+			if tc.errIs != nil {
+				require.ErrorIs(t, err, tc.errIs)
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			// The fee was collected from the ERC20 balance
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			require.Equal(t, amount.BigInt(), app.Erc20Keeper.BalanceOf(cachedCtx, erc20, contract, moduleAddr))
+			require.Zero(t, app.Erc20Keeper.BalanceOf(cachedCtx, erc20, contract, owner).Sign())
+		})
+	}
+}
+
 // TestDeductFeeDecoratorCheckerNil tests the DeductFeeDecorator with a nil checker
 func TestDeductFeeDecoratorCheckerNil(t *testing.T) {
 	// Start the app and the context
@@ -7,10 +7,16 @@
 // - VerifyAccountBalance will check if the user has enough balance to pay for the transaction value (before was fee + value)
 // - The key ContextPaidFeesKey is defined on the context to store the paid fees, this is used to refund the gas under the evm module
 //   - EVM module counterpart is defined under `x/vm/keeper/gas.go`
+// - Calls to a registered fee sponsor contract have their fees paid by the sponsor, if its policy allows it
+// - The msg priority is adjusted to the native value of the charged fees
+// - Fees on ERC20 fee tokens are collected by the fee abstraction module with the allowance given to its address
+// - The fee token charged to the user is recorded, so the unused gas is refunded on it
 
 package evm
 
 import (
+	"errors"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -29,6 +35,7 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	antetypes "github.com/kiichain/kiichain/v5/ante/types"
+	feeabstractiontypes "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
 // MonoDecorator is a single decorator that handles all the prechecks for
@@ -190,23 +197,51 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
-		// Here the fee abstraction module does it work
-		// We check if the user has enough balance to pay for the fees using the
-		// native token (evmDenom), if not we iterate the fee abstraction module tokens
-		convertedMsgFees, err := md.feeAbstractionKeeper.ConvertNativeFee(ctx, from, msgFees)
+		// Calls to a fee sponsor are paid by the sponsor
+		// If the sponsor can't pay for the tx, the user pays as usual
+		convertedMsgFees, sponsor, err := md.chargeSponsor(ctx, ethTx, msg, from, msgFees)
 		if err != nil {
 			return ctx, err
 		}
 
-		// Here the gas is deducted from the user
-		err = evmante.ConsumeFeesAndEmitEvent(
-			ctx,
-			md.evmKeeper,
-			convertedMsgFees,
-			from,
-		)
-		if err != nil {
-			return ctx, err
+		// The fees refunded by the EVM module, fees collected from ERC20 fee tokens are not refunded
+		refundableFees := convertedMsgFees
+		if sponsor == nil {
+			// Here the fee abstraction module does it work
+			// We check if the user has enough balance to pay for the fees using the
+			// native token (evmDenom), if not we iterate the fee abstraction module tokens
+			convertedMsgFees, err = md.feeAbstractionKeeper.ConvertNativeFee(ctx, from, msgFees)
+			if err != nil {
+				return ctx, err
+			}
+
+			// Fees on ERC20 fee tokens were already collected by the conversion
+			refundableFees, err = md.feeAbstractionKeeper.GetBankFees(ctx, convertedMsgFees)
+			if err != nil {
+				return ctx, err
+			}
+
+			// Here the gas is deducted from the user
+			err = evmante.ConsumeFeesAndEmitEvent(
+				ctx,
+				md.evmKeeper,
+				refundableFees,
+				from,
+			)
+			if err != nil {
+				return ctx, err
+			}
+
+			// Record the fee token charged to the user, the unused gas is refunded on it at the charged price
+			// ERC20 fees are final, their refunds are dropped
+			if err := md.feeAbstractionKeeper.SetChargedFee(ctx, from, msgFees, convertedMsgFees); err != nil {
+				return ctx, err
+			}
+		} else {
+			// Record the fee paid by the sponsor, the unused gas is refunded to the sponsor balance
+			if err := md.feeAbstractionKeeper.SetSponsoredFee(ctx, from, sponsor, msgFees, refundableFees); err != nil {
+				return ctx, err
+			}
 		}
 
 		// This checks if the user has enough balance
@@ -231,12 +266,17 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		)
 		decUtils.GasWanted = gasWanted
 
-		minPriority := evmante.GetMsgPriority(
+		// The msg priority is adjusted to the native value of the charged fees
+		msgPriority := evmante.GetMsgPriority(
 			ethTx,
-			decUtils.MinPriority,
+			math.MaxInt64,
 			decUtils.BaseFee,
 		)
-		decUtils.MinPriority = minPriority
+		msgPriority, err = md.feeAbstractionKeeper.GetFeePriority(ctx, msgPriority, msgFees, convertedMsgFees, gas)
+		if err != nil {
+			return ctx, err
+		}
+		decUtils.MinPriority = min(decUtils.MinPriority, msgPriority)
 
 		// Update the fee to be paid for the tx adding the fee specified for the
 		// current message.
@@ -284,7 +324,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		)
 
 		// Define the fee on the context for gas refunding
-		ctx = ctx.WithValue(evmkeeper.ContextPaidFeesKey{}, convertedMsgFees)
+		ctx = ctx.WithValue(evmkeeper.ContextPaidFeesKey{}, refundableFees)
 	}
 
 	if err := evmante.CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
@@ -298,3 +338,46 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	return next(ctx, tx, simulate)
 }
+
+// chargeSponsor charges the fees to the called contract if it's a registered fee sponsor
+// It returns a nil sponsor if the tx isn't sponsored, either because the contract isn't a sponsor or
+// because its policy, user quotas or balance don't allow paying for the tx
+func (md MonoDecorator) chargeSponsor(ctx sdk.Context, ethTx *ethtypes.Transaction, msg sdk.Msg, from sdk.AccAddress, msgFees sdk.Coins) (sdk.Coins, sdk.AccAddress, error) {
+	// Contract creations can't be sponsored
+	to := ethTx.To()
+	if to == nil {
+		return nil, nil, nil
+	}
+
+	// Check if the called contract is a sponsor
+	sponsor := sdk.AccAddress(to.Bytes())
+	isSponsor, err := md.feeAbstractionKeeper.IsSponsor(ctx, sponsor)
+	if err != nil || !isSponsor {
+		return nil, nil, err
+	}
+
+	// Charge the sponsor, the sponsor state is only written if the charge succeeds
+	cacheCtx, write := ctx.CacheContext()
+	chargedFees, err := md.feeAbstractionKeeper.ChargeSponsor(cacheCtx, sponsor, from, []sdk.Msg{msg}, msgFees, ethTx.Gas())
+	if errors.Is(err, feeabstractiontypes.ErrSponsorNotAllowed) ||
+		errors.Is(err, feeabstractiontypes.ErrSponsorQuotaReached) ||
+		errors.Is(err, errortypes.ErrInsufficientFunds) {
+		ctx.Logger().Debug("fee sponsor skipped", "sponsor", sponsor.String(), "reason", err.Error())
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	write()
+
+	// Emit the fee event with the sponsor as the payer
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, chargedFees.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sponsor.String()),
+		),
+	)
+
+	return chargedFees, sponsor, nil
+}
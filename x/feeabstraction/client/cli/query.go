@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -26,6 +27,14 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryFeeTokens(),
+		GetCmdQueryPreferredFeeToken(),
+		GetCmdQueryEstimateFee(),
+		GetCmdQuerySponsor(),
+		GetCmdQuerySponsors(),
+		GetCmdQuerySponsorUsage(),
+		GetCmdQueryFeeRevenue(),
+		GetCmdQueryFeeRevenues(),
+		GetCmdQueryFeeTokenStats(),
 	)
 	return cmd
 }
@@ -91,3 +100,291 @@ func GetCmdQueryFeeTokens() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPreferredFeeToken implements the preferred fee token query command.
+func GetCmdQueryPreferredFeeToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preferred-fee-token [address]",
+		Short: "Query the fee token preferred by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the PreferredFeeToken query
+			res, err := queryClient.PreferredFeeToken(cmd.Context(), &types.QueryPreferredFeeTokenRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEstimateFee implements the estimate fee query command.
+func GetCmdQueryEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [gas-limit] [denom]",
+		Short: "Estimate the fee charged for a gas limit on the fee tokens",
+		Long:  "Estimate the fee charged for a gas limit on every enabled fee token, or only on the given denom",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the gas limit
+			gasLimit, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gas limit: %w", err)
+			}
+
+			// Parse the optional denom
+			denom := ""
+			if len(args) > 1 {
+				denom = args[1]
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the EstimateFee query
+			res, err := queryClient.EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{GasLimit: gasLimit, Denom: denom})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySponsor implements the sponsor query command.
+func GetCmdQuerySponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor [contract]",
+		Short: "Query a fee sponsor, including its balance and policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the Sponsor query
+			res, err := queryClient.Sponsor(cmd.Context(), &types.QuerySponsorRequest{Contract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySponsors implements the sponsors query command.
+func GetCmdQuerySponsors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsors",
+		Short: "Query all the fee sponsors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the pagination flags
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the Sponsors query
+			res, err := queryClient.Sponsors(cmd.Context(), &types.QuerySponsorsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query and pagination flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsors")
+	return cmd
+}
+
+// GetCmdQuerySponsorUsage implements the sponsor usage query command.
+func GetCmdQuerySponsorUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor-usage [contract] [user]",
+		Short: "Query the usage of a fee sponsor by a user",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the SponsorUsage query
+			res, err := queryClient.SponsorUsage(cmd.Context(), &types.QuerySponsorUsageRequest{Contract: args[0], User: args[1]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeRevenue implements the fee revenue query command.
+func GetCmdQueryFeeRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-revenue [denom]",
+		Short: "Query the fees collected and routed on a fee token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the FeeRevenue query
+			res, err := queryClient.FeeRevenue(cmd.Context(), &types.QueryFeeRevenueRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeRevenues implements the fee revenues query command.
+func GetCmdQueryFeeRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-revenues",
+		Short: "Query the fees collected and routed on all the fee tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the pagination flags
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the FeeRevenues query
+			res, err := queryClient.FeeRevenues(cmd.Context(), &types.QueryFeeRevenuesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query and pagination flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee revenues")
+	return cmd
+}
+
+// GetCmdQueryFeeTokenStats implements the fee token statistics query command.
+func GetCmdQueryFeeTokenStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-stats [denom]",
+		Short: "Query the daily usage statistics of the fee tokens, optionally filtered by denom",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the pagination flags
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Read the optional denom
+			req := &types.QueryFeeTokenStatsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the FeeTokenStats query
+			res, err := queryClient.FeeTokenStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query and pagination flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee token stats")
+	return cmd
+}
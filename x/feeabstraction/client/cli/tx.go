@@ -2,14 +2,36 @@ package cli
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
+// Flags used to define the sponsor policy
+const (
+	FlagAllowedMsgTypes  = "allowed-msg-types"
+	FlagAllowedContracts = "allowed-contracts"
+	FlagMaxTxsPerUser    = "max-txs-per-user"
+	FlagMaxFeesPerUser   = "max-fees-per-user"
+	FlagMaxFeePerTx      = "max-fee-per-tx"
+	FlagMaxGasPrice      = "max-gas-price"
+	FlagNonces           = "nonces"
+	FlagDeposit          = "deposit"
+	FlagExpiration       = "expiration"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	// Create the core cobra command
@@ -22,6 +44,332 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	// Add all the commands and return the CMD
-	cmd.AddCommand()
+	cmd.AddCommand(
+		GetCmdSetPreferredFeeToken(),
+		GetCmdRegisterSponsor(),
+		GetCmdUpdateSponsorPolicy(),
+		GetCmdFundSponsor(),
+		GetCmdWithdrawSponsorFunds(),
+		GetCmdGrantFeeTokenAllowance(),
+	)
+	return cmd
+}
+
+// GetCmdSetPreferredFeeToken implements the set preferred fee token command
+func GetCmdSetPreferredFeeToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-preferred-fee-token [denom]",
+		Short: "Set the fee token used first to pay for the sender transactions",
+		Long: strings.TrimSpace(`
+Set the fee token that should be tried first when paying the sender transaction fees.
+An empty denom clears the preference.
+
+$ kiichaind tx feeabstraction set-preferred-fee-token uusdc --from mykey
+$ kiichaind tx feeabstraction set-preferred-fee-token "" --from mykey`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Build and validate the message
+			msg := types.NewMessageSetPreferredFeeToken(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add tx flags to the command
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRegisterSponsor implements the register sponsor command
+func GetCmdRegisterSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-sponsor [contract]",
+		Short: "Register a wasm or EVM contract as a fee sponsor",
+		Long: strings.TrimSpace(`
+Register a wasm or EVM contract as a fee sponsor. The sender must be the wasm contract admin or the EVM
+contract deployer, proved by the deployment nonces. The sender becomes the sponsor admin.
+
+$ kiichaind tx feeabstraction register-sponsor kii1... --deposit 1000000akii --allowed-contracts kii1... --max-txs-per-user 10 --from mykey
+$ kiichaind tx feeabstraction register-sponsor kii1... --nonces 4 --deposit 1000000akii --from mykey`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the policy, nonces and deposit
+			policy, err := parseSponsorPolicy(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			nonces, err := cmd.Flags().GetUintSlice(FlagNonces)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return fmt.Errorf("invalid deposit: %w", err)
+			}
+
+			// Convert the nonces
+			nonces64 := make([]uint64, len(nonces))
+			for i, nonce := range nonces {
+				nonces64[i] = uint64(nonce)
+			}
+
+			// Build and validate the message
+			msg := types.NewMessageRegisterSponsor(clientCtx.GetFromAddress().String(), args[0], nonces64, deposit, policy)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add the policy and tx flags to the command
+	addSponsorPolicyFlags(cmd)
+	cmd.Flags().UintSlice(FlagNonces, nil, "Deployer nonces used to derive the EVM contract address")
+	cmd.Flags().String(FlagDeposit, "", "Initial sponsor deposit")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateSponsorPolicy implements the update sponsor policy command
+func GetCmdUpdateSponsorPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-sponsor-policy [contract]",
+		Short: "Replace the policy of a fee sponsor, the sender must be the sponsor admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the policy
+			policy, err := parseSponsorPolicy(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Build and validate the message
+			msg := types.NewMessageUpdateSponsorPolicy(clientCtx.GetFromAddress().String(), args[0], policy)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add the policy and tx flags to the command
+	addSponsorPolicyFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFundSponsor implements the fund sponsor command
+func GetCmdFundSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-sponsor [contract] [amount]",
+		Short: "Add funds to the balance of a fee sponsor",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the amount
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			// Build and validate the message
+			msg := types.NewMessageFundSponsor(clientCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add tx flags to the command
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWithdrawSponsorFunds implements the withdraw sponsor funds command
+func GetCmdWithdrawSponsorFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-sponsor-funds [contract] [amount]",
+		Short: "Withdraw funds from the balance of a fee sponsor, the sender must be the sponsor admin",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the amount
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			// Build and validate the message
+			msg := types.NewMessageWithdrawSponsorFunds(clientCtx.GetFromAddress().String(), args[0], amount)
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add tx flags to the command
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdGrantFeeTokenAllowance implements the grant fee token allowance command
+func GetCmdGrantFeeTokenAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-token-allowance [grantee] [fee-token] [spend-limit]",
+		Short: "Grant a fee allowance spent on a fee token",
+		Long: strings.TrimSpace(`
+Grant a fee allowance from the sender to the grantee. The grantee fees are converted to the fee token
+at its price and paid by the sender. The spend limit must be on the fee token, an empty spend limit
+means no limit.
+
+$ kiichaind tx feeabstraction grant-fee-token-allowance kii1... uusdc 1000000uusdc --from mykey
+$ kiichaind tx feeabstraction grant-fee-token-allowance kii1... uusdc "" --expiration 2026-01-01T00:00:00Z --from mykey`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the grantee and the spend limit
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee: %w", err)
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			// Parse the optional expiration
+			basic := &feegrant.BasicAllowance{SpendLimit: spendLimit}
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if expirationStr != "" {
+				expiration, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return fmt.Errorf("invalid expiration: %w", err)
+				}
+				basic.Expiration = &expiration
+			}
+
+			// Build the allowance
+			allowance, err := types.NewFeeTokenAllowance(basic, args[1])
+			if err != nil {
+				return err
+			}
+			if err := allowance.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Build the fee grant message
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			// Broadcast the message
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	// Add the expiration and tx flags to the command
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the allowance expires")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// addSponsorPolicyFlags adds the flags used to define the sponsor policy
+func addSponsorPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagAllowedMsgTypes, nil, "Msg type URLs paid by the sponsor, empty allows any msg type")
+	cmd.Flags().StringSlice(FlagAllowedContracts, nil, "Contracts the sponsored msgs can call besides the sponsor")
+	cmd.Flags().Uint64(FlagMaxTxsPerUser, 0, "Max number of sponsored txs per user, zero means no limit")
+	cmd.Flags().String(FlagMaxFeesPerUser, "0", "Max amount of native fees sponsored per user, zero means no limit")
+	cmd.Flags().String(FlagMaxFeePerTx, "0", "Max amount of native fees sponsored per tx, zero means no limit")
+	cmd.Flags().String(FlagMaxGasPrice, "0", "Max native gas price sponsored, zero means no limit")
+}
+
+// parseSponsorPolicy parses the sponsor policy from the command flags
+func parseSponsorPolicy(fs *pflag.FlagSet) (types.SponsorPolicy, error) {
+	allowedMsgTypes, err := fs.GetStringSlice(FlagAllowedMsgTypes)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	allowedContracts, err := fs.GetStringSlice(FlagAllowedContracts)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	maxTxsPerUser, err := fs.GetUint64(FlagMaxTxsPerUser)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	maxFeesPerUserStr, err := fs.GetString(FlagMaxFeesPerUser)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	maxFeesPerUser, ok := math.NewIntFromString(maxFeesPerUserStr)
+	if !ok {
+		return types.SponsorPolicy{}, fmt.Errorf("invalid max fees per user: %s", maxFeesPerUserStr)
+	}
+	maxFeePerTxStr, err := fs.GetString(FlagMaxFeePerTx)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	maxFeePerTx, ok := math.NewIntFromString(maxFeePerTxStr)
+	if !ok {
+		return types.SponsorPolicy{}, fmt.Errorf("invalid max fee per tx: %s", maxFeePerTxStr)
+	}
+	maxGasPriceStr, err := fs.GetString(FlagMaxGasPrice)
+	if err != nil {
+		return types.SponsorPolicy{}, err
+	}
+	maxGasPrice, err := math.LegacyNewDecFromStr(maxGasPriceStr)
+	if err != nil {
+		return types.SponsorPolicy{}, fmt.Errorf("invalid max gas price: %s", err)
+	}
+
+	return types.NewSponsorPolicy(allowedMsgTypes, allowedContracts, maxTxsPerUser, maxFeesPerUser, maxFeePerTx, maxGasPrice), nil
+}
@@ -31,10 +31,47 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		return err
 	}
 
+	// Prune the expired fee token statistics
+	if err := k.PruneFeeTokenStats(sdkCtx); err != nil {
+		return err
+	}
+
 	// Write the fee token prices to telemetry metrics
 	return k.WriteFeeTokenPricesMetrics(sdkCtx)
 }
 
+// EndBlocker is called at the end of each block to route the fee tokens collected on the block
+// The fees are routed before the distribution module runs on the next begin block, and are
+// settled with the prices calculated on the begin block of the same block
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	// Apply telemetry metrics
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	// Unwrap the context
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Check if the module is enabled
+	params, err := k.Params.Get(sdkCtx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled {
+		return nil
+	}
+
+	// Route the fee tokens collected on the block
+	if err := k.RouteFeeRevenue(sdkCtx); err != nil {
+		return err
+	}
+
+	// Settle the held fee tokens against the native reserve
+	if params.SettlementInterval > 0 && sdkCtx.BlockHeight()%int64(params.SettlementInterval) == 0 {
+		return k.SettleFeeRevenue(sdkCtx)
+	}
+
+	return nil
+}
+
 // WriteFeeTokenPricesMetrics writes the fee token prices to telemetry metrics
 func (k Keeper) WriteFeeTokenPricesMetrics(ctx context.Context) error {
 	// Get the fee token prices
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
@@ -36,9 +39,51 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 	// Call the BeginBlocker
 	s.Require().NoError(s.app.FeeAbstractionKeeper.BeginBlocker(s.ctx))
 
-	// Now the token should be disable due to missing twap
+	// Now the token should be suspended due to missing twap
 	feeTokens, err = s.app.FeeAbstractionKeeper.FeeTokens.Get(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Len(feeTokens.Items, 1)
-	s.Require().False(feeTokens.Items[0].Enabled)
+	s.Require().True(feeTokens.Items[0].Enabled)
+	s.Require().True(feeTokens.Items[0].Suspended)
+}
+
+// TestEndBlocker tests the EndBlocker of the fee abstraction module
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Register the fee token
+	err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Route the token to the treasury and disable the module
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.RevenueRoutes = []types.RevenueRoute{types.NewRevenueRoute("uatom", types.RevenueDestinationTreasury)}
+	params.SettlementInterval = 0
+	params.Enabled = false
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// Collect the fees
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	s.fundFeeCollector(ctx, fees)
+	s.Require().NoError(s.keeper.AddCollectedFees(ctx, fees))
+
+	// Nothing is routed while the module is disabled
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	treasury := authtypes.NewModuleAddress(types.TreasuryName)
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, treasury, "uatom").IsZero())
+
+	// Enable the module
+	params.Enabled = true
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// The BeginBlocker only updates the prices and doesn't route the fees
+	s.Require().NoError(s.keeper.BeginBlocker(ctx))
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, treasury, "uatom").IsZero())
+
+	// The fees collected on the block are routed on the EndBlocker
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	s.Require().Equal(math.NewInt(100), s.app.BankKeeper.GetBalance(ctx, treasury, "uatom").Amount)
 }
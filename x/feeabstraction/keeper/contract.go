@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// ValidateContractAdmin checks if the sender can manage the wasm or EVM contract
+// It guards the sponsor registrations and the contract registrations of other modules, such as the rewards revenue
+// Wasm contracts are managed by themselves or by their admin
+// EVM contracts are managed by themselves or by their deployer, proved by the deployment nonces
+func (k Keeper) ValidateContractAdmin(ctx sdk.Context, sender, contract sdk.AccAddress, nonces []uint64) error {
+	// Check the wasm contract admin
+	if contractInfo := k.wasmKeeper.GetContractInfo(ctx, contract); contractInfo != nil {
+		if !sender.Equals(contract) && contractInfo.Admin != sender.String() {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of contract %s", sender, contract)
+		}
+		return nil
+	}
+
+	// Check if the address is an EVM contract
+	account := k.evmKeeper.GetAccount(ctx, common.BytesToAddress(contract))
+	if account == nil || !account.IsContract() {
+		return errorsmod.Wrapf(types.ErrInvalidSponsor, "%s is not a wasm or EVM contract", contract)
+	}
+	if sender.Equals(contract) {
+		return nil
+	}
+
+	// Derive the contract address from the deployer and the nonces
+	// Each nonce after the first derives a contract deployed by the previous contract
+	if len(nonces) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidSponsor, "deployer nonces are required for EVM contracts")
+	}
+	derived := common.BytesToAddress(sender)
+	for _, nonce := range nonces {
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+	if derived != common.BytesToAddress(contract) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the deployer of contract %s", sender, contract)
+	}
+
+	return nil
+}
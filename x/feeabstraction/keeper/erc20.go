@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// GetBankFees returns the fees that must be deducted from the bank balance
+// Fees on ERC20 fee tokens are collected by the module during the conversion, so they are removed
+func (k Keeper) GetBankFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	// Get the fee tokens
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Filter the fees collected from ERC20 contracts
+	bankFees := sdk.Coins{}
+	for _, fee := range fees {
+		if token, found := feeTokens.GetByDenom(fee.Denom); found && token.IsERC20() {
+			continue
+		}
+		bankFees = append(bankFees, fee)
+	}
+
+	return bankFees, nil
+}
+
+// collectERC20Fee collects the fee on an ERC20 fee token without a token pair
+// The fee is transferred to the module address using the allowance given by the account, an
+// EIP-2612 permit on the context is applied first if it's for the token contract
+// It returns false if the account balance can't pay for the fee
+func (k Keeper) collectERC20Fee(ctx sdk.Context, account sdk.AccAddress, feeToken types.FeeTokenMetadata, amount math.Int) (bool, error) {
+	contract := feeToken.GetERC20Contract()
+	owner := common.BytesToAddress(account)
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+	// Check the account balance
+	// A contract that can't be queried is skipped, so the other fee tokens can be used
+	balance, err := k.callERC20Uint256(ctx, contract, "balanceOf", owner)
+	if err != nil {
+		k.Logger(ctx).Debug("ERC20 fee token skipped", "denom", feeToken.Denom, "reason", err.Error())
+		return false, nil
+	}
+	if balance.LT(amount) {
+		return false, nil
+	}
+
+	// Approve the module address with the permit
+	if permit, found := types.GetERC20PermitFromContext(ctx, contract); found {
+		if _, err := k.callERC20(
+			ctx, contract, true, "permit",
+			owner, moduleAddr, permit.Value.BigInt(), new(big.Int).SetUint64(permit.Deadline),
+			uint8(permit.V), [32]byte(permit.R), [32]byte(permit.S),
+		); err != nil {
+			return false, errorsmod.Wrapf(types.ErrInvalidERC20Permit, "failed to apply the permit: %s", err)
+		}
+	}
+
+	// Check the allowance given to the module address
+	allowance, err := k.callERC20Uint256(ctx, contract, "allowance", owner, moduleAddr)
+	if err != nil {
+		return false, err
+	}
+	if allowance.LT(amount) {
+		return false, nil
+	}
+
+	// Transfer the fee to the module address
+	ret, err := k.callERC20(ctx, contract, true, "transferFrom", owner, moduleAddr, amount.BigInt())
+	if err != nil {
+		return false, err
+	}
+	unpacked, err := types.ERC20PermitABI.Unpack("transferFrom", ret)
+	if err != nil {
+		return false, err
+	}
+	if success, ok := unpacked[0].(bool); !ok || !success {
+		return false, errorsmod.Wrapf(types.ErrERC20FeeCollection, "transfer of %s%s returned false", amount, feeToken.Denom)
+	}
+
+	return true, nil
+}
+
+// SweepERC20Fees sends the ERC20 fees held by the module address to the recipient
+// The whole module balance on the contract is sent, so fee tokens already removed can be swept
+func (k Keeper) SweepERC20Fees(ctx sdk.Context, contract common.Address, recipient sdk.AccAddress) (math.Int, error) {
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+	// Get the fees held by the module address
+	balance, err := k.callERC20Uint256(ctx, contract, "balanceOf", moduleAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if !balance.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(types.ErrERC20FeeCollection, "no fees held on %s", contract)
+	}
+
+	// Transfer the fees to the recipient
+	ret, err := k.callERC20(ctx, contract, true, "transfer", common.BytesToAddress(recipient), balance.BigInt())
+	if err != nil {
+		return math.Int{}, err
+	}
+	unpacked, err := types.ERC20PermitABI.Unpack("transfer", ret)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if success, ok := unpacked[0].(bool); !ok || !success {
+		return math.Int{}, errorsmod.Wrapf(types.ErrERC20FeeCollection, "transfer of %s%s returned false", balance, types.GetERC20FeeTokenDenom(contract))
+	}
+
+	return balance, nil
+}
+
+// callERC20Uint256 calls a view method of the ERC20 contract that returns an uint256
+func (k Keeper) callERC20Uint256(ctx sdk.Context, contract common.Address, method string, args ...interface{}) (math.Int, error) {
+	// Call the contract
+	ret, err := k.callERC20(ctx, contract, false, method, args...)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Unpack the value
+	unpacked, err := types.ERC20PermitABI.Unpack(method, ret)
+	if err != nil {
+		return math.Int{}, err
+	}
+	value, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(types.ErrERC20FeeCollection, "invalid %s response from %s", method, contract)
+	}
+
+	return math.NewIntFromBigInt(value), nil
+}
+
+// callERC20 calls the ERC20 contract from the module address
+// Reverted calls are returned as errors
+func (k Keeper) callERC20(ctx sdk.Context, contract common.Address, commit bool, method string, args ...interface{}) ([]byte, error) {
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	res, err := k.evmKeeper.CallEVM(ctx, types.ERC20PermitABI, moduleAddr, contract, commit, nil, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	if res.Failed() {
+		return nil, errorsmod.Wrapf(types.ErrERC20FeeCollection, "%s call to %s failed: %s", method, contract, res.VmError)
+	}
+	return res.Ret, nil
+}
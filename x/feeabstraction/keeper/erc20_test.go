@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/evm/contracts"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestCollectERC20Fee tests the fee payment with ERC20 fee tokens without a token pair
+func (s *KeeperTestSuite) TestCollectERC20Fee() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Deploy the ERC20 contract
+	contract, err := apptesting.DeployERC20(ctx, s.app)
+	s.Require().NoError(err)
+
+	// Register the ERC20 fee token, 1 token per kii
+	feeToken := types.NewFeeTokenMetadata(types.GetERC20FeeTokenDenom(contract), "erc20oracle", 18, math.LegacyOneDec())
+	feeToken.Erc20Address = contract.Hex()
+	s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(feeToken)))
+
+	// The fee payer only holds the ERC20 token
+	feePayer := apptesting.RandomAccountAddress()
+	owner := common.BytesToAddress(feePayer)
+	amount := convertToMinimalDenomination(1, 18)
+	s.Require().NoError(apptesting.MintERC20(ctx, s.app, contract, owner, amount.MulRaw(2).BigInt()))
+	fees := sdk.NewCoins(sdk.NewCoin("akii", amount))
+
+	// The fee can't be collected without an allowance
+	_, err = s.keeper.ConvertNativeFee(ctx, feePayer, fees)
+	s.Require().ErrorContains(err, "insufficient funds for fee")
+
+	// Approve the module address and pay the fee
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	s.Require().NoError(apptesting.CreateERC20Allowance(ctx, s.app, contract, owner, moduleAddr, amount.BigInt()))
+	charged, err := s.keeper.ConvertNativeFee(ctx, feePayer, fees)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(feeToken.Denom, amount)).String(), charged.String())
+
+	// The fee was transferred to the module address
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	s.Require().Equal(amount.BigInt(), s.app.Erc20Keeper.BalanceOf(ctx, erc20, contract, moduleAddr))
+	s.Require().Equal(amount.BigInt(), s.app.Erc20Keeper.BalanceOf(ctx, erc20, contract, owner))
+
+	// Nothing is left to be deducted from the bank balance
+	bankFees, err := s.keeper.GetBankFees(ctx, charged)
+	s.Require().NoError(err)
+	s.Require().True(bankFees.IsZero())
+
+	// The ERC20 fees are final, they aren't tracked for the revenue routing
+	has, err := s.keeper.CollectedFees.Has(ctx, feeToken.Denom)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.RevenueRoutes = []types.RevenueRoute{types.NewRevenueRoute(feeToken.Denom, types.RevenueDestinationTreasury)}
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+	s.Require().NoError(s.keeper.RouteFeeRevenue(ctx))
+	s.Require().Equal(amount.BigInt(), s.app.Erc20Keeper.BalanceOf(ctx, erc20, contract, moduleAddr))
+}
+
+// TestCollectERC20FeePermit tests the EIP-2612 permit set on the context by the ante handler
+// The test contract has no permit method, so applying the permit fails
+func (s *KeeperTestSuite) TestCollectERC20FeePermit() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Deploy the ERC20 contract
+	contract, err := apptesting.DeployERC20(ctx, s.app)
+	s.Require().NoError(err)
+
+	// Register the ERC20 fee token, 1 token per kii
+	feeToken := types.NewFeeTokenMetadata(types.GetERC20FeeTokenDenom(contract), "erc20oracle", 18, math.LegacyOneDec())
+	feeToken.Erc20Address = contract.Hex()
+	s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(feeToken)))
+
+	// The fee payer holds the ERC20 token and approved the module address
+	feePayer := apptesting.RandomAccountAddress()
+	owner := common.BytesToAddress(feePayer)
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	amount := convertToMinimalDenomination(1, 18)
+	s.Require().NoError(apptesting.MintERC20(ctx, s.app, contract, owner, amount.BigInt()))
+	s.Require().NoError(apptesting.CreateERC20Allowance(ctx, s.app, contract, owner, moduleAddr, amount.BigInt()))
+	fees := sdk.NewCoins(sdk.NewCoin("akii", amount))
+
+	// A permit for the fee token contract is applied, failing on a contract without permit
+	permit := types.NewERC20Permit(contract, amount, 1000, 27, [32]byte{1}, [32]byte{2})
+	permitCtx := ctx.WithValue(types.ContextERC20PermitKey{}, permit)
+	_, err = s.keeper.ConvertNativeFee(permitCtx, feePayer, fees)
+	s.Require().ErrorIs(err, types.ErrInvalidERC20Permit)
+	s.Require().ErrorContains(err, "failed to apply the permit")
+
+	// A permit for another contract is ignored and the allowance pays the fee
+	otherPermit := types.NewERC20Permit(common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"), amount, 1000, 27, [32]byte{1}, [32]byte{2})
+	permitCtx = ctx.WithValue(types.ContextERC20PermitKey{}, otherPermit)
+	charged, err := s.keeper.ConvertNativeFee(permitCtx, feePayer, fees)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(feeToken.Denom, amount)).String(), charged.String())
+}
+
+// TestSweepERC20Fees tests moving the ERC20 fees out of the module address
+func (s *KeeperTestSuite) TestSweepERC20Fees() {
+	ctx, _ := s.ctx.CacheContext()
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := apptesting.RandomAccountAddress()
+
+	// Deploy the ERC20 contract and give fees to the module address
+	contract, err := apptesting.DeployERC20(ctx, s.app)
+	s.Require().NoError(err)
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	amount := convertToMinimalDenomination(1, 18)
+	s.Require().NoError(apptesting.MintERC20(ctx, s.app, contract, moduleAddr, amount.BigInt()))
+
+	// Only the governance can sweep the fees
+	msg := types.NewMessageSweepERC20Fees(authority, contract.Hex(), recipient.String())
+	wrongMsg := types.NewMessageSweepERC20Fees(recipient.String(), contract.Hex(), recipient.String())
+	_, err = s.msgServer.SweepERC20Fees(ctx, wrongMsg)
+	s.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	// The whole module balance is sent to the recipient
+	res, err := s.msgServer.SweepERC20Fees(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(amount, res.Amount)
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	s.Require().Equal(amount.BigInt(), s.app.Erc20Keeper.BalanceOf(ctx, erc20, contract, common.BytesToAddress(recipient)))
+	s.Require().Equal(int64(0), s.app.Erc20Keeper.BalanceOf(ctx, erc20, contract, moduleAddr).Int64())
+
+	// Nothing is left to sweep
+	_, err = s.msgServer.SweepERC20Fees(ctx, msg)
+	s.Require().ErrorContains(err, "no fees held")
+}
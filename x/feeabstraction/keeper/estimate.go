@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// EstimateNativeFee estimates the native fee charged for a gas limit
+// It follows the fee market checker, charging the highest between the base fee and the min gas price
+func (k Keeper) EstimateNativeFee(ctx sdk.Context, gasLimit uint64) (sdk.Coin, error) {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// The min gas price is always rounded up
+	gasPrice := k.feeMarketKeeper.GetParams(ctx).MinGasPrice
+	gasPriceInt := math.ZeroInt()
+	if !gasPrice.IsNil() {
+		gasPriceInt = gasPrice.Ceil().TruncateInt()
+	}
+
+	// Use the base fee if it's higher than the min gas price, rounded up so the estimate is never short
+	baseFee := k.feeMarketKeeper.GetBaseFee(ctx)
+	if !baseFee.IsNil() && baseFee.Ceil().TruncateInt().GT(gasPriceInt) {
+		gasPriceInt = baseFee.Ceil().TruncateInt()
+	}
+
+	// Calculate the fee for the gas limit
+	amount := gasPriceInt.Mul(math.NewIntFromUint64(gasLimit))
+	return sdk.NewCoin(params.NativeDenom, amount), nil
+}
+
+// EstimateFee estimates the fee charged for a gas limit on each enabled fee token
+// If a denom is given only the fee on that token is returned
+func (k Keeper) EstimateFee(ctx sdk.Context, gasLimit uint64, denom string) (sdk.Coin, []sdk.Coin, error) {
+	// Validate the gas limit
+	if gasLimit == 0 {
+		return sdk.Coin{}, nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "gas limit must be positive")
+	}
+
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// Estimate the fee on the native token
+	nativeFee, err := k.EstimateNativeFee(ctx, gasLimit)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// Only the native fee is charged if the module is disabled or the native denom is requested
+	if !params.Enabled || denom == params.NativeDenom {
+		return nativeFee, []sdk.Coin{}, nil
+	}
+
+	// Get the fee tokens
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// Estimate the fee on a single token
+	if denom != "" {
+		feeToken, found := feeTokens.GetByDenom(denom)
+		if !found {
+			return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+		}
+		if !feeToken.IsActive() {
+			return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrFeeTokenDisabled, "denom %s is disabled or suspended", denom)
+		}
+
+		// The token volume caps are checked, so a capped token returns an error
+		amount, err := k.estimateFeeToken(ctx, nativeFee, feeToken)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+		if amount.IsZero() {
+			return nativeFee, []sdk.Coin{}, nil
+		}
+		return nativeFee, []sdk.Coin{sdk.NewCoin(feeToken.Denom, amount)}, nil
+	}
+
+	// Estimate the fee on all the enabled tokens
+	fees, err := k.estimateFeeTokens(ctx, nativeFee, feeTokens.Items)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	return nativeFee, fees, nil
+}
+
+// estimateFeeTokens calculates the amount charged on each enabled fee token for the native fee
+// The fee token order is kept, tokens with a zero amount or over their volume caps are skipped, as they can't pay fees
+func (k Keeper) estimateFeeTokens(ctx sdk.Context, nativeFee sdk.Coin, feeTokens []types.FeeTokenMetadata) ([]sdk.Coin, error) {
+	fees := make([]sdk.Coin, 0, len(feeTokens))
+	for _, feeToken := range feeTokens {
+		// Skip the disabled and suspended tokens
+		if !feeToken.IsActive() {
+			continue
+		}
+
+		// Convert the native fee using the token price
+		amount, err := k.estimateFeeToken(ctx, nativeFee, feeToken)
+		if errors.Is(err, types.ErrFeeTokenVolumeCap) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if amount.IsZero() {
+			continue
+		}
+
+		fees = append(fees, sdk.NewCoin(feeToken.Denom, amount))
+	}
+
+	return fees, nil
+}
+
+// estimateFeeToken calculates the amount charged on a fee token for the native fee
+// An ErrFeeTokenVolumeCap error is returned if the amount goes over the token volume caps
+func (k Keeper) estimateFeeToken(ctx sdk.Context, nativeFee sdk.Coin, feeToken types.FeeTokenMetadata) (math.Int, error) {
+	// Convert the native fee using the token price
+	amountEquivalent, err := convertFeeTokenAmount(nativeFee, feeToken)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Amounts charged as zero can't pay fees
+	if amountEquivalent.RoundInt().IsZero() {
+		return math.ZeroInt(), nil
+	}
+
+	// The charge rounds to the nearest unit, the estimate is rounded up so it's never short
+	amount := amountEquivalent.Ceil().TruncateInt()
+
+	// Check the token volume caps, as the fee is charged
+	usage, err := k.GetFeeTokenUsage(ctx, feeToken.Denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if err := usage.CheckCaps(feeToken, amount); err != nil {
+		return math.Int{}, err
+	}
+
+	return amount, nil
+}
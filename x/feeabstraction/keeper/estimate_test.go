@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestEstimateFee tests the EstimateFee function
+func (s *KeeperTestSuite) TestEstimateFee() {
+	// Default fee tokens used on the tests
+	feeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.123")),
+		types.NewFeeTokenMetadata("mbtc", "btcoracle", 8, math.LegacyMustNewDecFromStr("2")),
+		types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
+	)
+	// Disable the last token
+	feeTokens.Items[2].Enabled = false
+
+	// Build the test cases
+	testCases := []struct {
+		name              string
+		malleate          func(sdk.Context)
+		gasLimit          uint64
+		denom             string
+		expectedNativeFee sdk.Coin
+		expectedFees      []sdk.Coin
+		errContains       string
+	}{
+		{
+			name:              "success - all enabled fee tokens",
+			gasLimit:          100_000,
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees: []sdk.Coin{
+				sdk.NewCoin("uatom", convertToMinimalDenomination(123, 3)), // 0.123 ATOM
+				sdk.NewCoin("mbtc", convertToMinimalDenomination(2, 8)),    // 2 BTC
+			},
+		},
+		{
+			name:              "success - single fee token",
+			gasLimit:          100_000,
+			denom:             "mbtc",
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees: []sdk.Coin{
+				sdk.NewCoin("mbtc", convertToMinimalDenomination(2, 8)), // 2 BTC
+			},
+		},
+		{
+			name:              "success - native denom",
+			gasLimit:          100_000,
+			denom:             "akii",
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees:      []sdk.Coin{},
+		},
+		{
+			name: "success - min gas price higher than the base fee",
+			malleate: func(ctx sdk.Context) {
+				// Set a min gas price above the base fee
+				params := s.app.FeeMarketKeeper.GetParams(ctx)
+				params.MinGasPrice = math.LegacyMustNewDecFromStr("19999999999999.5")
+				s.Require().NoError(s.app.FeeMarketKeeper.SetParams(ctx, params))
+			},
+			gasLimit:          100_000,
+			denom:             "uatom",
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(2, 18)), // 2 KII
+			expectedFees: []sdk.Coin{
+				sdk.NewCoin("uatom", convertToMinimalDenomination(246, 3)), // 0.246 ATOM
+			},
+		},
+		{
+			name: "success - module disabled",
+			malleate: func(ctx sdk.Context) {
+				// Disable the module
+				params, err := s.keeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				params.Enabled = false
+				s.Require().NoError(s.keeper.Params.Set(ctx, params))
+			},
+			gasLimit:          100_000,
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees:      []sdk.Coin{},
+		},
+		{
+			name: "success - amounts rounded to zero are skipped",
+			malleate: func(ctx sdk.Context) {
+				// Set a fee token with a tiny price
+				tinyFeeTokens := types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.000001")),
+				)
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *tinyFeeTokens))
+			},
+			gasLimit:          1,
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 13)),
+			expectedFees:      []sdk.Coin{},
+		},
+		{
+			name: "success - fractional base fee rounded up",
+			malleate: func(ctx sdk.Context) {
+				// Set a base fee just below 10^13 per gas
+				params := s.app.FeeMarketKeeper.GetParams(ctx)
+				params.BaseFee = math.LegacyMustNewDecFromStr("9999999999999.5")
+				s.Require().NoError(s.app.FeeMarketKeeper.SetParams(ctx, params))
+			},
+			gasLimit:          100_000,
+			denom:             "akii",
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees:      []sdk.Coin{},
+		},
+		{
+			name: "success - tokens over their volume caps are skipped",
+			malleate: func(ctx sdk.Context) {
+				// Cap the first token under the estimated fee
+				cappedFeeTokens := append([]types.FeeTokenMetadata{}, feeTokens.Items...)
+				cappedFeeTokens[0].MaxBlockVolume = convertToMinimalDenomination(1, 3)
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(cappedFeeTokens...)))
+			},
+			gasLimit:          100_000,
+			expectedNativeFee: sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), // 1 KII
+			expectedFees: []sdk.Coin{
+				sdk.NewCoin("mbtc", convertToMinimalDenomination(2, 8)), // 2 BTC
+			},
+		},
+		{
+			name: "fail - fee token over its daily volume cap",
+			malleate: func(ctx sdk.Context) {
+				// Cap the first token and use most of the daily volume
+				cappedFeeTokens := append([]types.FeeTokenMetadata{}, feeTokens.Items...)
+				cappedFeeTokens[0].MaxDailyVolume = convertToMinimalDenomination(1, 6)
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(cappedFeeTokens...)))
+				usage := types.NewFeeTokenUsage(ctx.BlockHeight(), types.DayFromUnix(ctx.BlockTime().Unix()))
+				s.Require().NoError(s.keeper.FeeTokenUsages.Set(ctx, "uatom", usage.Add(convertToMinimalDenomination(95, 4))))
+			},
+			gasLimit:    100_000,
+			denom:       "uatom",
+			errContains: types.ErrFeeTokenVolumeCap.Error(),
+		},
+		{
+			name:        "fail - zero gas limit",
+			gasLimit:    0,
+			errContains: "gas limit must be positive",
+		},
+		{
+			name:        "fail - unknown fee token",
+			gasLimit:    100_000,
+			denom:       "unknown",
+			errContains: types.ErrUnknownFeeToken.Error(),
+		},
+		{
+			name: "fail - suspended fee token",
+			malleate: func(ctx sdk.Context) {
+				// Suspend the first token on a copy of the fee tokens
+				suspendedFeeTokens := append([]types.FeeTokenMetadata{}, feeTokens.Items...)
+				suspendedFeeTokens[0].Suspended = true
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(suspendedFeeTokens...)))
+			},
+			gasLimit:    100_000,
+			denom:       "uatom",
+			errContains: types.ErrFeeTokenDisabled.Error(),
+		},
+		{
+			name:        "fail - disabled fee token",
+			gasLimit:    100_000,
+			denom:       "usol",
+			errContains: types.ErrFeeTokenDisabled.Error(),
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Use a cached context for each test case
+			ctx, _ := s.ctx.CacheContext()
+
+			// Set a base fee of 10^13 per gas, so 100k gas costs 1 KII
+			feeMarketParams := s.app.FeeMarketKeeper.GetParams(ctx)
+			feeMarketParams.NoBaseFee = false
+			feeMarketParams.BaseFee = math.LegacyNewDec(10_000_000_000_000)
+			feeMarketParams.MinGasPrice = math.LegacyZeroDec()
+			s.Require().NoError(s.app.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+
+			// Set the fee tokens
+			s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *feeTokens))
+
+			// Apply the test case changes
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// Estimate the fees
+			nativeFee, fees, err := s.keeper.EstimateFee(ctx, tc.gasLimit, tc.denom)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Check the result
+			s.Require().Equal(tc.expectedNativeFee, nativeFee)
+			s.Require().Equal(tc.expectedFees, fees)
+		})
+	}
+}
+
+// TestEstimateFeeCoversCharge tests an account funded with the estimate can pay the fee on a fractional conversion
+func (s *KeeperTestSuite) TestEstimateFeeCoversCharge() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Set a base fee of 10^13 per gas, so 100k gas costs 1 KII
+	feeMarketParams := s.app.FeeMarketKeeper.GetParams(ctx)
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.BaseFee = math.LegacyNewDec(10_000_000_000_000)
+	feeMarketParams.MinGasPrice = math.LegacyZeroDec()
+	s.Require().NoError(s.app.FeeMarketKeeper.SetParams(ctx, feeMarketParams))
+
+	// Set prices converting the fee right under and right at the half unit
+	for _, price := range []string{"0.1234562", "0.1234565"} {
+		feeTokens := types.NewFeeTokenMetadataCollection(
+			types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr(price)),
+		)
+		s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *feeTokens))
+
+		// The estimate rounds the fractional unit up
+		nativeFee, fees, err := s.keeper.EstimateFee(ctx, 100_000, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal([]sdk.Coin{sdk.NewCoin("uatom", math.NewInt(123457))}, fees)
+
+		// An account holding only the estimate pays the fee
+		account := apptesting.RandomAccountAddress()
+		s.fundAccount(ctx, account, sdk.NewCoins(fees...))
+		charged, err := s.keeper.ConvertNativeFeeWithFeeToken(ctx, account, sdk.NewCoins(nativeFee), "uatom")
+		s.Require().NoError(err)
+		s.Require().True(charged.AmountOf("uatom").LTE(fees[0].Amount))
+	}
+}
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -18,7 +23,21 @@ import (
 
 // ConvertNativeFee prepares the user balance for fees though the registered pairs
 // this function considers that the amount passed is the staking denom
+// The fee token stored as the account preference is tried first
 func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error) {
+	// Get the fee token preferred by the account
+	preferredDenom, err := k.GetPreferredFeeToken(ctx, account)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return k.ConvertNativeFeeWithPreference(ctx, account, fees, preferredDenom)
+}
+
+// ConvertNativeFeeWithPreference prepares the user balance for fees though the registered pairs
+// The preferred denom is tried before the native balance and the ordered fee tokens, an empty
+// preferred denom keeps the default behaviour
+func (k Keeper) ConvertNativeFeeWithPreference(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins, preferredDenom string) (sdk.Coins, error) {
 	// Get the module params
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -49,6 +68,19 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 		return fees, nil
 	}
 
+	// Try the preferred fee token first
+	// Preferring the native denom is the same as the default behaviour
+	if preferredDenom != "" && preferredDenom != params.NativeDenom {
+		newFee, price, ok, err := k.convertPreferredFeeToken(ctx, account, fee, preferredDenom)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+		if ok {
+			k.emitConvertFeesEvent(ctx, account, fee, newFee, price)
+			return newFee, nil
+		}
+	}
+
 	// Check for the native fees
 	ok := k.hasSufficientNativeBalance(ctx, account, fee)
 	if ok {
@@ -56,12 +88,77 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 	}
 
 	// Convert ERC20 tokens to fees
-	newFee, price, err := k.convertERC20ForFees(ctx, account, fee)
+	// The preferred denom was already tried, so it's skipped
+	newFee, price, err := k.convertERC20ForFees(ctx, account, fee, preferredDenom)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
 
 	// Emit an event for the fee conversion
+	k.emitConvertFeesEvent(ctx, account, fee, newFee, price)
+
+	return newFee, nil
+}
+
+// ConvertNativeFeeWithFeeToken prepares the account balance to pay the fees with a single fee token
+// Unlike the preference, no other token is tried and an error is returned if the token can't pay
+// This is used by the fee grant allowances spent on a fee token
+func (k Keeper) ConvertNativeFeeWithFeeToken(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins, denom string) (sdk.Coins, error) {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Paying with the native denom needs no conversion
+	if fees.IsZero() || denom == params.NativeDenom {
+		return fees, nil
+	}
+
+	// Only a single native fee can be converted while the module is enabled
+	if !params.Enabled {
+		return sdk.Coins{}, errorsmod.Wrapf(types.ErrFeeTokenDisabled, "fee abstraction is disabled, can't pay fees with %s", denom)
+	}
+	if len(fees) != 1 || fees[0].Denom != params.NativeDenom {
+		return sdk.Coins{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "fees %s can't be converted to %s", fees, denom)
+	}
+	fee := fees[0]
+
+	// Find the fee token
+	feePrices, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	feePrice, found := feePrices.GetByDenom(denom)
+	if !found {
+		return sdk.Coins{}, errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+	}
+	if !feePrice.IsActive() {
+		return sdk.Coins{}, errorsmod.Wrapf(types.ErrFeeTokenDisabled, "fee token %s is not active", denom)
+	}
+
+	// Pay with the fee token
+	newFee, ok, err := k.convertWithFeeToken(ctx, account, fee, feePrice)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if !ok {
+		return sdk.Coins{}, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"insufficient funds to pay fee %s with fee token %s",
+			fee.String(),
+			denom,
+		)
+	}
+
+	// Emit an event for the fee conversion
+	k.emitConvertFeesEvent(ctx, account, fee, newFee, feePrice.Price)
+
+	return newFee, nil
+}
+
+// emitConvertFeesEvent emits the event for a fee conversion
+func (k Keeper) emitConvertFeesEvent(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, newFee sdk.Coins, price math.LegacyDec) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.TypeEventConvertFees,
@@ -71,8 +168,39 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 			sdk.NewAttribute(types.TypeAttributePrice, price.String()),
 		),
 	)
+}
 
-	return newFee, nil
+// GetPreferredFeeToken returns the fee token preferred by the account
+// An empty denom is returned if the account has no preference
+func (k Keeper) GetPreferredFeeToken(ctx context.Context, account sdk.AccAddress) (string, error) {
+	// Get the preference from the store
+	denom, err := k.PreferredFeeTokens.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return denom, err
+}
+
+// SetPreferredFeeToken sets the fee token preferred by the account
+// An empty denom clears the preference
+func (k Keeper) SetPreferredFeeToken(ctx context.Context, account sdk.AccAddress, denom string) error {
+	// Clear the preference if the denom is empty
+	if denom == "" {
+		return k.PreferredFeeTokens.Remove(ctx, account)
+	}
+
+	// The preference must be a registered fee token
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if _, found := feeTokens.GetByDenom(denom); !found {
+		return errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+	}
+
+	// Store the preference
+	return k.PreferredFeeTokens.Set(ctx, account, denom)
 }
 
 // hasSufficientNativeBalance checks if the user has enough balance to pay using the native coin
@@ -82,10 +210,40 @@ func (k Keeper) hasSufficientNativeBalance(ctx sdk.Context, account sdk.AccAddre
 	return balance.Amount.GTE(fee.Amount)
 }
 
+// convertPreferredFeeToken tries to pay the fee with the preferred fee token
+// Unknown or disabled preferences are ignored so the default flow can be used
+func (k Keeper) convertPreferredFeeToken(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, preferredDenom string) (sdk.Coins, math.LegacyDec, bool, error) {
+	// Get the fee prices
+	feePrices, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, math.LegacyDec{}, false, err
+	}
+
+	// Find the preferred fee token
+	feePrice, found := feePrices.GetByDenom(preferredDenom)
+	if !found || !feePrice.IsActive() {
+		return sdk.Coins{}, math.LegacyDec{}, false, nil
+	}
+
+	// Try to pay with the preferred token
+	// A capped token is skipped so the default flow can be used
+	newFee, ok, err := k.convertWithFeeToken(ctx, account, fee, feePrice)
+	if errors.Is(err, types.ErrFeeTokenVolumeCap) {
+		k.Logger(ctx).Debug("preferred fee token skipped", "denom", preferredDenom, "reason", err.Error())
+		return sdk.Coins{}, math.LegacyDec{}, false, nil
+	}
+	if err != nil || !ok {
+		return sdk.Coins{}, math.LegacyDec{}, false, err
+	}
+
+	return newFee, feePrice.Price, true, nil
+}
+
 // convertERC20ForFees prepares the user balance for fees by converting the native coin to the fee token
 // It checks if the user has enough balance in the native token, if not it tries to
 // convert the ERC20 token to the native token
-func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin) (sdk.Coins, math.LegacyDec, error) {
+// The skipped denom is ignored, this is used to avoid trying the preferred token twice
+func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, skipDenom string) (sdk.Coins, math.LegacyDec, error) {
 	// Get the fee prices
 	feePrices, err := k.FeeTokens.Get(ctx)
 	if err != nil {
@@ -93,41 +251,40 @@ func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee
 	}
 
 	// Iterate over the fee prices and try to convert the native fee
+	var capErrs []string
 	for _, feePrice := range feePrices.Items {
 		// Check if the token is enabled
-		if !feePrice.Enabled {
+		if !feePrice.IsActive() || feePrice.Denom == skipDenom {
 			continue
 		}
 
-		// Convert the amount using the price
-		amountEquivalent, err := types.CalculateTokenAmountWithDecimals(
-			feePrice.Price,
-			fee.Amount,
-			params.BaseDenomUnit,
-			uint64(feePrice.Decimals),
-		)
-		if err != nil {
-			return sdk.Coins{}, math.LegacyDec{}, err
-		}
-		// Truncate the decimals
-		amountEquivalentInt := amountEquivalent.RoundInt()
-		// If the amount is zero, we skip this fee token
-		if amountEquivalentInt.IsZero() {
+		// Try to pay with the fee token
+		// Tokens that reached their volume caps are skipped
+		newFee, ok, err := k.convertWithFeeToken(ctx, account, fee, feePrice)
+		if errors.Is(err, types.ErrFeeTokenVolumeCap) {
+			capErrs = append(capErrs, err.Error())
 			continue
 		}
-
-		// Prepare the user balance for fees
-		ok, err := k.convertERC20ToNative(ctx, account, feePrice.Denom, amountEquivalentInt)
 		if err != nil {
 			return sdk.Coins{}, math.LegacyDec{}, err
 		}
 
 		// If all went well we return the selected fee
 		if ok {
-			return sdk.Coins{sdk.NewCoin(feePrice.Denom, amountEquivalentInt)}, feePrice.Price, nil
+			return newFee, feePrice.Price, nil
 		}
 	}
 
+	// If only capped tokens were available we return the cap errors
+	if len(capErrs) > 0 {
+		return sdk.Coins{}, math.LegacyDec{}, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"insufficient funds for fee or no suitable pair found for amount %s, skipped fee tokens: %s",
+			fee.String(),
+			strings.Join(capErrs, "; "),
+		)
+	}
+
 	// If no suitable pair was found we return an error
 	return sdk.Coins{}, math.LegacyDec{}, errorsmod.Wrapf(
 		errortypes.ErrInsufficientFunds,
@@ -136,22 +293,146 @@ func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee
 	)
 }
 
+// convertWithFeeToken calculates the fee on the given fee token and prepares the user balance for it
+// It returns false if the user can't afford the fee with the token
+// An ErrFeeTokenVolumeCap error is returned if the token reached its volume caps
+func (k Keeper) convertWithFeeToken(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, feePrice types.FeeTokenMetadata) (sdk.Coins, bool, error) {
+	// Convert the amount using the price
+	amountEquivalentInt, err := calculateFeeTokenAmount(fee, feePrice)
+	if err != nil {
+		return sdk.Coins{}, false, err
+	}
+	// If the amount is zero, we skip this fee token
+	if amountEquivalentInt.IsZero() {
+		return sdk.Coins{}, false, nil
+	}
+
+	// Check the token volume caps before touching the user balance
+	usage, err := k.GetFeeTokenUsage(ctx, feePrice.Denom)
+	if err != nil {
+		return sdk.Coins{}, false, err
+	}
+	if err := usage.CheckCaps(feePrice, amountEquivalentInt); err != nil {
+		return sdk.Coins{}, false, err
+	}
+
+	// Prepare the user balance for fees
+	// ERC20 fee tokens without a token pair are collected right away
+	var ok bool
+	source := types.FeePaymentSourceERC20Transfer
+	if feePrice.IsERC20() {
+		ok, err = k.collectERC20Fee(ctx, account, feePrice, amountEquivalentInt)
+	} else {
+		ok, source, err = k.convertERC20ToNative(ctx, account, feePrice.Denom, amountEquivalentInt)
+	}
+	if err != nil || !ok {
+		return sdk.Coins{}, false, err
+	}
+
+	// Track the charged amount
+	if err := k.FeeTokenUsages.Set(ctx, feePrice.Denom, usage.Add(amountEquivalentInt)); err != nil {
+		return sdk.Coins{}, false, err
+	}
+
+	// Record the usage statistics, the native value is the original fee
+	if err := k.RecordFeeTokenStats(ctx, feePrice.Denom, amountEquivalentInt, fee.Amount, source); err != nil {
+		return sdk.Coins{}, false, err
+	}
+
+	// Track the fees sent to the fee collector for the revenue routing
+	// ERC20 fee tokens are held by the module address instead
+	newFee := sdk.Coins{sdk.NewCoin(feePrice.Denom, amountEquivalentInt)}
+	if !feePrice.IsERC20() {
+		if err := k.AddCollectedFees(ctx, newFee); err != nil {
+			return sdk.Coins{}, false, err
+		}
+	}
+
+	return newFee, true, nil
+}
+
+// GetFeeTokenUsage returns the usage of the fee token for the current block and day
+func (k Keeper) GetFeeTokenUsage(ctx sdk.Context, denom string) (types.FeeTokenUsage, error) {
+	// Get the current block and day
+	blockHeight := ctx.BlockHeight()
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+
+	// Get the stored usage
+	usage, err := k.FeeTokenUsages.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewFeeTokenUsage(blockHeight, day), nil
+	}
+	if err != nil {
+		return types.FeeTokenUsage{}, err
+	}
+
+	// Reset the volumes of the finished periods
+	return usage.Rollover(blockHeight, day), nil
+}
+
+// calculateFeeTokenAmount calculates the amount charged on the fee token for a native fee
+// The token price multiplier is applied over the oracle price
+func calculateFeeTokenAmount(fee sdk.Coin, feePrice types.FeeTokenMetadata) (math.Int, error) {
+	amountEquivalent, err := convertFeeTokenAmount(fee, feePrice)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Truncate the decimals
+	return amountEquivalent.RoundInt(), nil
+}
+
+// convertFeeTokenAmount converts a native fee to the fee token, keeping the decimals
+func convertFeeTokenAmount(fee sdk.Coin, feePrice types.FeeTokenMetadata) (math.LegacyDec, error) {
+	// Convert the amount using the price
+	return types.CalculateTokenAmountWithDecimals(
+		feePrice.Price.Mul(feePrice.GetEffectivePriceMultiplier()),
+		fee.Amount,
+		params.BaseDenomUnit,
+		uint64(feePrice.Decimals),
+	)
+}
+
+// calculateNativeAmount calculates the native amount equivalent to a fee token amount
+// The oracle price is used without the token price multiplier
+func calculateNativeAmount(amount math.Int, feePrice types.FeeTokenMetadata) (math.Int, error) {
+	// Price zero means no price, so the amount has no value
+	if !feePrice.Price.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	// Convert the amount using the inverse price, since the price is token/native
+	nativeEquivalent, err := types.CalculateTokenAmountWithDecimals(
+		math.LegacyOneDec().Quo(feePrice.Price),
+		amount,
+		uint64(feePrice.Decimals),
+		params.BaseDenomUnit,
+	)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Truncate the decimals
+	return nativeEquivalent.TruncateInt(), nil
+}
+
 // convertERC20ToNative converts the ERC20 token to the native token
 // It checks if the user has enough balance in the native token, if not it tries to
 // convert the ERC20 token to the native token
-func (k Keeper) convertERC20ToNative(ctx sdk.Context, account sdk.AccAddress, denom string, amount math.Int) (bool, error) {
+// It also returns the source used to pay for the fee
+func (k Keeper) convertERC20ToNative(ctx sdk.Context, account sdk.AccAddress, denom string, amount math.Int) (bool, types.FeePaymentSource, error) {
 	// Get the balance for the pair native token on cosmos
 	// and check if the user has enough balance, if so we return true
 	balance := k.bankKeeper.GetBalance(ctx, account, denom)
 	if balance.Amount.GTE(amount) {
-		return true, nil
+		return true, types.FeePaymentSourceBalance, nil
 	}
 
 	// Get the pair ID and check if it exists
 	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
 	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
 	if !found {
-		return false, nil
+		return false, types.FeePaymentSourceBalance, nil
 	}
 
 	// Take the ABI
@@ -171,11 +452,11 @@ func (k Keeper) convertERC20ToNative(ctx sdk.Context, account sdk.AccAddress, de
 			common.BytesToAddress(account.Bytes()),
 		)
 		if _, err := k.erc20Keeper.ConvertERC20(ctx, msg); err != nil {
-			return false, err
+			return false, types.FeePaymentSourceBalance, err
 		}
-		return true, nil
+		return true, types.FeePaymentSourceERC20Unwrap, nil
 	}
 
 	// If the user does not have enough balance, we return false
-	return false, nil
+	return false, types.FeePaymentSourceBalance, nil
 }
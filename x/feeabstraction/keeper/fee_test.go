@@ -156,6 +156,131 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),  // 0.1 Kii
 			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(125, 5))), // 0.00125 usol
 		},
+		{
+			name: "success - stored preference is used before the native balance",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register multiple fee tokens
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.123")),
+					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with native and both fee tokens
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 18))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(1, 18))))
+
+				// Prefer the last fee token
+				s.Require().NoError(s.keeper.SetPreferredFeeToken(ctx, feePayer, "usol"))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),  // 0.1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(125, 5))), // 0.0125 USOL
+		},
+		{
+			name: "success - unaffordable preference falls back to the ordered fee tokens",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register multiple fee tokens
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.123")),
+					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user only with the first fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 18))))
+
+				// Prefer the token the user doesn't have
+				s.Require().NoError(s.keeper.SetPreferredFeeToken(ctx, feePayer, "usol"))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),   // 0.1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(123, 2))), // 0.0123 ATOM
+		},
+		{
+			name: "success - disabled preference is ignored",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register the preferred fee token as disabled
+				disabled := types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125"))
+				disabled.Enabled = false
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(disabled))
+				s.Require().NoError(err)
+
+				// Fund the user with native and the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(1, 18))))
+
+				// Prefer the disabled token
+				s.Require().NoError(s.keeper.PreferredFeeTokens.Set(ctx, feePayer, "usol"))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),
+			expected: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),
+		},
+		{
+			name: "success - price multiplier adds a premium",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a 10% premium
+				premium := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				premium.PriceMultiplier = math.LegacyMustNewDecFromStr("1.1")
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(premium))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 18))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))),  // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(11, 5))), // 1.1 Atom
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The usage is tracked
+				usage, err := s.keeper.GetFeeTokenUsage(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().Equal(convertToMinimalDenomination(11, 5), usage.BlockVolume)
+				s.Require().Equal(convertToMinimalDenomination(11, 5), usage.DailyVolume)
+			},
+		},
+		{
+			name: "success - capped token is skipped",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a capped fee token followed by an uncapped one
+				capped := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				capped.MaxBlockVolume = convertToMinimalDenomination(1, 6)
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					capped,
+					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
+				))
+				s.Require().NoError(err)
+
+				// Part of the block volume was already used
+				usage := types.NewFeeTokenUsage(ctx.BlockHeight(), types.DayFromUnix(ctx.BlockTime().Unix()))
+				s.Require().NoError(s.keeper.FeeTokenUsages.Set(ctx, "uatom", usage.Add(convertToMinimalDenomination(95, 4))))
+
+				// Fund the user with both fee tokens
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 18))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(1, 18))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),  // 0.1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(125, 5))), // 0.0125 USOL
+		},
+		{
+			name: "fail - all tokens capped",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a capped fee token
+				capped := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				capped.MaxDailyVolume = convertToMinimalDenomination(1, 4)
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(capped))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 18))))
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))), // 0.1 Kii
+			errContains: "fee token uatom daily volume cap of 10000 reached",
+		},
 		{
 			name: "fail - token with price as zero",
 			malleate: func(ctx sdk.Context) sdk.Context {
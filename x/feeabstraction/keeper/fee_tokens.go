@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// AddFeeToken adds a single fee token to the end of the fee token list
+// The runtime state of the other fee tokens is kept untouched
+func (k Keeper) AddFeeToken(ctx sdk.Context, feeToken types.FeeTokenMetadata) error {
+	// Validate the oracle denom and the token denom
+	if err := k.validateOracleDenoms(ctx, feeToken.OracleDenom); err != nil {
+		return err
+	}
+	if err := k.validateFeeTokenDenom(ctx, feeToken); err != nil {
+		return err
+	}
+
+	// Get the current fee tokens
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The token can't be registered twice
+	if _, found := feeTokens.GetByDenom(feeToken.Denom); found {
+		return errorsmod.Wrapf(types.ErrInvalidFeeTokenMetadata, "fee token %s is already registered", feeToken.Denom)
+	}
+
+	// Append and validate the new collection
+	feeTokens.Items = append(feeTokens.Items, feeToken)
+	if err := feeTokens.Validate(); err != nil {
+		return err
+	}
+
+	return k.FeeTokens.Set(ctx, feeTokens)
+}
+
+// RegisterFeeToken registers a fee token from an ERC20 token pair or an IBC denom
+// The decimals are derived from the bank metadata or the ERC20 contract, and the initial
+// price is seeded from the current oracle TWAP
+func (k Keeper) RegisterFeeToken(ctx sdk.Context, token, oracleDenom string) (types.FeeTokenMetadata, error) {
+	// Validate the oracle denom
+	if err := k.validateOracleDenoms(ctx, oracleDenom); err != nil {
+		return types.FeeTokenMetadata{}, err
+	}
+
+	// Find the denom and the decimals of the token
+	denom, decimals, err := k.resolveFeeTokenSource(ctx, token)
+	if err != nil {
+		return types.FeeTokenMetadata{}, err
+	}
+
+	// Seed the price from the TWAP
+	price, err := k.getTwapFeeTokenPrice(ctx, oracleDenom)
+	if err != nil {
+		return types.FeeTokenMetadata{}, err
+	}
+
+	// Add the fee token
+	feeToken := types.NewFeeTokenMetadata(denom, oracleDenom, decimals, price)
+	if err := k.AddFeeToken(ctx, feeToken); err != nil {
+		return types.FeeTokenMetadata{}, err
+	}
+
+	return feeToken, nil
+}
+
+// RemoveFeeToken removes a single fee token from the fee token list
+// Stored preferences for the token are ignored once it's removed
+// Tokens with revenue pending settlement can't be removed, as the settlement needs the token price
+func (k Keeper) RemoveFeeToken(ctx sdk.Context, denom string) error {
+	// Get the current fee tokens
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Filter out the token, keeping the order of the others
+	items := make([]types.FeeTokenMetadata, 0, len(feeTokens.Items))
+	for _, token := range feeTokens.Items {
+		if token.Denom != denom {
+			items = append(items, token)
+		}
+	}
+	if len(items) == len(feeTokens.Items) {
+		return errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+	}
+
+	// Clear the token, failing if it has revenue pending settlement
+	if err := k.clearRemovedFeeToken(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(items...))
+}
+
+// UpdateFeeTokens replaces the whole fee token list
+// New tokens go through the same validation as AddFeeToken, and dropped tokens through the
+// same checks as RemoveFeeToken
+func (k Keeper) UpdateFeeTokens(ctx sdk.Context, feeTokens types.FeeTokenMetadataCollection) error {
+	// Validate the collection
+	if err := feeTokens.Validate(); err != nil {
+		return err
+	}
+
+	// Get the current fee tokens
+	current, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validate the oracle denoms of all the tokens
+	oracleDenoms := make([]string, 0, len(feeTokens.Items))
+	for _, feeToken := range feeTokens.Items {
+		oracleDenoms = append(oracleDenoms, feeToken.OracleDenom)
+	}
+	if err := k.validateOracleDenoms(ctx, oracleDenoms...); err != nil {
+		return err
+	}
+
+	// Validate the token denom of the new tokens, the registered ones were validated when added
+	for _, feeToken := range feeTokens.Items {
+		if _, found := current.GetByDenom(feeToken.Denom); found {
+			continue
+		}
+		if err := k.validateFeeTokenDenom(ctx, feeToken); err != nil {
+			return err
+		}
+	}
+
+	// Clear the dropped tokens
+	for _, token := range current.Items {
+		if _, found := feeTokens.GetByDenom(token.Denom); found {
+			continue
+		}
+		if err := k.clearRemovedFeeToken(ctx, token.Denom); err != nil {
+			return err
+		}
+	}
+
+	return k.FeeTokens.Set(ctx, feeTokens)
+}
+
+// SetFeeTokenEnabled enables or disables a single fee token
+// The price and the suspension state are kept, so the oracle checks keep working as usual
+func (k Keeper) SetFeeTokenEnabled(ctx sdk.Context, denom string, enabled bool) error {
+	// Get the current fee tokens
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Find and update the token
+	for i, token := range feeTokens.Items {
+		if token.Denom == denom {
+			feeTokens.Items[i].Enabled = enabled
+			return k.FeeTokens.Set(ctx, feeTokens)
+		}
+	}
+
+	return errorsmod.Wrapf(types.ErrUnknownFeeToken, "denom %s is not registered as a fee token", denom)
+}
+
+// clearRemovedFeeToken clears the tracked usage of a fee token being removed
+// Tokens with revenue pending settlement can't be removed, as the settlement needs the token price
+func (k Keeper) clearRemovedFeeToken(ctx sdk.Context, denom string) error {
+	// Check for revenue pending settlement
+	revenue, err := k.FeeRevenues.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && revenue.PendingSettlement.IsPositive() {
+		return errorsmod.Wrapf(
+			types.ErrFeeTokenPendingRevenue,
+			"denom %s has %s pending settlement, disable the token until it's settled",
+			denom, revenue.PendingSettlement,
+		)
+	}
+
+	// Clear the tracked usage of the token
+	return k.FeeTokenUsages.Remove(ctx, denom)
+}
+
+// validateOracleDenoms checks if all the oracle denoms are vote targets on the oracle module
+func (k Keeper) validateOracleDenoms(ctx sdk.Context, oracleDenoms ...string) error {
+	// Get the vote targets from the oracle module
+	voteTargets, err := k.oracleKeeper.GetVoteTargets(ctx)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("failed to get oracle vote targets: %s", err)
+	}
+	voteTargetMap := make(map[string]struct{}, len(voteTargets))
+	for _, denom := range voteTargets {
+		voteTargetMap[denom] = struct{}{}
+	}
+
+	// Check each oracle denom
+	for _, oracleDenom := range oracleDenoms {
+		if _, ok := voteTargetMap[oracleDenom]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s is not registered on the oracle module", oracleDenom)
+		}
+	}
+
+	return nil
+}
+
+// validateFeeTokenDenom checks if the token exists, either on the bank module or as an ERC20 token pair
+// ERC20 fee tokens must reference an EVM contract without a token pair
+func (k Keeper) validateFeeTokenDenom(ctx sdk.Context, feeToken types.FeeTokenMetadata) error {
+	// Check the ERC20 contract
+	if feeToken.IsERC20() {
+		contract := feeToken.GetERC20Contract()
+		account := k.evmKeeper.GetAccount(ctx, contract)
+		if account == nil || !account.IsContract() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s is not an EVM contract", contract)
+		}
+		if len(k.erc20Keeper.GetTokenPairID(ctx, contract.Hex())) > 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("ERC20 %s has a token pair, its denom must be used instead", contract)
+		}
+		return nil
+	}
+
+	// Check the bank supply
+	if k.bankKeeper.HasSupply(ctx, feeToken.Denom) {
+		return nil
+	}
+
+	// Check the ERC20 token pairs
+	if len(k.erc20Keeper.GetTokenPairID(ctx, feeToken.Denom)) > 0 {
+		return nil
+	}
+
+	return sdkerrors.ErrInvalidRequest.Wrapf("denom %s has no supply and no ERC20 token pair", feeToken.Denom)
+}
+
+// resolveFeeTokenSource returns the denom and the decimals of an ERC20 token pair or an IBC denom
+// Token pairs are found by their contract or denom, and their decimals come from the bank metadata
+// or the ERC20 contract
+func (k Keeper) resolveFeeTokenSource(ctx sdk.Context, token string) (string, uint32, error) {
+	// Check the ERC20 token pairs
+	if pairID := k.erc20Keeper.GetTokenPairID(ctx, token); len(pairID) > 0 {
+		pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+		if !found {
+			return "", 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "token pair for %s not found", token)
+		}
+
+		// Prefer the bank metadata, then ask the contract
+		if decimals, found := k.getMetadataDecimals(ctx, pair.Denom); found {
+			return pair.Denom, decimals, nil
+		}
+		decimals, err := k.getERC20Decimals(ctx, pair.GetERC20Contract())
+		if err != nil {
+			return "", 0, err
+		}
+		return pair.Denom, decimals, nil
+	}
+
+	// IBC denoms must have a known denom trace
+	hash, found := strings.CutPrefix(token, transfertypes.DenomPrefix+"/")
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "%s is neither an ERC20 token pair nor an IBC denom", token)
+	}
+	denomHash, err := transfertypes.ParseHexHash(hash)
+	if err != nil {
+		return "", 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "invalid IBC denom %s: %s", token, err)
+	}
+	if _, found := k.transferKeeper.GetDenom(ctx, denomHash); !found {
+		return "", 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "denom trace for %s not found", token)
+	}
+
+	// IBC denoms rely on the bank metadata for the decimals
+	decimals, found := k.getMetadataDecimals(ctx, token)
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "bank metadata for %s has no display decimals", token)
+	}
+
+	return token, decimals, nil
+}
+
+// getMetadataDecimals returns the exponent of the display unit on the bank metadata
+// It returns false if the metadata doesn't exist or has no display unit with decimals
+func (k Keeper) getMetadataDecimals(ctx sdk.Context, denom string) (uint32, bool) {
+	// Get the bank metadata
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return 0, false
+	}
+
+	// Find the display unit
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display && unit.Exponent > 0 {
+			return unit.Exponent, true
+		}
+	}
+
+	return 0, false
+}
+
+// getERC20Decimals returns the decimals of an ERC20 contract
+func (k Keeper) getERC20Decimals(ctx sdk.Context, contract common.Address) (uint32, error) {
+	// Call the contract
+	ret, err := k.callERC20(ctx, contract, false, "decimals")
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "failed to get the decimals of %s: %s", contract, err)
+	}
+
+	// Unpack the value
+	unpacked, err := types.ERC20PermitABI.Unpack("decimals", ret)
+	if err != nil {
+		return 0, err
+	}
+	decimals, ok := unpacked[0].(uint8)
+	if !ok {
+		return 0, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "invalid decimals response from %s", contract)
+	}
+
+	return uint32(decimals), nil
+}
+
+// getTwapFeeTokenPrice returns the price of a fee token from the current oracle TWAPs
+// The token TWAP must cover enough of the lookback window
+func (k Keeper) getTwapFeeTokenPrice(ctx sdk.Context, oracleDenom string) (math.LegacyDec, error) {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// Get the token TWAP
+	twapPriceMap, lowCoverage, baseTokenPrice := k.getTwapPrices(ctx, params)
+	tokenPrice, ok := twapPriceMap[oracleDenom]
+	if _, low := lowCoverage[oracleDenom]; !ok || low || !tokenPrice.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrFeeTokenRegistration, "no TWAP with enough coverage for %s", oracleDenom)
+	}
+
+	// Calculate the price in terms of the base token
+	return types.CalculateTokenPrice(baseTokenPrice, tokenPrice)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
@@ -19,7 +21,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	// Set the fee tokens
-	return k.FeeTokens.Set(ctx, *gs.FeeTokens)
+	if err := k.FeeTokens.Set(ctx, *gs.FeeTokens); err != nil {
+		return err
+	}
+
+	// Set the preferred fee tokens
+	for _, preference := range gs.PreferredFeeTokens {
+		account, err := sdk.AccAddressFromBech32(preference.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.PreferredFeeTokens.Set(ctx, account, preference.Denom); err != nil {
+			return err
+		}
+	}
+
+	// Set the sponsors
+	for _, sponsor := range gs.Sponsors {
+		contract, err := sdk.AccAddressFromBech32(sponsor.Contract)
+		if err != nil {
+			return err
+		}
+		if err := k.Sponsors.Set(ctx, contract, sponsor); err != nil {
+			return err
+		}
+	}
+
+	// Set the sponsor usages
+	for _, entry := range gs.SponsorUsages {
+		contract, err := sdk.AccAddressFromBech32(entry.Sponsor)
+		if err != nil {
+			return err
+		}
+		user, err := sdk.AccAddressFromBech32(entry.User)
+		if err != nil {
+			return err
+		}
+		if err := k.SponsorUsages.Set(ctx, collections.Join(contract, user), entry.Usage); err != nil {
+			return err
+		}
+	}
+
+	// Set the fee revenues
+	for _, revenue := range gs.FeeRevenues {
+		if err := k.FeeRevenues.Set(ctx, revenue.Denom, revenue); err != nil {
+			return err
+		}
+	}
+
+	// Set the fee token statistics
+	for _, stats := range gs.FeeTokenStats {
+		if err := k.FeeTokenStats.Set(ctx, collections.Join(stats.Day, stats.Denom), stats); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis reads the module collections and return the genesis state
@@ -36,6 +93,69 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 		return nil, err
 	}
 
+	// Get the preferred fee tokens
+	var preferredFeeTokens []types.PreferredFeeToken
+	err = k.PreferredFeeTokens.Walk(ctx, nil, func(account sdk.AccAddress, denom string) (bool, error) {
+		preferredFeeTokens = append(preferredFeeTokens, types.PreferredFeeToken{
+			Address: account.String(),
+			Denom:   denom,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the sponsors
+	var sponsors []types.Sponsor
+	err = k.Sponsors.Walk(ctx, nil, func(_ sdk.AccAddress, sponsor types.Sponsor) (bool, error) {
+		sponsors = append(sponsors, sponsor)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the sponsor usages
+	var sponsorUsages []types.SponsorUsageEntry
+	err = k.SponsorUsages.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage types.SponsorUsage) (bool, error) {
+		sponsorUsages = append(sponsorUsages, types.SponsorUsageEntry{
+			Sponsor: key.K1().String(),
+			User:    key.K2().String(),
+			Usage:   usage,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fee revenues
+	var feeRevenues []types.FeeRevenue
+	err = k.FeeRevenues.Walk(ctx, nil, func(_ string, revenue types.FeeRevenue) (bool, error) {
+		feeRevenues = append(feeRevenues, revenue)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fee token statistics
+	var feeTokenStats []types.FeeTokenStats
+	err = k.FeeTokenStats.Walk(ctx, nil, func(_ collections.Pair[int64, string], stats types.FeeTokenStats) (bool, error) {
+		feeTokenStats = append(feeTokenStats, stats)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Return the genesis state
-	return types.NewGenesisState(params, &feeTokens), nil
+	genesis := types.NewGenesisState(params, &feeTokens)
+	genesis.PreferredFeeTokens = preferredFeeTokens
+	genesis.Sponsors = sponsors
+	genesis.SponsorUsages = sponsorUsages
+	genesis.FeeRevenues = feeRevenues
+	genesis.FeeTokenStats = feeTokenStats
+	return genesis, nil
 }
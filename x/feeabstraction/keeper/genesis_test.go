@@ -1,6 +1,9 @@
 package keeper_test
 
-import "github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+import (
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
 
 // TestGenesisInitExport tests the InitGenesis and ExportGenesis
 func (s *KeeperTestSuite) TestGenesisInitExport() {
@@ -19,7 +22,14 @@ func (s *KeeperTestSuite) TestGenesisInitExport() {
 		types.DefaultFallbackNativePrice,
 		types.DefaultTwapLookbackWindow,
 		true,
+		types.DefaultReenableBlocks,
 	)
+	genesisState.FeeTokens = types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("coin", "coinoracle", 6, types.DefaultClampFactor),
+	)
+	genesisState.PreferredFeeTokens = []types.PreferredFeeToken{
+		{Address: apptesting.RandomAccountAddress().String(), Denom: "coin"},
+	}
 
 	// Apply the init genesis
 	err = s.keeper.InitGenesis(s.ctx, *genesisState)
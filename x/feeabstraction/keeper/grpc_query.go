@@ -3,6 +3,14 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
@@ -42,3 +50,196 @@ func (q Querier) FeeTokens(ctx context.Context, _ *types.QueryFeeTokensRequest)
 	// Return the response with the fee tokens
 	return &types.QueryFeeTokensResponse{FeeTokens: &feeTokens}, nil
 }
+
+// PreferredFeeToken queries the fee token preferred by an account
+func (q Querier) PreferredFeeToken(ctx context.Context, req *types.QueryPreferredFeeTokenRequest) (*types.QueryPreferredFeeTokenResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Parse the account address
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	// Get the preference from the keeper
+	denom, err := q.Keeper.GetPreferredFeeToken(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the preference
+	return &types.QueryPreferredFeeTokenResponse{Denom: denom}, nil
+}
+
+// EstimateFee queries the fee charged for a gas limit on the fee tokens
+func (q Querier) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.GasLimit == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gas limit must be positive")
+	}
+
+	// Estimate the fees
+	nativeFee, fees, err := q.Keeper.EstimateFee(sdk.UnwrapSDKContext(ctx), req.GasLimit, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the estimated fees
+	return &types.QueryEstimateFeeResponse{NativeFee: nativeFee, Fees: fees}, nil
+}
+
+// Sponsor queries a fee sponsor
+func (q Querier) Sponsor(ctx context.Context, req *types.QuerySponsorRequest) (*types.QuerySponsorResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Parse the contract address
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err)
+	}
+
+	// Get the sponsor from the keeper
+	sponsor, err := q.Keeper.GetSponsor(ctx, contract)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// Return the response with the sponsor
+	return &types.QuerySponsorResponse{Sponsor: sponsor}, nil
+}
+
+// Sponsors queries all the fee sponsors
+func (q Querier) Sponsors(ctx context.Context, req *types.QuerySponsorsRequest) (*types.QuerySponsorsResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Paginate over the sponsors
+	sponsors, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.Sponsors,
+		req.Pagination,
+		func(_ sdk.AccAddress, sponsor types.Sponsor) (types.Sponsor, error) {
+			return sponsor, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Return the response with the sponsors
+	return &types.QuerySponsorsResponse{Sponsors: sponsors, Pagination: pageRes}, nil
+}
+
+// SponsorUsage queries the usage of a fee sponsor by a user
+func (q Querier) SponsorUsage(ctx context.Context, req *types.QuerySponsorUsageRequest) (*types.QuerySponsorUsageResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Parse the addresses
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err)
+	}
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user address: %s", err)
+	}
+
+	// Get the usage from the keeper
+	usage, err := q.Keeper.GetSponsorUsage(ctx, contract, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the usage
+	return &types.QuerySponsorUsageResponse{Usage: usage}, nil
+}
+
+// FeeRevenue queries the fees collected and routed on a fee token
+func (q Querier) FeeRevenue(ctx context.Context, req *types.QueryFeeRevenueRequest) (*types.QueryFeeRevenueResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err)
+	}
+
+	// Get the revenue from the keeper
+	revenue, err := q.Keeper.GetFeeRevenue(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the revenue
+	return &types.QueryFeeRevenueResponse{Revenue: revenue}, nil
+}
+
+// FeeRevenues queries the fees collected and routed on all the fee tokens
+func (q Querier) FeeRevenues(ctx context.Context, req *types.QueryFeeRevenuesRequest) (*types.QueryFeeRevenuesResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Paginate over the revenues
+	revenues, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.FeeRevenues,
+		req.Pagination,
+		func(_ string, revenue types.FeeRevenue) (types.FeeRevenue, error) {
+			return revenue, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Return the response with the revenues
+	return &types.QueryFeeRevenuesResponse{Revenues: revenues, Pagination: pageRes}, nil
+}
+
+// FeeTokenStats queries the daily usage statistics of the fee tokens
+func (q Querier) FeeTokenStats(ctx context.Context, req *types.QueryFeeTokenStatsRequest) (*types.QueryFeeTokenStatsResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err)
+		}
+	}
+
+	// Paginate over the statistics, filtered by the denom if given
+	stats, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.Keeper.FeeTokenStats,
+		req.Pagination,
+		func(key collections.Pair[int64, string], _ types.FeeTokenStats) (bool, error) {
+			return req.Denom == "" || key.K2() == req.Denom, nil
+		},
+		func(_ collections.Pair[int64, string], stats types.FeeTokenStats) (types.FeeTokenStats, error) {
+			return stats, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Return the response with the statistics
+	return &types.QueryFeeTokenStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
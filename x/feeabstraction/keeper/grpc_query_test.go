@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
@@ -16,6 +19,7 @@ func (s *KeeperTestSuite) TestQuerierParams() {
 		types.DefaultFallbackNativePrice,
 		types.DefaultTwapLookbackWindow,
 		false,
+		types.DefaultReenableBlocks,
 	)
 
 	// Set the params in the keeper
@@ -48,3 +52,60 @@ func (s *KeeperTestSuite) TestQuerierFeeTokens() {
 	// Check the response
 	s.Require().Equal(newFeeTokens, res.FeeTokens)
 }
+
+// TestQuerierPreferredFeeToken tests the PreferredFeeToken querier
+func (s *KeeperTestSuite) TestQuerierPreferredFeeToken() {
+	// The account with the preference
+	account := apptesting.RandomAccountAddress()
+
+	// Query without a preference
+	res, err := s.querier.PreferredFeeToken(s.ctx, &types.QueryPreferredFeeTokenRequest{Address: account.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Denom)
+
+	// Set the preference in the keeper
+	err = s.keeper.PreferredFeeTokens.Set(s.ctx, account, "testcoin")
+	s.Require().NoError(err)
+
+	// Query the preference
+	res, err = s.querier.PreferredFeeToken(s.ctx, &types.QueryPreferredFeeTokenRequest{Address: account.String()})
+	s.Require().NoError(err)
+	s.Require().Equal("testcoin", res.Denom)
+
+	// Query with an invalid address
+	_, err = s.querier.PreferredFeeToken(s.ctx, &types.QueryPreferredFeeTokenRequest{Address: "invalid"})
+	s.Require().ErrorContains(err, "invalid address")
+}
+
+// TestQuerierEstimateFee tests the EstimateFee querier
+func (s *KeeperTestSuite) TestQuerierEstimateFee() {
+	// Set a known base fee
+	feeMarketParams := s.app.FeeMarketKeeper.GetParams(s.ctx)
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.BaseFee = math.LegacyNewDec(10_000_000_000_000)
+	feeMarketParams.MinGasPrice = math.LegacyZeroDec()
+	s.Require().NoError(s.app.FeeMarketKeeper.SetParams(s.ctx, feeMarketParams))
+
+	// Set the fee tokens in the keeper
+	feeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+	)
+	err := s.keeper.FeeTokens.Set(s.ctx, *feeTokens)
+	s.Require().NoError(err)
+
+	// Query the estimation
+	res, err := s.querier.EstimateFee(s.ctx, &types.QueryEstimateFeeRequest{GasLimit: 100_000})
+	s.Require().NoError(err)
+
+	// Check the response
+	s.Require().Equal(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)), res.NativeFee)
+	s.Require().Equal([]sdk.Coin{sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))}, res.Fees)
+
+	// Query with a zero gas limit
+	_, err = s.querier.EstimateFee(s.ctx, &types.QueryEstimateFeeRequest{})
+	s.Require().ErrorContains(err, "gas limit must be positive")
+
+	// Query with an unknown denom
+	_, err = s.querier.EstimateFee(s.ctx, &types.QueryEstimateFeeRequest{GasLimit: 100_000, Denom: "unknown"})
+	s.Require().ErrorIs(err, types.ErrUnknownFeeToken)
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,9 +20,14 @@ type Keeper struct {
 	cdc codec.BinaryCodec
 
 	// Modules used on the keeper
-	bankKeeper   types.BankKeeper
-	erc20Keeper  types.Erc20Keeper
-	oracleKeeper types.OracleKeeper
+	bankKeeper      types.BankKeeper
+	erc20Keeper     types.Erc20Keeper
+	oracleKeeper    types.OracleKeeper
+	feeMarketKeeper types.FeeMarketKeeper
+	evmKeeper       types.EVMKeeper
+	wasmKeeper      types.WasmKeeper
+	distrKeeper     types.DistributionKeeper
+	transferKeeper  types.TransferKeeper
 
 	// The governance authority
 	authority string
@@ -30,27 +36,90 @@ type Keeper struct {
 	Schema    collections.Schema
 	Params    collections.Item[types.Params]
 	FeeTokens collections.Item[types.FeeTokenMetadataCollection]
+
+	// PreferredFeeTokens maps an account to the fee token it prefers to pay with
+	PreferredFeeTokens collections.Map[sdk.AccAddress, string]
+
+	// FeeTokenUsages maps a fee token denom to the amount charged as fees on the current block and day
+	FeeTokenUsages collections.Map[string, types.FeeTokenUsage]
+
+	// Sponsors maps a contract to its fee sponsor information
+	Sponsors collections.Map[sdk.AccAddress, types.Sponsor]
+
+	// SponsorUsages maps a sponsor and a user to the user sponsor usage
+	SponsorUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.SponsorUsage]
+
+	// FeeRevenues maps a fee token denom to the fees collected and routed on it
+	FeeRevenues collections.Map[string, types.FeeRevenue]
+
+	// FeeTokenStats maps a day and a fee token denom to the token usage statistics on the day
+	FeeTokenStats collections.Map[collections.Pair[int64, string], types.FeeTokenStats]
+
+	// CollectedFees maps a fee token denom to the fees sent to the fee collector since the last routing
+	CollectedFees collections.Map[string, math.Int]
+
+	// TransientSchema is the schema of the entries on the transient store
+	TransientSchema collections.Schema
+
+	// ChargedFees maps a tx hash and an account to the fee token charged for its EVM msg
+	ChargedFees collections.Map[collections.Pair[[]byte, sdk.AccAddress], types.ChargedFee]
 }
 
 // NewKeeper creates a new instance of the Keeper
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	transientStoreService store.TransientStoreService,
 	erc20Keeper types.Erc20Keeper, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper,
+	feeMarketKeeper types.FeeMarketKeeper, evmKeeper types.EVMKeeper, wasmKeeper types.WasmKeeper,
+	distrKeeper types.DistributionKeeper, transferKeeper types.TransferKeeper,
 	authority string,
 ) Keeper {
 	// Start a new schema builder
 	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
 
 	// Initialize the keeper
 	k := Keeper{
-		cdc:          cdc,
-		erc20Keeper:  erc20Keeper,
-		bankKeeper:   bankKeeper,
-		oracleKeeper: oracleKeeper,
-		authority:    authority,
-		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeeTokens:    collections.NewItem(sb, types.FeeTokensKey, "fee_tokens", codec.CollValue[types.FeeTokenMetadataCollection](cdc)),
+		cdc:             cdc,
+		erc20Keeper:     erc20Keeper,
+		bankKeeper:      bankKeeper,
+		oracleKeeper:    oracleKeeper,
+		feeMarketKeeper: feeMarketKeeper,
+		evmKeeper:       evmKeeper,
+		wasmKeeper:      wasmKeeper,
+		distrKeeper:     distrKeeper,
+		transferKeeper:  transferKeeper,
+		authority:       authority,
+		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeeTokens:       collections.NewItem(sb, types.FeeTokensKey, "fee_tokens", codec.CollValue[types.FeeTokenMetadataCollection](cdc)),
+		PreferredFeeTokens: collections.NewMap(
+			sb, types.PreferredFeeTokensKey, "preferred_fee_tokens", sdk.AccAddressKey, collections.StringValue,
+		),
+		FeeTokenUsages: collections.NewMap(
+			sb, types.FeeTokenUsagesKey, "fee_token_usages", collections.StringKey, codec.CollValue[types.FeeTokenUsage](cdc),
+		),
+		Sponsors: collections.NewMap(
+			sb, types.SponsorsKey, "sponsors", sdk.AccAddressKey, codec.CollValue[types.Sponsor](cdc),
+		),
+		SponsorUsages: collections.NewMap(
+			sb, types.SponsorUsagesKey, "sponsor_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.SponsorUsage](cdc),
+		),
+		FeeRevenues: collections.NewMap(
+			sb, types.FeeRevenuesKey, "fee_revenues", collections.StringKey, codec.CollValue[types.FeeRevenue](cdc),
+		),
+		FeeTokenStats: collections.NewMap(
+			sb, types.FeeTokenStatsKey, "fee_token_stats",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey), codec.CollValue[types.FeeTokenStats](cdc),
+		),
+		CollectedFees: collections.NewMap(
+			sb, types.CollectedFeesKey, "collected_fees", collections.StringKey, sdk.IntValue,
+		),
+		ChargedFees: collections.NewMap(
+			tsb, types.ChargedFeesKey, "charged_fees",
+			collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey), codec.CollValue[types.ChargedFee](cdc),
+		),
 	}
 
 	// Build the schema
@@ -60,6 +129,13 @@ func NewKeeper(
 	}
 	k.Schema = schema
 
+	// Build the transient schema
+	transientSchema, err := tsb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.TransientSchema = transientSchema
+
 	// Return the keeper
 	return k
 }
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"cosmossdk.io/errors"
 
@@ -26,15 +29,13 @@ func NewMsgServer(k Keeper) types.MsgServer {
 
 // UpdateParams updates the module params though a proposal
 func (ms MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	// Check the authority
-	if err := ms.validateAuthority(msg.Authority); err != nil {
-		return nil, err
-	}
-
 	// Validate the message
 	if msg == nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
 	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
 	if err := msg.Validate(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
 	}
@@ -55,41 +56,390 @@ func (ms MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 // UpdateFeeTokens updates the fee tokens through a proposal
 func (ms MsgServer) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFeeTokens) (*types.MsgUpdateFeeTokensResponse, error) {
-	// Check the authority
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
 	if err := ms.validateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Update the fee tokens
+	if err := ms.Keeper.UpdateFeeTokens(sdk.UnwrapSDKContext(ctx), msg.FeeTokens); err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &types.MsgUpdateFeeTokensResponse{}, nil
+}
+
+// SetPreferredFeeToken sets the fee token preferred by the sender
+func (ms MsgServer) SetPreferredFeeToken(ctx context.Context, msg *types.MsgSetPreferredFeeToken) (*types.MsgSetPreferredFeeTokenResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Parse the sender, the address is already validated
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	// Store the preference
+	if err := ms.Keeper.SetPreferredFeeToken(ctx, sender, msg.Denom); err != nil {
+		return nil, err
+	}
 
+	// Emit the preference event
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSetPreferredFeeToken,
+			sdk.NewAttribute(types.TypeAttributeAccount, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.Denom),
+		),
+	)
+
+	// Return the response
+	return &types.MsgSetPreferredFeeTokenResponse{}, nil
+}
+
+// AddFeeToken adds a single fee token through a proposal
+func (ms MsgServer) AddFeeToken(ctx context.Context, msg *types.MsgAddFeeToken) (*types.MsgAddFeeTokenResponse, error) {
 	// Validate the message
 	if msg == nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
 	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
 	if err := msg.Validate(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
 	}
 
-	// Check if all the oracle denoms are registered on the oracle module
-	voteTargets, err := ms.oracleKeeper.GetVoteTargets(sdk.UnwrapSDKContext(ctx))
+	// Add the fee token
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.AddFeeToken(sdkCtx, msg.FeeToken); err != nil {
+		return nil, err
+	}
+
+	// Emit the add event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventAddFeeToken,
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.FeeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, msg.FeeToken.OracleDenom),
+			sdk.NewAttribute(types.TypeAttributePrice, msg.FeeToken.Price.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgAddFeeTokenResponse{}, nil
+}
+
+// RegisterFeeToken registers a fee token from an ERC20 token pair or an IBC denom through a proposal
+func (ms MsgServer) RegisterFeeToken(ctx context.Context, msg *types.MsgRegisterFeeToken) (*types.MsgRegisterFeeTokenResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Register the fee token
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeToken, err := ms.Keeper.RegisterFeeToken(sdkCtx, msg.Token, msg.OracleDenom)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to get oracle vote targets: %s", err)
+		return nil, err
 	}
-	voteTargetMap := make(map[string]struct{}, len(voteTargets))
-	for _, denom := range voteTargets {
-		voteTargetMap[denom] = struct{}{}
+
+	// Emit the register event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRegisterFeeToken,
+			sdk.NewAttribute(types.TypeAttributeDenom, feeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, feeToken.OracleDenom),
+			sdk.NewAttribute(types.TypeAttributeDecimals, strconv.FormatUint(uint64(feeToken.Decimals), 10)),
+			sdk.NewAttribute(types.TypeAttributePrice, feeToken.Price.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgRegisterFeeTokenResponse{FeeToken: feeToken}, nil
+}
+
+// RemoveFeeToken removes a single fee token through a proposal
+func (ms MsgServer) RemoveFeeToken(ctx context.Context, msg *types.MsgRemoveFeeToken) (*types.MsgRemoveFeeTokenResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
 	}
-	for _, feeToken := range msg.FeeTokens.Items {
-		if _, ok := voteTargetMap[feeToken.OracleDenom]; !ok {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s is not registered on the oracle module", feeToken.OracleDenom)
-		}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
 	}
 
-	// Update the fee tokens
-	if err := ms.FeeTokens.Set(ctx, msg.FeeTokens); err != nil {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to update fee tokens: %s", err)
+	// Remove the fee token
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.RemoveFeeToken(sdkCtx, msg.Denom); err != nil {
+		return nil, err
 	}
 
+	// Emit the remove event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRemoveFeeToken,
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.Denom),
+		),
+	)
+
 	// Return the response
-	return &types.MsgUpdateFeeTokensResponse{}, nil
+	return &types.MsgRemoveFeeTokenResponse{}, nil
+}
+
+// SetFeeTokenEnabled enables or disables a single fee token through a proposal
+func (ms MsgServer) SetFeeTokenEnabled(ctx context.Context, msg *types.MsgSetFeeTokenEnabled) (*types.MsgSetFeeTokenEnabledResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Update the fee token
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.SetFeeTokenEnabled(sdkCtx, msg.Denom, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	// Emit the update event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSetFeeTokenEnabled,
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.TypeAttributeEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	// Return the response
+	return &types.MsgSetFeeTokenEnabledResponse{}, nil
+}
+
+// RegisterSponsor registers a contract as a fee sponsor
+func (ms MsgServer) RegisterSponsor(ctx context.Context, msg *types.MsgRegisterSponsor) (*types.MsgRegisterSponsorResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Parse the addresses, they are already validated
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+
+	// Register the sponsor
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.RegisterSponsor(sdkCtx, sender, contract, msg.Nonces, msg.Deposit, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	// Emit the register event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRegisterSponsor,
+			sdk.NewAttribute(types.TypeAttributeSponsor, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeAmount, msg.Deposit.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgRegisterSponsorResponse{}, nil
+}
+
+// UpdateSponsorPolicy updates the policy of a fee sponsor
+func (ms MsgServer) UpdateSponsorPolicy(ctx context.Context, msg *types.MsgUpdateSponsorPolicy) (*types.MsgUpdateSponsorPolicyResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Parse the addresses, they are already validated
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+
+	// Update the policy
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.UpdateSponsorPolicy(sdkCtx, sender, contract, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	// Emit the update event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventUpdateSponsorPolicy,
+			sdk.NewAttribute(types.TypeAttributeSponsor, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+		),
+	)
+
+	// Return the response
+	return &types.MsgUpdateSponsorPolicyResponse{}, nil
+}
+
+// FundSponsor adds funds to a fee sponsor
+func (ms MsgServer) FundSponsor(ctx context.Context, msg *types.MsgFundSponsor) (*types.MsgFundSponsorResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Parse the addresses, they are already validated
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+
+	// Fund the sponsor
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.FundSponsor(sdkCtx, sender, contract, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit the fund event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventFundSponsor,
+			sdk.NewAttribute(types.TypeAttributeSponsor, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeAmount, msg.Amount.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgFundSponsorResponse{}, nil
+}
+
+// WithdrawSponsorFunds withdraws funds from a fee sponsor
+func (ms MsgServer) WithdrawSponsorFunds(ctx context.Context, msg *types.MsgWithdrawSponsorFunds) (*types.MsgWithdrawSponsorFundsResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Parse the addresses, they are already validated
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+
+	// Withdraw the funds
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := ms.Keeper.WithdrawSponsorFunds(sdkCtx, sender, contract, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit the withdraw event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventWithdrawSponsorFunds,
+			sdk.NewAttribute(types.TypeAttributeSponsor, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeAmount, msg.Amount.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgWithdrawSponsorFundsResponse{}, nil
+}
+
+// SweepERC20Fees moves the fees collected on an ERC20 fee token out of the module address through a proposal
+func (ms MsgServer) SweepERC20Fees(ctx context.Context, msg *types.MsgSweepERC20Fees) (*types.MsgSweepERC20FeesResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Sweep the fees to the recipient, the address is already validated
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+	amount, err := ms.Keeper.SweepERC20Fees(sdkCtx, common.HexToAddress(msg.Contract), recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the sweep event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSweepERC20Fees,
+			sdk.NewAttribute(types.TypeAttributeDenom, types.GetERC20FeeTokenDenom(common.HexToAddress(msg.Contract))),
+			sdk.NewAttribute(types.TypeAttributeRecipient, msg.Recipient),
+			sdk.NewAttribute(types.TypeAttributeAmount, amount.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgSweepERC20FeesResponse{Amount: amount}, nil
+}
+
+// WithdrawRevenue moves funds out of the treasury or the reserve module accounts through a proposal
+func (ms MsgServer) WithdrawRevenue(ctx context.Context, msg *types.MsgWithdrawRevenue) (*types.MsgWithdrawRevenueResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+
+	// Withdraw the funds to the recipient, the address is already validated
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+	if err := ms.Keeper.WithdrawRevenue(sdkCtx, msg.Account, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Emit the withdraw event
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventWithdrawRevenue,
+			sdk.NewAttribute(types.TypeAttributeAccount, msg.Account),
+			sdk.NewAttribute(types.TypeAttributeRecipient, msg.Recipient),
+			sdk.NewAttribute(types.TypeAttributeAmount, msg.Amount.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgWithdrawRevenueResponse{}, nil
 }
 
 // validateAuthority checks if address authority is valid and same as expected
@@ -5,8 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 	oracletypes "github.com/kiichain/kiichain/v5/x/oracle/types"
 )
@@ -23,14 +28,14 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			name: "valid - valid param update",
 			msg: &types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				Params:    types.NewParams("testcoin", "testcoin", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true),
+				Params:    types.NewParams("testcoin", "testcoin", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true, types.DefaultReenableBlocks),
 			},
 		},
 		{
 			name: "invalid - twap lookback window too high",
 			msg: &types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				Params:    types.NewParams("testcoin", "testcoin", types.DefaultClampFactor, types.DefaultFallbackNativePrice, 1000000, true),
+				Params:    types.NewParams("testcoin", "testcoin", types.DefaultClampFactor, types.DefaultFallbackNativePrice, 1000000, true, types.DefaultReenableBlocks),
 			},
 			errContains: "Twap lookback seconds is greater than max lookback duration",
 		},
@@ -89,6 +94,26 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 		types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyMustNewDecFromStr("0.01")),
 		types.NewFeeTokenMetadata("three", "oraclethree", 6, math.LegacyMustNewDecFromStr("0.01")))
 
+	// The fee token registered before the update
+	removedFeeToken := types.NewFeeTokenMetadata("four", "oraclefour", 6, math.LegacyMustNewDecFromStr("0.01"))
+
+	// registerFeeTokens registers the oracle denoms and creates supply for all the tokens
+	registerFeeTokens := func(ctx sdk.Context) {
+		for _, feeToken := range defaultFeeTokens.Items {
+			// Register the token as a vote target on the oracle module
+			err := s.app.OracleKeeper.VoteTarget.Set(ctx, feeToken.OracleDenom, oracletypes.Denom{Name: feeToken.OracleDenom})
+			s.Require().NoError(err)
+
+			// Create supply for the denom
+			s.fundAccount(ctx, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin(feeToken.Denom, math.NewInt(1))))
+		}
+
+		// Register the token removed by the update
+		s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(removedFeeToken)))
+		usage := types.NewFeeTokenUsage(ctx.BlockHeight(), types.DayFromUnix(ctx.BlockTime().Unix()))
+		s.Require().NoError(s.keeper.FeeTokenUsages.Set(ctx, removedFeeToken.Denom, usage.Add(math.NewInt(100))))
+	}
+
 	// Prepare all the test cases
 	testCases := []struct {
 		name        string
@@ -102,14 +127,35 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				*defaultFeeTokens,
 			),
+			malleate: registerFeeTokens,
+		},
+		{
+			name: "invalid - new token without supply",
+			msg: types.NewMessageUpdateFeeTokens(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				*defaultFeeTokens,
+			),
 			malleate: func(ctx sdk.Context) {
-				// Iterate all the tokens
+				// Register only the oracle denoms
 				for _, feeToken := range defaultFeeTokens.Items {
-					// Register the token as a vote target on the oracle module
 					err := s.app.OracleKeeper.VoteTarget.Set(ctx, feeToken.OracleDenom, oracletypes.Denom{Name: feeToken.OracleDenom})
 					s.Require().NoError(err)
 				}
 			},
+			errContains: "denom one has no supply and no ERC20 token pair",
+		},
+		{
+			name: "invalid - removed token with revenue pending settlement",
+			msg: types.NewMessageUpdateFeeTokens(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				*defaultFeeTokens,
+			),
+			malleate: func(ctx sdk.Context) {
+				registerFeeTokens(ctx)
+				revenue := types.NewFeeRevenue(removedFeeToken.Denom).AddRouted(types.RevenueDestinationFeeCollector, math.NewInt(100), true)
+				s.Require().NoError(s.keeper.FeeRevenues.Set(ctx, removedFeeToken.Denom, revenue))
+			},
+			errContains: types.ErrFeeTokenPendingRevenue.Error(),
 		},
 		{
 			name: "invalid - one token not registered on oracle",
@@ -152,6 +198,10 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 			),
 			errContains: "denom is invalid: invalid fee token metadata: invalid request",
 		},
+		{
+			name:        "invalid - nil msg",
+			errContains: "msg cannot be nil",
+		},
 	}
 
 	// Iterate through the test cases
@@ -179,6 +229,524 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 				tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
 				s.Require().NoError(err)
 				s.Require().Equal(tc.msg.FeeTokens, tokens)
+
+				// The usage of the removed token is cleared
+				has, err := s.keeper.FeeTokenUsages.Has(cachedCtx, removedFeeToken.Denom)
+				s.Require().NoError(err)
+				s.Require().False(has)
+			}
+		})
+	}
+}
+
+// TestSetPreferredFeeToken tests the SetPreferredFeeToken method
+func (s *KeeperTestSuite) TestSetPreferredFeeToken() {
+	// The account setting the preference
+	sender := apptesting.RandomAccountAddress()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetPreferredFeeToken
+		malleate    func(ctx sdk.Context)
+		expected    string
+		errContains string
+	}{
+		{
+			name: "valid - set a registered fee token",
+			msg:  types.NewMessageSetPreferredFeeToken(sender.String(), "one"),
+			malleate: func(ctx sdk.Context) {
+				// Register the fee token
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("one", "oracleone", 6, math.LegacyMustNewDecFromStr("0.01")),
+				))
+				s.Require().NoError(err)
+			},
+			expected: "one",
+		},
+		{
+			name: "valid - clear the preference",
+			msg:  types.NewMessageSetPreferredFeeToken(sender.String(), ""),
+			malleate: func(ctx sdk.Context) {
+				// Set a previous preference
+				err := s.keeper.PreferredFeeTokens.Set(ctx, sender, "one")
+				s.Require().NoError(err)
+			},
+			expected: "",
+		},
+		{
+			name:        "invalid - fee token not registered",
+			msg:         types.NewMessageSetPreferredFeeToken(sender.String(), "unknown"),
+			errContains: "denom unknown is not registered as a fee token",
+		},
+		{
+			name:        "invalid - invalid sender",
+			msg:         types.NewMessageSetPreferredFeeToken("", "one"),
+			errContains: "empty address string is not allowed",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the SetPreferredFeeToken method
+			_, err := s.msgServer.SetPreferredFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the preference was updated
+				denom, err := s.keeper.GetPreferredFeeToken(cachedCtx, sender)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, denom)
+			}
+		})
+	}
+}
+
+// TestAddFeeToken tests the AddFeeToken method
+func (s *KeeperTestSuite) TestAddFeeToken() {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// The fee token already registered
+	existingFeeToken := types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("0.5"))
+	existingFeeToken.Suspended = true
+	existingFeeToken.ValidPriceBlocks = 3
+	existingFeeToken.Price = math.LegacyZeroDec()
+
+	// The fee token to be added
+	newFeeToken := types.NewFeeTokenMetadata("uusdc", "usdc", 6, math.LegacyMustNewDecFromStr("0.01"))
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgAddFeeToken
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - bank denom",
+			msg:  types.NewMessageAddFeeToken(authority, newFeeToken),
+			malleate: func(ctx sdk.Context) {
+				// Create supply for the denom
+				s.fundAccount(ctx, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1))))
+			},
+		},
+		{
+			name: "valid - erc20 token pair",
+			msg:  types.NewMessageAddFeeToken(authority, types.NewFeeTokenMetadata("erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", "usdc", 6, math.LegacyMustNewDecFromStr("0.01"))),
+			malleate: func(ctx sdk.Context) {
+				// Register the token pair
+				err := s.app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+					Erc20Address:  "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+					Denom:         "erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+					Enabled:       true,
+					ContractOwner: erc20types.OWNER_EXTERNAL,
+				})
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name:        "invalid - denom doesn't exist",
+			msg:         types.NewMessageAddFeeToken(authority, newFeeToken),
+			errContains: "denom uusdc has no supply and no ERC20 token pair",
+		},
+		{
+			name: "invalid - oracle denom isn't a vote target",
+			msg:  types.NewMessageAddFeeToken(authority, types.NewFeeTokenMetadata("uusdc", "unknown", 6, math.LegacyMustNewDecFromStr("0.01"))),
+			malleate: func(ctx sdk.Context) {
+				// Create supply for the denom
+				s.fundAccount(ctx, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1))))
+			},
+			errContains: "fee token denom unknown is not registered on the oracle module",
+		},
+		{
+			name: "invalid - token already registered",
+			msg:  types.NewMessageAddFeeToken(authority, types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyOneDec())),
+			malleate: func(ctx sdk.Context) {
+				// Create supply for the denom
+				s.fundAccount(ctx, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))))
+			},
+			errContains: "fee token uatom is already registered",
+		},
+		{
+			name: "invalid - wrong authority",
+			msg: &types.MsgAddFeeToken{
+				Authority: authtypes.NewModuleAddress(types.ModuleName).String(),
+				FeeToken:  newFeeToken,
+			},
+			errContains: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:        "invalid - nil msg",
+			errContains: "msg cannot be nil",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Register the existing fee token and the oracle vote targets
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, *types.NewFeeTokenMetadataCollection(existingFeeToken)))
+			for _, denom := range []string{"atom", "usdc"} {
+				err := s.app.OracleKeeper.VoteTarget.Set(cachedCtx, denom, oracletypes.Denom{Name: denom})
+				s.Require().NoError(err)
+			}
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the AddFeeToken method
+			_, err := s.msgServer.AddFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The token is appended and the existing runtime state is kept
+			tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.FeeTokenMetadata{existingFeeToken, tc.msg.FeeToken}, tokens.Items)
+		})
+	}
+}
+
+// TestRegisterFeeToken tests the RegisterFeeToken method
+func (s *KeeperTestSuite) TestRegisterFeeToken() {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// The ERC20 token pair and the IBC denom
+	pair := erc20types.TokenPair{
+		Erc20Address:  "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+		Denom:         "uusdc",
+		Enabled:       true,
+		ContractOwner: erc20types.OWNER_MODULE,
+	}
+	ibcDenom := transfertypes.NewDenom("uatom", transfertypes.NewHop(transfertypes.PortID, "channel-0"))
+
+	// setMetadata sets the bank metadata of a denom with the display decimals
+	setMetadata := func(ctx sdk.Context, denom string, decimals uint32) {
+		s.app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			Base:    denom,
+			Display: "display",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "display", Exponent: decimals},
+			},
+		})
+	}
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name             string
+		msg              *types.MsgRegisterFeeToken
+		malleate         func(ctx sdk.Context) sdk.Context
+		expectedDenom    string
+		expectedDecimals uint32
+		errContains      string
+	}{
+		{
+			name: "valid - erc20 token pair by contract",
+			msg:  types.NewMessageRegisterFeeToken(authority, pair.Erc20Address, "usdc"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				s.Require().NoError(s.app.Erc20Keeper.SetToken(ctx, pair))
+				setMetadata(ctx, pair.Denom, 6)
+				return s.createTwaps(ctx, math.LegacyMustNewDecFromStr("1"), 100, "usdc")
+			},
+			expectedDenom:    "uusdc",
+			expectedDecimals: 6,
+		},
+		{
+			name: "valid - ibc denom",
+			msg:  types.NewMessageRegisterFeeToken(authority, ibcDenom.IBCDenom(), "atom"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				s.app.TransferKeeper.SetDenom(ctx, ibcDenom)
+				setMetadata(ctx, ibcDenom.IBCDenom(), 6)
+				return s.createTwaps(ctx, math.LegacyMustNewDecFromStr("5"), 100, "atom")
+			},
+			expectedDenom:    ibcDenom.IBCDenom(),
+			expectedDecimals: 6,
+		},
+		{
+			name: "invalid - ibc denom without metadata",
+			msg:  types.NewMessageRegisterFeeToken(authority, ibcDenom.IBCDenom(), "atom"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				s.app.TransferKeeper.SetDenom(ctx, ibcDenom)
+				return s.createTwaps(ctx, math.LegacyMustNewDecFromStr("5"), 100, "atom")
+			},
+			errContains: "has no display decimals",
+		},
+		{
+			name: "invalid - unknown denom trace",
+			msg:  types.NewMessageRegisterFeeToken(authority, ibcDenom.IBCDenom(), "atom"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return s.createTwaps(ctx, math.LegacyMustNewDecFromStr("5"), 100, "atom")
+			},
+			errContains: "denom trace for",
+		},
+		{
+			name: "invalid - neither a token pair nor an ibc denom",
+			msg:  types.NewMessageRegisterFeeToken(authority, "uosmo", "atom"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return s.createTwaps(ctx, math.LegacyMustNewDecFromStr("5"), 100, "atom")
+			},
+			errContains: "is neither an ERC20 token pair nor an IBC denom",
+		},
+		{
+			name: "invalid - oracle denom isn't a vote target",
+			msg:  types.NewMessageRegisterFeeToken(authority, ibcDenom.IBCDenom(), "unknown"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				s.app.TransferKeeper.SetDenom(ctx, ibcDenom)
+				setMetadata(ctx, ibcDenom.IBCDenom(), 6)
+				return ctx
+			},
+			errContains: "fee token denom unknown is not registered on the oracle module",
+		},
+		{
+			name: "invalid - no TWAP for the oracle denom",
+			msg:  types.NewMessageRegisterFeeToken(authority, ibcDenom.IBCDenom(), "atom"),
+			malleate: func(ctx sdk.Context) sdk.Context {
+				s.app.TransferKeeper.SetDenom(ctx, ibcDenom)
+				setMetadata(ctx, ibcDenom.IBCDenom(), 6)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, "atom", oracletypes.Denom{Name: "atom"})
+				s.Require().NoError(err)
+				return ctx
+			},
+			errContains: "no TWAP with enough coverage for atom",
+		},
+		{
+			name: "invalid - wrong authority",
+			msg: &types.MsgRegisterFeeToken{
+				Authority:   authtypes.NewModuleAddress(types.ModuleName).String(),
+				Token:       ibcDenom.IBCDenom(),
+				OracleDenom: "atom",
+			},
+			errContains: "expected gov account as only signer for proposal message",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context without fee tokens
+			cachedCtx, _ := s.ctx.CacheContext()
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, *types.NewFeeTokenMetadataCollection()))
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				cachedCtx = tc.malleate(cachedCtx)
+			}
+
+			// Call the RegisterFeeToken method
+			res, err := s.msgServer.RegisterFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The token is registered with the derived metadata and a TWAP price
+			s.Require().Equal(tc.expectedDenom, res.FeeToken.Denom)
+			s.Require().Equal(tc.expectedDecimals, res.FeeToken.Decimals)
+			s.Require().True(res.FeeToken.Price.IsPositive())
+			tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
+			s.Require().NoError(err)
+			s.Require().Equal([]types.FeeTokenMetadata{res.FeeToken}, tokens.Items)
+		})
+	}
+}
+
+// TestRemoveFeeToken tests the RemoveFeeToken method
+func (s *KeeperTestSuite) TestRemoveFeeToken() {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// The registered fee tokens
+	feeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("one", "oracleone", 6, math.LegacyMustNewDecFromStr("0.01")),
+		types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyMustNewDecFromStr("0.02")),
+		types.NewFeeTokenMetadata("three", "oraclethree", 6, math.LegacyMustNewDecFromStr("0.03")),
+	)
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		malleate    func(sdk.Context)
+		msg         *types.MsgRemoveFeeToken
+		expected    []types.FeeTokenMetadata
+		errContains string
+	}{
+		{
+			name:     "valid - remove a token keeping the order",
+			msg:      types.NewMessageRemoveFeeToken(authority, "two"),
+			expected: []types.FeeTokenMetadata{feeTokens.Items[0], feeTokens.Items[2]},
+		},
+		{
+			name: "valid - remove a token with settled revenue",
+			malleate: func(ctx sdk.Context) {
+				revenue := types.NewFeeRevenue("two").AddRouted(types.RevenueDestinationFeeCollector, math.NewInt(100), true)
+				s.Require().NoError(s.keeper.FeeRevenues.Set(ctx, "two", revenue.AddSettled(math.NewInt(100), math.NewInt(2))))
+			},
+			msg:      types.NewMessageRemoveFeeToken(authority, "two"),
+			expected: []types.FeeTokenMetadata{feeTokens.Items[0], feeTokens.Items[2]},
+		},
+		{
+			name: "invalid - revenue pending settlement",
+			malleate: func(ctx sdk.Context) {
+				revenue := types.NewFeeRevenue("two").AddRouted(types.RevenueDestinationFeeCollector, math.NewInt(100), true)
+				s.Require().NoError(s.keeper.FeeRevenues.Set(ctx, "two", revenue))
+			},
+			msg:         types.NewMessageRemoveFeeToken(authority, "two"),
+			errContains: types.ErrFeeTokenPendingRevenue.Error(),
+		},
+		{
+			name:        "invalid - unknown token",
+			msg:         types.NewMessageRemoveFeeToken(authority, "unknown"),
+			errContains: types.ErrUnknownFeeToken.Error(),
+		},
+		{
+			name:        "invalid - wrong authority",
+			msg:         types.NewMessageRemoveFeeToken(authtypes.NewModuleAddress(types.ModuleName).String(), "two"),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:        "invalid - nil msg",
+			errContains: "msg cannot be nil",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Register the fee tokens
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, *feeTokens))
+
+			// Apply the test case changes
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the RemoveFeeToken method
+			_, err := s.msgServer.RemoveFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Check the remaining tokens
+			tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, tokens.Items)
+		})
+	}
+}
+
+// TestSetFeeTokenEnabled tests the SetFeeTokenEnabled method
+func (s *KeeperTestSuite) TestSetFeeTokenEnabled() {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// The registered fee tokens, the second one is suspended
+	suspendedFeeToken := types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyZeroDec())
+	suspendedFeeToken.Suspended = true
+	suspendedFeeToken.ValidPriceBlocks = 2
+	feeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("one", "oracleone", 6, math.LegacyMustNewDecFromStr("0.01")),
+		suspendedFeeToken,
+	)
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetFeeTokenEnabled
+		errContains string
+	}{
+		{
+			name: "valid - disable a token",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, "one", false),
+		},
+		{
+			name: "valid - disable a suspended token keeping its state",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, "two", false),
+		},
+		{
+			name: "valid - enable an enabled token",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, "one", true),
+		},
+		{
+			name:        "invalid - unknown token",
+			msg:         types.NewMessageSetFeeTokenEnabled(authority, "unknown", false),
+			errContains: types.ErrUnknownFeeToken.Error(),
+		},
+		{
+			name:        "invalid - wrong authority",
+			msg:         types.NewMessageSetFeeTokenEnabled(authtypes.NewModuleAddress(types.ModuleName).String(), "one", false),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+		{
+			name:        "invalid - nil msg",
+			errContains: "msg cannot be nil",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Register the fee tokens
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, *feeTokens))
+
+			// Call the SetFeeTokenEnabled method
+			_, err := s.msgServer.SetFeeTokenEnabled(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Only the enabled flag of the token was changed
+			tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
+			s.Require().NoError(err)
+			for i, token := range tokens.Items {
+				expected := feeTokens.Items[i]
+				if token.Denom == tc.msg.Denom {
+					expected.Enabled = tc.msg.Enabled
+				}
+				s.Require().Equal(expected, token)
 			}
 		})
 	}
@@ -19,6 +19,28 @@ func (k Keeper) CalculateFeeTokenPrices(ctx sdk.Context) error {
 		return err
 	}
 
+	// Get the TWAP prices and the base token price
+	twapPriceMap, lowCoverage, baseTokenPrice := k.getTwapPrices(ctx, params)
+
+	// Iterate all the tokens
+	updateTokens, err := k.calculatePriceTokens(
+		ctx,
+		twapPriceMap,
+		lowCoverage,
+		baseTokenPrice,
+		params,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Save the updated tokens
+	return k.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(updateTokens...))
+}
+
+// getTwapPrices returns the TWAP prices by oracle denom, the denoms with a low coverage TWAP and the
+// price of the base token
+func (k Keeper) getTwapPrices(ctx sdk.Context, params types.Params) (map[string]math.LegacyDec, map[string]struct{}, math.LegacyDec) {
 	// Get the twaps for the tokens
 	twaps, err := k.oracleKeeper.CalculateTwaps(ctx, params.TwapLookbackWindow)
 	if err != nil {
@@ -29,38 +51,36 @@ func (k Keeper) CalculateFeeTokenPrices(ctx sdk.Context) error {
 	}
 
 	// Parse the twaps into a map for easier access
+	// TWAPs that don't cover enough of the lookback window are tracked apart
 	twapPriceMap := make(map[string]math.LegacyDec)
+	lowCoverage := make(map[string]struct{})
 	for _, twap := range twaps {
 		twapPriceMap[twap.Denom] = twap.Twap
+		if !params.HasTwapCoverage(twap.LookbackSeconds) {
+			lowCoverage[twap.Denom] = struct{}{}
+		}
 	}
 
 	// Find the price for the base token
+	// A TWAP with low coverage is not trusted and the fallback price is used
 	baseTokenPrice, ok := twapPriceMap[params.NativeOracleDenom]
-	if !ok {
+	if _, low := lowCoverage[params.NativeOracleDenom]; !ok || low {
 		baseTokenPrice = params.FallbackNativePrice
 	}
 
-	// Iterate all the tokens
-	updateTokens, err := k.calculatePriceTokens(
-		ctx,
-		twapPriceMap,
-		baseTokenPrice,
-		params.ClampFactor,
-	)
-	if err != nil {
-		return err
-	}
-
-	// Save the updated tokens
-	return k.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(updateTokens...))
+	return twapPriceMap, lowCoverage, baseTokenPrice
 }
 
 // calculatePriceTokens calculates the price of each fee token in terms of the base token
+// Tokens without a valid oracle price are suspended, and re-enabled after the oracle
+// provides valid prices for reenableBlocks consecutive blocks
+// Tokens with a low coverage TWAP keep their previous price, or are suspended if the params say so
 func (k Keeper) calculatePriceTokens(
 	ctx sdk.Context,
 	twapPriceMap map[string]math.LegacyDec,
+	lowCoverage map[string]struct{},
 	baseTokenPrice math.LegacyDec,
-	clampFactor math.LegacyDec,
+	params types.Params,
 ) ([]types.FeeTokenMetadata, error) {
 	// Get all the fee tokens
 	feeTokens, err := k.FeeTokens.Get(ctx)
@@ -71,7 +91,7 @@ func (k Keeper) calculatePriceTokens(
 	// Iterate through the fee tokens and calculate their prices
 	updateTokens := make([]types.FeeTokenMetadata, 0, len(feeTokens.Items))
 	for _, token := range feeTokens.Items {
-		// Check if the token is enabled
+		// Tokens disabled by governance are not updated
 		if !token.Enabled {
 			updateTokens = append(updateTokens, token)
 			continue
@@ -83,12 +103,28 @@ func (k Keeper) calculatePriceTokens(
 			tokenPrice = math.LegacyZeroDec()
 		}
 
-		// If the token price is zero, we disable the token for safety
+		// A TWAP with low coverage is close to a spot price, so it's not used
+		if _, low := lowCoverage[token.OracleDenom]; low && ok {
+			k.Logger(ctx).Debug("token TWAP has low coverage", "denom", token.Denom)
+			if !params.SuspendOnLowCoverage {
+				// Keep the previous price, suspended tokens don't count a valid price block
+				updateTokens = append(updateTokens, token)
+				continue
+			}
+			tokenPrice = math.LegacyZeroDec()
+		}
+
+		// If the token price is zero, we suspend the token for safety
 		if tokenPrice.IsZero() {
-			// Log or emit telemetry for monitoring
-			k.Logger(ctx).Warn("token price is zero, disabling token", "denom", token.Denom)
-			// Disable the token
-			token.Enabled = false
+			// Only log and emit the event on the transition
+			if !token.Suspended {
+				k.Logger(ctx).Warn("token price is zero, suspending token", "denom", token.Denom)
+				k.emitFeeTokenEvent(ctx, types.TypeEventFeeTokenSuspended, token)
+			}
+
+			// Suspend the token and reset the price, this also resets the clamp
+			token.Suspended = true
+			token.ValidPriceBlocks = 0
 			token.Price = math.LegacyZeroDec()
 			updateTokens = append(updateTokens, token)
 			continue
@@ -100,8 +136,26 @@ func (k Keeper) calculatePriceTokens(
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "error calculating token price for denom %s: %v", token.Denom, err)
 		}
 
+		// Suspended tokens wait for enough consecutive valid prices
+		if token.Suspended {
+			token.ValidPriceBlocks++
+			if token.ValidPriceBlocks < params.ReenableBlocks {
+				updateTokens = append(updateTokens, token)
+				continue
+			}
+
+			// Re-enable the token, seeding the clamp from the fresh price
+			token.Suspended = false
+			token.ValidPriceBlocks = 0
+			token.Price = price
+			k.Logger(ctx).Info("token price recovered, re-enabling token", "denom", token.Denom)
+			k.emitFeeTokenEvent(ctx, types.TypeEventFeeTokenReenabled, token)
+			updateTokens = append(updateTokens, token)
+			continue
+		}
+
 		// Apply clamping
-		price = types.ClampPrice(token.Price, price, clampFactor)
+		price = types.ClampPrice(token.Price, price, params.ClampFactor)
 
 		// Update the token price
 		token.Price = price
@@ -111,3 +165,15 @@ func (k Keeper) calculatePriceTokens(
 	// Return the updated tokens
 	return updateTokens, nil
 }
+
+// emitFeeTokenEvent emits an event for a fee token state transition
+func (k Keeper) emitFeeTokenEvent(ctx sdk.Context, eventType string, token types.FeeTokenMetadata) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.TypeAttributeDenom, token.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, token.OracleDenom),
+			sdk.NewAttribute(types.TypeAttributePrice, token.Price.String()),
+		),
+	)
+}
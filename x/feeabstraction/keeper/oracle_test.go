@@ -62,7 +62,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 		},
 		{
-			name: "all tokens are disabled due to no twap",
+			name: "all tokens are suspended due to no twap",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Set the fee token prices in the keeper without twaps
 				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
@@ -74,16 +74,27 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				return ctx
 			},
 			postCheck: func(ctx sdk.Context) {
-				// Check that the fee tokens are all disabled
+				// Check that the fee tokens are all suspended but still enabled by governance
 				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
 				s.Require().NoError(err)
 				for _, token := range feeTokens.Items {
-					s.Require().False(token.Enabled, "Expected token to be disabled: %s", token.Denom)
+					s.Require().True(token.Enabled, "Expected token to be enabled: %s", token.Denom)
+					s.Require().True(token.Suspended, "Expected token to be suspended: %s", token.Denom)
+					s.Require().True(token.Price.IsZero())
+				}
+
+				// Check the suspension events
+				suspendedEvents := 0
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.TypeEventFeeTokenSuspended {
+						suspendedEvents++
+					}
 				}
+				s.Require().Equal(2, suspendedEvents)
 			},
 		},
 		{
-			name: "partial tokens disabled due to no twap",
+			name: "partial tokens suspended due to no twap",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Mock oracle twaps
 				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
@@ -98,14 +109,14 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				return ctx
 			},
 			postCheck: func(ctx sdk.Context) {
-				// Check that the fee tokens are partially disabled
+				// Check that the fee tokens are partially suspended
 				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
 				s.Require().NoError(err)
 				for _, token := range feeTokens.Items {
 					if token.Denom == "uatom" {
-						s.Require().True(token.Enabled, "Expected uatom to be enabled")
+						s.Require().True(token.IsActive(), "Expected uatom to be active")
 					} else {
-						s.Require().False(token.Enabled, "Expected token to be disabled: %s", token.Denom)
+						s.Require().True(token.Suspended, "Expected token to be suspended: %s", token.Denom)
 					}
 				}
 			},
@@ -170,6 +181,168 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				s.Require().NotEqual(math.LegacyOneDec(), feeTokens.Items[1].Price)
 			},
 		},
+		{
+			name: "suspended token counts valid price blocks",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
+
+				// Set a suspended token
+				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.FeeTokenMetadata{
+						Denom:            "uatom",
+						OracleDenom:      "atom",
+						Decimals:         6,
+						Price:            math.LegacyZeroDec(),
+						Enabled:          true,
+						Suspended:        true,
+						ValidPriceBlocks: 1,
+					},
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is still suspended, but the counter increased
+				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feeTokens.Items[0].Suspended)
+				s.Require().Equal(uint64(2), feeTokens.Items[0].ValidPriceBlocks)
+				s.Require().True(feeTokens.Items[0].Price.IsZero())
+			},
+		},
+		{
+			name: "suspended token is re-enabled after enough valid price blocks",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
+
+				// Get the params to know the reenable blocks
+				params, err := s.app.FeeAbstractionKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+
+				// Set a suspended token one block away from being re-enabled
+				err = s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.FeeTokenMetadata{
+						Denom:            "uatom",
+						OracleDenom:      "atom",
+						Decimals:         6,
+						Price:            math.LegacyZeroDec(),
+						Enabled:          true,
+						Suspended:        true,
+						ValidPriceBlocks: params.ReenableBlocks - 1,
+					},
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is active again
+				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feeTokens.Items[0].IsActive())
+				s.Require().Zero(feeTokens.Items[0].ValidPriceBlocks)
+
+				// The price is seeded from the fresh twap, without clamping
+				params, err := s.app.FeeAbstractionKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				expectedPrice := math.LegacyMustNewDecFromStr("0.5").Quo(params.FallbackNativePrice)
+				s.Require().True(feeTokens.Items[0].Price.GTE(expectedPrice))
+
+				// Check the re-enable event
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.TypeEventFeeTokenReenabled {
+						found = true
+					}
+				}
+				s.Require().True(found)
+			},
+		},
+		{
+			name: "suspended token counter is reset without a valid price",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Set a suspended token without twaps
+				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.FeeTokenMetadata{
+						Denom:            "uatom",
+						OracleDenom:      "atom",
+						Decimals:         6,
+						Price:            math.LegacyZeroDec(),
+						Enabled:          true,
+						Suspended:        true,
+						ValidPriceBlocks: 5,
+					},
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is still suspended and the counter is reset
+				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feeTokens.Items[0].Suspended)
+				s.Require().Zero(feeTokens.Items[0].ValidPriceBlocks)
+
+				// No new suspension event is emitted
+				for _, event := range ctx.EventManager().Events() {
+					s.Require().NotEqual(types.TypeEventFeeTokenSuspended, event.Type)
+				}
+			},
+		},
+		{
+			name: "token with low twap coverage keeps its previous price",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps covering less than half of the window
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 10, "atom")
+
+				// Set the fee token prices in the keeper
+				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is active and the price is untouched
+				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feeTokens.Items[0].IsActive())
+				s.Require().Equal(math.LegacyMustNewDecFromStr("50"), feeTokens.Items[0].Price)
+			},
+		},
+		{
+			name: "token with low twap coverage is suspended",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps covering less than half of the window
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 10, "atom")
+
+				// Suspend the tokens with low coverage
+				params, err := s.app.FeeAbstractionKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				params.SuspendOnLowCoverage = true
+				s.Require().NoError(s.app.FeeAbstractionKeeper.Params.Set(ctx, params))
+
+				// Set the fee token prices in the keeper
+				err = s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is suspended
+				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				s.Require().NoError(err)
+				s.Require().True(feeTokens.Items[0].Suspended)
+				s.Require().True(feeTokens.Items[0].Price.IsZero())
+			},
+		},
 	}
 
 	// Iterate through the test cases
@@ -203,7 +376,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 func (s *KeeperTestSuite) createTwaps(
 	ctx sdk.Context,
 	startRate math.LegacyDec,
-	steps int, //nolint:unparam
+	steps int,
 	denom string,
 ) sdk.Context {
 	s.T().Helper()
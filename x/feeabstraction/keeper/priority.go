@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// GetFeePriority returns the tx priority for the charged fees
+// The priority computed over the native fee is adjusted to the native value of the charged fees,
+// so txs paying with fee tokens get the same priority as native txs paying the same value
+func (k Keeper) GetFeePriority(ctx sdk.Context, priority int64, nativeFees, chargedFees sdk.Coins, gas uint64) (int64, error) {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fees charged on the native denom keep their priority
+	nativeFee := nativeFees.AmountOf(params.NativeDenom)
+	if chargedFees.Equal(nativeFees) || nativeFee.IsZero() {
+		return priority, nil
+	}
+
+	// Get the native value of the charged fees
+	chargedValue, err := k.GetNativeFeeValue(ctx, chargedFees)
+	if err != nil {
+		return 0, err
+	}
+
+	return types.AdjustPriority(priority, nativeFee, chargedValue, gas, evmtypes.DefaultPriorityReduction), nil
+}
+
+// GetNativeFeeValue returns the value of the fees on the native denom
+// Fee tokens are valued at their oracle price, and unknown denoms have no value
+func (k Keeper) GetNativeFeeValue(ctx sdk.Context, fees sdk.Coins) (math.Int, error) {
+	// Get the params and the fee tokens
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	// Sum the value of each fee
+	value := math.ZeroInt()
+	for _, fee := range fees {
+		// The native denom is taken as is
+		if fee.Denom == params.NativeDenom {
+			value = value.Add(fee.Amount)
+			continue
+		}
+
+		// Convert the fee token to the native denom
+		token, found := feeTokens.GetByDenom(fee.Denom)
+		if !found {
+			continue
+		}
+		nativeAmount, err := calculateNativeAmount(fee.Amount, token)
+		if err != nil {
+			return math.Int{}, err
+		}
+		value = value.Add(nativeAmount)
+	}
+
+	return value, nil
+}
+
+// GetFeelessPriority returns the priority given to feeless txs
+func (k Keeper) GetFeelessPriority(ctx sdk.Context) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return params.FeelessPriority, nil
+}
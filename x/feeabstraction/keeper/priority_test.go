@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestGetFeePriority tests the GetFeePriority function
+func (s *KeeperTestSuite) TestGetFeePriority() {
+	// The native fee paid for 100 gas
+	nativeFees := sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)))
+
+	// Build the test cases
+	testCases := []struct {
+		name        string
+		chargedFees sdk.Coins
+		expected    int64
+	}{
+		{
+			name:        "native fees keep the priority",
+			chargedFees: nativeFees,
+			expected:    10,
+		},
+		{
+			name:        "fee token with the same value keeps the priority",
+			chargedFees: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))),
+			expected:    10,
+		},
+		{
+			name:        "fee token with a higher value increases the priority",
+			chargedFees: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))),
+			expected:    10 + 10_000_000_000,
+		},
+		{
+			name:        "unknown denom has no value",
+			chargedFees: sdk.NewCoins(sdk.NewCoin("uunknown", convertToMinimalDenomination(2, 6))),
+			expected:    0,
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// Register the fee token, 1 atom per kii
+			err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+			))
+			s.Require().NoError(err)
+
+			// Get the priority
+			priority, err := s.keeper.GetFeePriority(ctx, 10, nativeFees, tc.chargedFees, 100)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, priority)
+		})
+	}
+}
+
+// TestGetFeelessPriority tests the GetFeelessPriority function
+func (s *KeeperTestSuite) TestGetFeelessPriority() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// The default priority is used
+	priority, err := s.keeper.GetFeelessPriority(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultFeelessPriority, priority)
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// SetChargedFee records the fee token charged for the current EVM tx of an account
+// Fees that weren't converted to a single fee token are recorded as native, so the refunds stay native
+// ERC20 fees are final, they are recorded with nothing to refund
+func (k Keeper) SetChargedFee(ctx sdk.Context, account sdk.AccAddress, nativeFees, chargedFees sdk.Coins) error {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Record a native charge if the fees weren't converted to a single fee token
+	if len(nativeFees) != 1 || len(chargedFees) != 1 ||
+		nativeFees[0].Denom != params.NativeDenom || chargedFees[0].Denom == params.NativeDenom {
+		return k.setChargedFee(ctx, account, types.NewNativeChargedFee())
+	}
+
+	// ERC20 fees are held by the module address, the unused gas isn't refunded
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if feeToken, found := feeTokens.GetByDenom(chargedFees[0].Denom); found && feeToken.IsERC20() {
+		return k.setChargedFee(ctx, account, types.NewChargedFee(chargedFees[0].Denom, math.ZeroInt(), nativeFees[0].Amount))
+	}
+
+	// Store the charged fee
+	return k.setChargedFee(ctx, account, types.NewChargedFee(chargedFees[0].Denom, chargedFees[0].Amount, nativeFees[0].Amount))
+}
+
+// SetSponsoredFee records the fee paid by a sponsor for the current EVM tx of an account
+// The record is kept for any single coin charge, so the unused gas is always refunded to the sponsor
+func (k Keeper) SetSponsoredFee(ctx sdk.Context, account, sponsor sdk.AccAddress, nativeFees, chargedFees sdk.Coins) error {
+	// Zero fees have nothing to refund
+	if len(nativeFees) != 1 || len(chargedFees) != 1 {
+		return k.setChargedFee(ctx, account, types.NewNativeChargedFee())
+	}
+
+	// Store the sponsored fee
+	chargedFee := types.NewChargedFee(chargedFees[0].Denom, chargedFees[0].Amount, nativeFees[0].Amount)
+	chargedFee.Sponsor = sponsor.String()
+	return k.setChargedFee(ctx, account, chargedFee)
+}
+
+// GetChargedFee returns the fee recorded for the EVM msg of an account on the current tx
+func (k Keeper) GetChargedFee(ctx sdk.Context, account sdk.AccAddress) (types.ChargedFee, error) {
+	return k.ChargedFees.Get(ctx, getChargedFeeKey(ctx, account))
+}
+
+// ConvertGasRefund converts a native refund of unused gas to the fee token charged for the tx
+// The refund is converted at the charged price and the record is removed, refunds of accounts
+// without a record on the current tx are returned as is
+// The sponsor that paid the fee is returned, its refund must go to the sponsor balance
+func (k Keeper) ConvertGasRefund(ctx sdk.Context, account sdk.AccAddress, refund sdk.Coins) (sdk.Coins, sdk.AccAddress, error) {
+	// Get the fee charged for the tx
+	key := getChargedFeeKey(ctx, account)
+	chargedFee, err := k.ChargedFees.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return refund, nil, nil
+	}
+	if err != nil {
+		return sdk.Coins{}, nil, err
+	}
+
+	// Get the sponsor that paid the fee
+	var sponsor sdk.AccAddress
+	if chargedFee.Sponsor != "" {
+		sponsor, err = sdk.AccAddressFromBech32(chargedFee.Sponsor)
+		if err != nil {
+			return sdk.Coins{}, nil, err
+		}
+	}
+
+	// Only a single native refund can be converted
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, nil, err
+	}
+	if len(refund) != 1 || refund[0].Denom != params.NativeDenom {
+		return refund, sponsor, nil
+	}
+
+	// The tx is refunded a single time
+	if err := k.ChargedFees.Remove(ctx, key); err != nil {
+		return sdk.Coins{}, nil, err
+	}
+
+	// Native charges keep the refund as is
+	if !chargedFee.IsConverted() {
+		return refund, nil, nil
+	}
+
+	// Convert the refund at the charged price, the refunded fees are no longer routed
+	convertedRefund := sdk.NewCoins(sdk.NewCoin(chargedFee.Denom, chargedFee.GetRefund(refund[0].Amount)))
+	if err := k.SubCollectedFees(ctx, convertedRefund); err != nil {
+		return sdk.Coins{}, nil, err
+	}
+
+	// The refunded fee tokens no longer count on the volume caps and the statistics
+	if chargedFee.Denom != params.NativeDenom && convertedRefund.IsAllPositive() {
+		if err := k.subFeeTokenRefund(ctx, chargedFee.Denom, convertedRefund.AmountOf(chargedFee.Denom), refund[0].Amount); err != nil {
+			return sdk.Coins{}, nil, err
+		}
+	}
+
+	// Emit an event for the refund conversion
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRefundFees,
+			sdk.NewAttribute(types.TypeAttributeFeePayer, account.String()),
+			sdk.NewAttribute(types.TypeAttributeOriginalRefund, refund.String()),
+			sdk.NewAttribute(types.TypeAttributeConvertedRefund, convertedRefund.String()),
+		),
+	)
+
+	return convertedRefund, sponsor, nil
+}
+
+// setChargedFee stores the fee charged for the EVM msg of an account on the current tx
+// The refunds don't tell the msgs of a tx apart, so converted fees support a single EVM msg per account in a tx
+func (k Keeper) setChargedFee(ctx sdk.Context, account sdk.AccAddress, chargedFee types.ChargedFee) error {
+	// Check for another EVM msg of the account on the tx
+	key := getChargedFeeKey(ctx, account)
+	existing, err := k.ChargedFees.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && (existing.IsConverted() || chargedFee.IsConverted()) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"account %s has more than one EVM msg on the tx, fee tokens and sponsors support a single EVM msg per account",
+			account,
+		)
+	}
+
+	return k.ChargedFees.Set(ctx, key, chargedFee)
+}
+
+// subFeeTokenRefund removes the refunded part of a fee token charge from the usage and the statistics
+func (k Keeper) subFeeTokenRefund(ctx sdk.Context, denom string, amount, nativeValue math.Int) error {
+	// Update the volume caps usage
+	usage, err := k.GetFeeTokenUsage(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if err := k.FeeTokenUsages.Set(ctx, denom, usage.Sub(amount)); err != nil {
+		return err
+	}
+
+	// Update the statistics
+	return k.SubFeeTokenStats(ctx, denom, amount, nativeValue)
+}
+
+// getChargedFeeKey returns the key of the fee charged for an account on the current tx
+// The key includes the tx hash, so a record is never used by another tx of the account
+func getChargedFeeKey(ctx sdk.Context, account sdk.AccAddress) collections.Pair[[]byte, sdk.AccAddress] {
+	return collections.Join([]byte(cmttypes.Tx(ctx.TxBytes()).Hash()), account)
+}
+
+// RefundBankKeeper wraps the bank keeper used by the EVM module
+// The refunds of unused gas sent from the fee collector are paid on the fee token charged for the tx
+// Only the sends to an account with a fee recorded by the EVM ante handler on the same tx are converted,
+// any other send from the fee collector is kept as is
+type RefundBankKeeper struct {
+	bankkeeper.Keeper
+
+	// The fee abstraction keeper is created after the EVM keeper, only its pointer is used
+	feeAbstractionKeeper *Keeper
+}
+
+// NewRefundBankKeeper creates a new RefundBankKeeper instance
+func NewRefundBankKeeper(bankKeeper bankkeeper.Keeper, feeAbstractionKeeper *Keeper) RefundBankKeeper {
+	return RefundBankKeeper{
+		Keeper:               bankKeeper,
+		feeAbstractionKeeper: feeAbstractionKeeper,
+	}
+}
+
+// SendCoinsFromModuleToAccount converts the refunds sent from the fee collector before sending them
+// The refunds of sponsored txs are returned to the sponsor balance instead of the tx sender
+func (k RefundBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule == authtypes.FeeCollectorName {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		refund, sponsor, err := k.feeAbstractionKeeper.ConvertGasRefund(sdkCtx, recipientAddr, amt)
+		if err != nil {
+			return err
+		}
+		if sponsor != nil {
+			return k.feeAbstractionKeeper.RefundSponsor(sdkCtx, sponsor, refund)
+		}
+		// Nothing is sent for refunds converted to zero, such as the refunds of ERC20 fees
+		if refund.IsZero() {
+			return nil
+		}
+		amt = refund
+	}
+
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
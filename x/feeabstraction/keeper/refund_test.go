@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/keeper"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestSetChargedFee tests the SetChargedFee function
+func (s *KeeperTestSuite) TestSetChargedFee() {
+	ctx, _ := s.ctx.CacheContext()
+	account := sdk.AccAddress("account")
+	nativeFees := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+	feeTokenFees := sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(500)))
+
+	// A fee token charge is recorded for the tx
+	txCtx := ctx.WithTxBytes([]byte("tx"))
+	s.Require().NoError(s.keeper.SetChargedFee(txCtx, account, nativeFees, feeTokenFees))
+	chargedFee, err := s.keeper.GetChargedFee(txCtx, account)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewChargedFee("uatom", math.NewInt(500), math.NewInt(1000)), chargedFee)
+
+	// The record isn't visible to other txs of the account
+	otherTxCtx := ctx.WithTxBytes([]byte("other tx"))
+	_, err = s.keeper.GetChargedFee(otherTxCtx, account)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+
+	// A second EVM msg of the account on the tx is rejected
+	err = s.keeper.SetChargedFee(txCtx, account, nativeFees, feeTokenFees)
+	s.Require().ErrorContains(err, "has more than one EVM msg on the tx")
+	err = s.keeper.SetChargedFee(txCtx, account, nativeFees, nativeFees)
+	s.Require().ErrorContains(err, "has more than one EVM msg on the tx")
+
+	// A native charge is recorded without conversion
+	s.Require().NoError(s.keeper.SetChargedFee(otherTxCtx, account, nativeFees, nativeFees))
+	chargedFee, err = s.keeper.GetChargedFee(otherTxCtx, account)
+	s.Require().NoError(err)
+	s.Require().False(chargedFee.IsConverted())
+
+	// Several native EVM msgs of the account on the tx are allowed
+	s.Require().NoError(s.keeper.SetChargedFee(otherTxCtx, account, nativeFees, nativeFees))
+
+	// Fees not charged to the account are recorded without conversion
+	emptyTxCtx := ctx.WithTxBytes([]byte("empty tx"))
+	s.Require().NoError(s.keeper.SetChargedFee(emptyTxCtx, account, nativeFees, sdk.Coins{}))
+	chargedFee, err = s.keeper.GetChargedFee(emptyTxCtx, account)
+	s.Require().NoError(err)
+	s.Require().False(chargedFee.IsConverted())
+}
+
+// TestConvertGasRefund tests the ConvertGasRefund function
+func (s *KeeperTestSuite) TestConvertGasRefund() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithTxBytes([]byte("tx"))
+	account := sdk.AccAddress("account")
+	nativeRefund := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(400)))
+
+	// Enable the statistics
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.StatsRetentionDays = 30
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// Refunds without a record are kept
+	refund, sponsor, err := s.keeper.ConvertGasRefund(ctx, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Nil(sponsor)
+	s.Require().Equal(nativeRefund, refund)
+
+	// Record a fee token charge, with its usage and statistics
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+	usage := types.NewFeeTokenUsage(ctx.BlockHeight(), day)
+	s.Require().NoError(s.keeper.FeeTokenUsages.Set(ctx, "uatom", usage.Add(math.NewInt(500))))
+	s.Require().NoError(s.keeper.RecordFeeTokenStats(ctx, "uatom", math.NewInt(500), math.NewInt(1000), types.FeePaymentSourceBalance))
+	err = s.keeper.SetChargedFee(
+		ctx, account,
+		sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+		sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(500))),
+	)
+	s.Require().NoError(err)
+
+	// Refunds of other txs are kept
+	refund, sponsor, err = s.keeper.ConvertGasRefund(ctx.WithTxBytes([]byte("other tx")), account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Nil(sponsor)
+	s.Require().Equal(nativeRefund, refund)
+
+	// Refunds on other denoms are kept
+	otherRefund := sdk.NewCoins(sdk.NewCoin("uosmo", math.NewInt(400)))
+	refund, sponsor, err = s.keeper.ConvertGasRefund(ctx, account, otherRefund)
+	s.Require().NoError(err)
+	s.Require().Nil(sponsor)
+	s.Require().Equal(otherRefund, refund)
+
+	// The native refund is converted at the charged price
+	refund, sponsor, err = s.keeper.ConvertGasRefund(ctx, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Nil(sponsor)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(200))), refund)
+
+	// The refunded part is removed from the usage and the statistics
+	usage, err = s.keeper.GetFeeTokenUsage(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(300), usage.BlockVolume)
+	s.Require().Equal(math.NewInt(300), usage.DailyVolume)
+	stats, err := s.keeper.GetFeeTokenStats(ctx, day, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), stats.TxCount)
+	s.Require().Equal(math.NewInt(300), stats.ConvertedAmount)
+	s.Require().Equal(math.NewInt(600), stats.NativeValue)
+
+	// The record is removed after the refund
+	refund, sponsor, err = s.keeper.ConvertGasRefund(ctx, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Nil(sponsor)
+	s.Require().Equal(nativeRefund, refund)
+}
+
+// TestConvertGasRefundERC20 tests that the ERC20 fees are final and their refunds are dropped
+func (s *KeeperTestSuite) TestConvertGasRefundERC20() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithTxBytes([]byte("tx"))
+	account := sdk.AccAddress("account")
+	bankKeeper := keeper.NewRefundBankKeeper(s.app.BankKeeper, &s.keeper)
+
+	// Register the ERC20 fee token
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	feeToken := types.NewFeeTokenMetadata(types.GetERC20FeeTokenDenom(contract), "erc20oracle", 18, math.LegacyOneDec())
+	feeToken.Erc20Address = contract.Hex()
+	s.Require().NoError(s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(feeToken)))
+
+	// Record an ERC20 charge, nothing is left to refund
+	err := s.keeper.SetChargedFee(
+		ctx, account,
+		sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+		sdk.NewCoins(sdk.NewCoin(feeToken.Denom, math.NewInt(1000))),
+	)
+	s.Require().NoError(err)
+	chargedFee, err := s.keeper.GetChargedFee(ctx, account)
+	s.Require().NoError(err)
+	s.Require().True(chargedFee.Amount.IsZero())
+
+	// The native refund sent from the fee collector is dropped
+	s.fundFeeCollector(ctx, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))))
+	err = bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, account, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(400))))
+	s.Require().NoError(err)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, account).IsZero())
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.Require().Equal(math.NewInt(1000), s.app.BankKeeper.GetBalance(ctx, feeCollector, "akii").Amount)
+}
+
+// TestRefundBankKeeper tests the refunds sent through the RefundBankKeeper
+func (s *KeeperTestSuite) TestRefundBankKeeper() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithTxBytes([]byte("tx"))
+	account := sdk.AccAddress("account")
+	bankKeeper := keeper.NewRefundBankKeeper(s.app.BankKeeper, &s.keeper)
+
+	// The fee collector holds the charged fees
+	s.fundFeeCollector(ctx, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)), sdk.NewCoin("uatom", math.NewInt(500))))
+	err := s.keeper.SetChargedFee(
+		ctx, account,
+		sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+		sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(500))),
+	)
+	s.Require().NoError(err)
+
+	// Sends from the fee collector on other txs aren't converted
+	nativeRefund := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(400)))
+	err = bankKeeper.SendCoinsFromModuleToAccount(ctx.WithTxBytes([]byte("other tx")), authtypes.FeeCollectorName, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(400), s.app.BankKeeper.GetBalance(ctx, account, "akii").Amount)
+
+	// The refund is paid on the charged fee token
+	err = bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(200), s.app.BankKeeper.GetBalance(ctx, account, "uatom").Amount)
+	s.Require().Equal(math.NewInt(400), s.app.BankKeeper.GetBalance(ctx, account, "akii").Amount)
+
+	// Without a record the refund stays native
+	err = bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(800), s.app.BankKeeper.GetBalance(ctx, account, "akii").Amount)
+}
+
+// TestRefundBankKeeperSponsor tests that the refunds of sponsored txs are returned to the sponsor
+func (s *KeeperTestSuite) TestRefundBankKeeperSponsor() {
+	ctx, _ := s.ctx.CacheContext()
+	ctx = ctx.WithTxBytes([]byte("tx"))
+	account := sdk.AccAddress("account")
+	contract := sdk.AccAddress("contract")
+	bankKeeper := keeper.NewRefundBankKeeper(s.app.BankKeeper, &s.keeper)
+	fees := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+
+	// The sponsor paid the fees to the fee collector
+	sponsor := types.NewSponsor(contract.String(), contract.String(), types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()))
+	sponsor.Spent = fees
+	s.Require().NoError(s.keeper.Sponsors.Set(ctx, contract, sponsor))
+	s.fundFeeCollector(ctx, fees)
+	s.Require().NoError(s.keeper.SetSponsoredFee(ctx, account, contract, fees, fees))
+
+	// The refund goes back to the sponsor balance
+	nativeRefund := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(400)))
+	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, account, nativeRefund)
+	s.Require().NoError(err)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, account).IsZero())
+
+	sponsor, err = s.keeper.GetSponsor(ctx, contract)
+	s.Require().NoError(err)
+	s.Require().Equal(nativeRefund.String(), sponsor.Balance.String())
+	s.Require().Equal(math.NewInt(600), sponsor.Spent.AmountOf("akii"))
+	moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().Equal(math.NewInt(400), s.app.BankKeeper.GetBalance(ctx, moduleAddr, "akii").Amount)
+
+	// The record is removed after the refund
+	_, err = s.keeper.GetChargedFee(ctx, account)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+}
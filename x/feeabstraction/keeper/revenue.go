@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/app/params"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// GetFeeRevenue returns the fee revenue of a denom
+// An empty revenue is returned if no fees were collected on the denom
+func (k Keeper) GetFeeRevenue(ctx context.Context, denom string) (types.FeeRevenue, error) {
+	revenue, err := k.FeeRevenues.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewFeeRevenue(denom), nil
+	}
+	return revenue, err
+}
+
+// AddCollectedFees tracks the fee tokens sent to the fee collector as fees
+// Only the tracked amounts are routed, so other funds on the fee collector are left to the distribution
+func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
+	// Get the params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Track each fee token, the native denom is never routed
+	for _, fee := range fees {
+		if fee.Denom == params.NativeDenom || !fee.IsPositive() {
+			continue
+		}
+		collected, err := k.getCollectedFees(ctx, fee.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.CollectedFees.Set(ctx, fee.Denom, collected.Add(fee.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SubCollectedFees removes the fees refunded from the fee collector from the tracked amounts
+func (k Keeper) SubCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
+	for _, fee := range fees {
+		// Get the tracked amount
+		collected, err := k.getCollectedFees(ctx, fee.Denom)
+		if err != nil {
+			return err
+		}
+		if collected.IsZero() {
+			continue
+		}
+
+		// Remove the entry once nothing is left
+		if collected.LTE(fee.Amount) {
+			if err := k.CollectedFees.Remove(ctx, fee.Denom); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.CollectedFees.Set(ctx, fee.Denom, collected.Sub(fee.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getCollectedFees returns the fees tracked on a denom since the last routing
+func (k Keeper) getCollectedFees(ctx sdk.Context, denom string) (math.Int, error) {
+	collected, err := k.CollectedFees.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return collected, err
+}
+
+// RouteFeeRevenue routes the fee tokens collected on the fee collector to their destinations
+// Only the fees tracked since the last routing are routed, capped by the fee collector balance
+// Fee tokens routed to the fee collector are held by the module if the settlement is enabled
+func (k Keeper) RouteFeeRevenue(ctx sdk.Context) error {
+	// Get the params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Collect the tracked fees, they are cleared once routed
+	var collectedFees sdk.Coins
+	err = k.CollectedFees.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		collectedFees = append(collectedFees, sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.CollectedFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// Route the fees of each fee token
+	settle := params.SettlementInterval > 0
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	for _, collected := range collectedFees {
+		// The native denom is never routed
+		if collected.Denom == params.NativeDenom {
+			continue
+		}
+
+		// The fees can't exceed the fee collector balance
+		balance := k.bankKeeper.GetBalance(ctx, feeCollector, collected.Denom)
+		amount := sdk.NewCoin(collected.Denom, math.MinInt(collected.Amount, balance.Amount))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		// Send the fees to the destination
+		destination := params.GetRevenueDestination(amount.Denom)
+		if err := k.routeFees(ctx, sdk.NewCoins(amount), destination, settle); err != nil {
+			return err
+		}
+
+		// Track the routed amount
+		revenue, err := k.GetFeeRevenue(ctx, amount.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.FeeRevenues.Set(ctx, amount.Denom, revenue.AddRouted(destination, amount.Amount, settle)); err != nil {
+			return err
+		}
+
+		// Emit the event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeEventRouteFeeRevenue,
+				sdk.NewAttribute(types.TypeAttributeAmount, amount.String()),
+				sdk.NewAttribute(types.TypeAttributeDestination, destination.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// SettleFeeRevenue sells the fee tokens held by the module to the native reserve at the token price
+// The reserve pays with native tokens sent to the fee collector, if it can't pay for all the held
+// tokens only the amount it can pay for is settled
+func (k Keeper) SettleFeeRevenue(ctx sdk.Context) error {
+	// Get the params and the fee tokens
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	feeTokens, err := k.FeeTokens.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Collect the revenues pending settlement
+	var pending []types.FeeRevenue
+	err = k.FeeRevenues.Walk(ctx, nil, func(_ string, revenue types.FeeRevenue) (bool, error) {
+		if revenue.PendingSettlement.IsPositive() {
+			pending = append(pending, revenue)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Settle each revenue
+	reserve := authtypes.NewModuleAddress(types.ReserveName)
+	for _, revenue := range pending {
+		// Tokens without a price are held until the price recovers
+		token, found := feeTokens.GetByDenom(revenue.Denom)
+		if !found || !token.Price.IsPositive() {
+			continue
+		}
+
+		// Nothing else can be settled if the reserve is empty
+		reserveBalance := k.bankKeeper.GetBalance(ctx, reserve, params.NativeDenom)
+		if !reserveBalance.IsPositive() {
+			return nil
+		}
+
+		// Calculate the settled amounts
+		amount, nativeAmount, err := calculateSettlement(revenue.PendingSettlement, reserveBalance.Amount, token)
+		if err != nil {
+			return err
+		}
+		if !amount.IsPositive() || !nativeAmount.IsPositive() {
+			continue
+		}
+
+		// Swap the tokens with the reserve
+		nativeCoins := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, nativeAmount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ReserveName, authtypes.FeeCollectorName, nativeCoins); err != nil {
+			return err
+		}
+		tokenCoins := sdk.NewCoins(sdk.NewCoin(revenue.Denom, amount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ReserveName, tokenCoins); err != nil {
+			return err
+		}
+
+		// Track the settled amount
+		if err := k.FeeRevenues.Set(ctx, revenue.Denom, revenue.AddSettled(amount, nativeAmount)); err != nil {
+			return err
+		}
+
+		// Emit the event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.TypeEventSettleFeeRevenue,
+				sdk.NewAttribute(types.TypeAttributeAmount, tokenCoins.String()),
+				sdk.NewAttribute(types.TypeAttributeNativeAmount, nativeCoins.String()),
+				sdk.NewAttribute(types.TypeAttributePrice, token.Price.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// WithdrawRevenue sends funds from the treasury or the reserve module account to the recipient
+// The reserve funds are used on the settlements, so withdrawing them limits the next settlements
+func (k Keeper) WithdrawRevenue(ctx sdk.Context, account string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	moduleName, found := types.GetRevenueAccountName(account)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidFeeRevenue, "unknown revenue account: %s", account)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, moduleName, recipient, amount)
+}
+
+// routeFees sends the fees from the fee collector to the destination
+func (k Keeper) routeFees(ctx sdk.Context, fees sdk.Coins, destination types.RevenueDestination, settle bool) error {
+	switch destination {
+	case types.RevenueDestinationCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, fees, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	case types.RevenueDestinationBurn:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees); err != nil {
+			return err
+		}
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+	case types.RevenueDestinationTreasury:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.TreasuryName, fees)
+	default:
+		// The fees stay on the fee collector unless they are held for the settlement
+		if !settle {
+			return nil
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees)
+	}
+}
+
+// calculateSettlement calculates the token amount settled and the native amount paid for it
+// The price is token/native, and the amount is limited by the native reserve balance
+func calculateSettlement(pending, reserveBalance math.Int, token types.FeeTokenMetadata) (math.Int, math.Int, error) {
+	// Convert the pending tokens to the native denom
+	nativeAmount, err := calculateNativeAmount(pending, token)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	if nativeAmount.LTE(reserveBalance) {
+		return pending, nativeAmount, nil
+	}
+
+	// Settle only the amount the reserve can pay for
+	amount, err := types.CalculateTokenAmountWithDecimals(
+		token.Price,
+		reserveBalance,
+		params.BaseDenomUnit,
+		uint64(token.Decimals),
+	)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	return math.MinInt(amount.TruncateInt(), pending), reserveBalance, nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestRouteFeeRevenue tests the RouteFeeRevenue function
+func (s *KeeperTestSuite) TestRouteFeeRevenue() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Register a fee token for each destination
+	err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+		types.NewFeeTokenMetadata("uosmo", "osmooracle", 6, math.LegacyOneDec()),
+		types.NewFeeTokenMetadata("ujuno", "junooracle", 6, math.LegacyOneDec()),
+		types.NewFeeTokenMetadata("ustars", "starsoracle", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Route the tokens, ustars has no route and stays on the fee collector
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.RevenueRoutes = []types.RevenueRoute{
+		types.NewRevenueRoute("uatom", types.RevenueDestinationBurn),
+		types.NewRevenueRoute("uosmo", types.RevenueDestinationTreasury),
+		types.NewRevenueRoute("ujuno", types.RevenueDestinationCommunityPool),
+	}
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// Collect the fees
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100),
+		sdk.NewInt64Coin("uosmo", 200),
+		sdk.NewInt64Coin("ujuno", 300),
+		sdk.NewInt64Coin("ustars", 400),
+	)
+	s.fundFeeCollector(ctx, fees)
+	s.Require().NoError(s.keeper.AddCollectedFees(ctx, fees))
+
+	// Funds that weren't collected as fees, such as rewards releases, aren't routed
+	s.fundFeeCollector(ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)))
+
+	// Route the fees
+	s.Require().NoError(s.keeper.RouteFeeRevenue(ctx))
+
+	// Only the fees without a route and the funds not collected as fees stay on the fee collector
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	s.Require().Equal(sdk.NewInt64Coin("ustars", 400).String(), s.app.BankKeeper.GetBalance(ctx, feeCollector, "ustars").String())
+	s.Require().Equal(sdk.NewInt64Coin("uatom", 50).String(), s.app.BankKeeper.GetBalance(ctx, feeCollector, "uatom").String())
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, feeCollector, "uosmo").IsZero())
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, feeCollector, "ujuno").IsZero())
+
+	// Check each destination
+	s.Require().True(s.app.BankKeeper.GetSupply(ctx, "uatom").IsZero())
+	treasury := authtypes.NewModuleAddress(types.TreasuryName)
+	s.Require().Equal(math.NewInt(200), s.app.BankKeeper.GetBalance(ctx, treasury, "uosmo").Amount)
+	feePool, err := s.app.DistrKeeper.FeePool.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDec(300), feePool.CommunityPool.AmountOf("ujuno"))
+
+	// Check the tracked revenues
+	revenue, err := s.keeper.GetFeeRevenue(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), revenue.Burned)
+	revenue, err = s.keeper.GetFeeRevenue(ctx, "uosmo")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(200), revenue.Treasury)
+	revenue, err = s.keeper.GetFeeRevenue(ctx, "ujuno")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(300), revenue.CommunityPool)
+	revenue, err = s.keeper.GetFeeRevenue(ctx, "ustars")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(400), revenue.FeeCollector)
+
+	// Check the revenues through the querier
+	res, err := s.querier.FeeRevenues(ctx, &types.QueryFeeRevenuesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Revenues, 4)
+
+	// The tracked fees are cleared once routed
+	s.Require().NoError(s.keeper.RouteFeeRevenue(ctx))
+	s.Require().Equal(sdk.NewInt64Coin("uatom", 50).String(), s.app.BankKeeper.GetBalance(ctx, feeCollector, "uatom").String())
+	has, err := s.keeper.CollectedFees.Has(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+// TestCollectedFees tests the tracking of the fees collected for the revenue routing
+func (s *KeeperTestSuite) TestCollectedFees() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// The native denom is never tracked
+	fees := sdk.NewCoins(sdk.NewInt64Coin("akii", 1000), sdk.NewInt64Coin("uatom", 100))
+	s.Require().NoError(s.keeper.AddCollectedFees(ctx, fees))
+	s.Require().NoError(s.keeper.AddCollectedFees(ctx, fees))
+	has, err := s.keeper.CollectedFees.Has(ctx, "akii")
+	s.Require().NoError(err)
+	s.Require().False(has)
+	collected, err := s.keeper.CollectedFees.Get(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(200), collected)
+
+	// The refunds are removed from the tracked fees
+	s.Require().NoError(s.keeper.SubCollectedFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 50))))
+	collected, err = s.keeper.CollectedFees.Get(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(150), collected)
+
+	// The entry is removed once nothing is left
+	s.Require().NoError(s.keeper.SubCollectedFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("uatom", 500))))
+	has, err = s.keeper.CollectedFees.Has(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+// TestSettleFeeRevenue tests the SettleFeeRevenue function
+func (s *KeeperTestSuite) TestSettleFeeRevenue() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Register the fee token, 1 atom per kii
+	err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Enable the settlement
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.SettlementInterval = 1
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// Collect and route the fees, they are held by the module
+	collectedFees := sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6)))
+	s.fundFeeCollector(ctx, collectedFees)
+	s.Require().NoError(s.keeper.AddCollectedFees(ctx, collectedFees))
+	s.Require().NoError(s.keeper.RouteFeeRevenue(ctx))
+	revenue, err := s.keeper.GetFeeRevenue(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(convertToMinimalDenomination(2, 6), revenue.PendingSettlement)
+
+	// Nothing is settled while the reserve is empty
+	s.Require().NoError(s.keeper.SettleFeeRevenue(ctx))
+	revenue, err = s.keeper.GetFeeRevenue(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().True(revenue.Settled.IsZero())
+
+	// Fund the reserve with enough to buy half the fees
+	reserveFunds := sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)))
+	s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, reserveFunds))
+	s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, types.ReserveName, reserveFunds))
+
+	// Settle the fees
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	nativeBefore := s.app.BankKeeper.GetBalance(ctx, feeCollector, "akii")
+	s.Require().NoError(s.keeper.SettleFeeRevenue(ctx))
+
+	// The reserve bought half of the fees
+	revenue, err = s.keeper.GetFeeRevenue(ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(convertToMinimalDenomination(1, 6), revenue.PendingSettlement)
+	s.Require().Equal(convertToMinimalDenomination(1, 6), revenue.Settled)
+	s.Require().Equal(convertToMinimalDenomination(1, 18), revenue.SettledNative)
+
+	// Check the balances
+	reserve := authtypes.NewModuleAddress(types.ReserveName)
+	s.Require().Equal(convertToMinimalDenomination(1, 6), s.app.BankKeeper.GetBalance(ctx, reserve, "uatom").Amount)
+	s.Require().True(s.app.BankKeeper.GetBalance(ctx, reserve, "akii").IsZero())
+	nativeAfter := s.app.BankKeeper.GetBalance(ctx, feeCollector, "akii")
+	s.Require().Equal(convertToMinimalDenomination(1, 18), nativeAfter.Amount.Sub(nativeBefore.Amount))
+}
+
+// TestWithdrawRevenue tests moving funds out of the treasury and the reserve module accounts
+func (s *KeeperTestSuite) TestWithdrawRevenue() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Fund the treasury and the reserve
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := apptesting.RandomAccountAddress()
+	funds := sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6)))
+	for _, moduleName := range []string{types.TreasuryName, types.ReserveName} {
+		s.Require().NoError(s.app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+		s.Require().NoError(s.app.BankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, moduleName, funds))
+	}
+	amount := sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6)))
+
+	// Only governance can withdraw
+	_, err := s.msgServer.WithdrawRevenue(ctx, types.NewMessageWithdrawRevenue(recipient.String(), types.RevenueAccountTreasury, recipient.String(), amount))
+	s.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	// Withdraw from the treasury and the reserve
+	_, err = s.msgServer.WithdrawRevenue(ctx, types.NewMessageWithdrawRevenue(authority, types.RevenueAccountTreasury, recipient.String(), amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.WithdrawRevenue(ctx, types.NewMessageWithdrawRevenue(authority, types.RevenueAccountReserve, recipient.String(), amount))
+	s.Require().NoError(err)
+	s.Require().Equal(amount.Add(amount...).String(), s.app.BankKeeper.GetAllBalances(ctx, recipient).String())
+	s.Require().Equal(amount.String(), s.app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.TreasuryName)).String())
+	s.Require().Equal(amount.String(), s.app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ReserveName)).String())
+
+	// The withdraw can't exceed the module account balance
+	_, err = s.msgServer.WithdrawRevenue(ctx, types.NewMessageWithdrawRevenue(authority, types.RevenueAccountTreasury, recipient.String(), funds))
+	s.Require().ErrorContains(err, "insufficient funds")
+
+	// A nil msg is rejected
+	_, err = s.msgServer.WithdrawRevenue(ctx, nil)
+	s.Require().ErrorContains(err, "msg cannot be nil")
+}
+
+// fundFeeCollector is a helper function to fund the fee collector with a specific amount of coins
+func (s *KeeperTestSuite) fundFeeCollector(ctx sdk.Context, amount sdk.Coins) {
+	err := s.app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, amount)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, authtypes.FeeCollectorName, amount)
+	s.Require().NoError(err)
+}
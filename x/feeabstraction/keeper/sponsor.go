@@ -0,0 +1,344 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// RegisterSponsor registers a wasm or EVM contract as a fee sponsor
+// The sender must be the contract admin and becomes the sponsor admin
+func (k Keeper) RegisterSponsor(ctx sdk.Context, sender, contract sdk.AccAddress, nonces []uint64, deposit sdk.Coins, policy types.SponsorPolicy) error {
+	// Check if the sponsor already exists
+	exists, err := k.Sponsors.Has(ctx, contract)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errorsmod.Wrapf(types.ErrSponsorExists, "contract %s", contract)
+	}
+
+	// Check if the sender can manage the contract
+	if err := k.ValidateContractAdmin(ctx, sender, contract, nonces); err != nil {
+		return err
+	}
+
+	// Move the deposit to the module account
+	sponsor := types.NewSponsor(contract.String(), sender.String(), policy)
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit); err != nil {
+			return err
+		}
+		sponsor.Balance = deposit
+	}
+
+	return k.Sponsors.Set(ctx, contract, sponsor)
+}
+
+// UpdateSponsorPolicy updates the policy of a fee sponsor
+func (k Keeper) UpdateSponsorPolicy(ctx sdk.Context, sender, contract sdk.AccAddress, policy types.SponsorPolicy) error {
+	// Get the sponsor managed by the sender
+	sponsor, err := k.getSponsorForAdmin(ctx, sender, contract)
+	if err != nil {
+		return err
+	}
+
+	// Update the policy, the usages are kept
+	sponsor.Policy = policy
+	return k.Sponsors.Set(ctx, contract, sponsor)
+}
+
+// FundSponsor adds funds to the balance of a fee sponsor
+func (k Keeper) FundSponsor(ctx sdk.Context, sender, contract sdk.AccAddress, amount sdk.Coins) error {
+	// Get the sponsor
+	sponsor, err := k.GetSponsor(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// Move the funds to the module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	// Update the balance
+	sponsor.Balance = sponsor.Balance.Add(amount...)
+	return k.Sponsors.Set(ctx, contract, sponsor)
+}
+
+// WithdrawSponsorFunds withdraws funds from the balance of a fee sponsor to its admin
+func (k Keeper) WithdrawSponsorFunds(ctx sdk.Context, sender, contract sdk.AccAddress, amount sdk.Coins) error {
+	// Get the sponsor managed by the sender
+	sponsor, err := k.getSponsorForAdmin(ctx, sender, contract)
+	if err != nil {
+		return err
+	}
+
+	// Check the sponsor balance
+	balance, hasNeg := sponsor.Balance.SafeSub(amount...)
+	if hasNeg {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "sponsor balance %s is smaller than %s", sponsor.Balance, amount)
+	}
+
+	// Send the funds to the admin
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, amount); err != nil {
+		return err
+	}
+
+	// Update the balance
+	sponsor.Balance = balance
+	return k.Sponsors.Set(ctx, contract, sponsor)
+}
+
+// GetSponsor returns the fee sponsor of a contract
+func (k Keeper) GetSponsor(ctx context.Context, contract sdk.AccAddress) (types.Sponsor, error) {
+	sponsor, err := k.Sponsors.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsor{}, errorsmod.Wrapf(types.ErrUnknownSponsor, "contract %s", contract)
+	}
+	return sponsor, err
+}
+
+// IsSponsor returns true if the contract is registered as a fee sponsor
+func (k Keeper) IsSponsor(ctx sdk.Context, contract sdk.AccAddress) (bool, error) {
+	return k.Sponsors.Has(ctx, contract)
+}
+
+// GetSponsorUsage returns the usage of a fee sponsor by a user
+func (k Keeper) GetSponsorUsage(ctx context.Context, contract, user sdk.AccAddress) (types.SponsorUsage, error) {
+	usage, err := k.SponsorUsages.Get(ctx, collections.Join(contract, user))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewSponsorUsage(), nil
+	}
+	return usage, err
+}
+
+// ChargeSponsor pays the native fees of a user tx with the sponsor balance
+// The msgs, the fee and the gas price are checked against the sponsor policy and the user quotas, the charged fee is returned
+func (k Keeper) ChargeSponsor(ctx sdk.Context, contract, user sdk.AccAddress, msgs []sdk.Msg, fees sdk.Coins, gasLimit uint64) (sdk.Coins, error) {
+	// Get the sponsor
+	sponsor, err := k.GetSponsor(ctx, contract)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Check the sponsor policy
+	if err := sponsor.Policy.Allows(contract.String(), getSponsoredMsgs(msgs)); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Nothing is charged for zero fees
+	if fees.IsZero() {
+		return fees, nil
+	}
+	if len(fees) != 1 {
+		return sdk.Coins{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "sponsored fees must have a single coin, got %s", fees)
+	}
+	fee := fees[0]
+
+	// Check the fee and the gas price limits of the policy
+	if err := sponsor.Policy.CheckFee(fee.Amount, gasLimit); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Check the user quota
+	usage, err := k.GetSponsorUsage(ctx, contract, user)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := usage.CheckQuota(sponsor.Policy, fee.Amount); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Calculate the fee using the sponsor balance
+	charged, err := k.convertSponsorFee(ctx, sponsor.Balance, fee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Pay the fee from the module account
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, charged); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.AddCollectedFees(ctx, charged); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Update the sponsor and the user usage
+	sponsor.Balance = sponsor.Balance.Sub(charged...)
+	sponsor.Spent = sponsor.Spent.Add(charged...)
+	sponsor.TxCount++
+	if err := k.Sponsors.Set(ctx, contract, sponsor); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.SponsorUsages.Set(ctx, collections.Join(contract, user), usage.Add(fee.Amount)); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Record the usage statistics when the sponsor paid with a fee token
+	if charged[0].Denom != fee.Denom {
+		if err := k.RecordFeeTokenStats(ctx, charged[0].Denom, charged[0].Amount, fee.Amount, types.FeePaymentSourceSponsor); err != nil {
+			return sdk.Coins{}, err
+		}
+	}
+
+	// Emit the sponsored fee event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSponsorFee,
+			sdk.NewAttribute(types.TypeAttributeSponsor, contract.String()),
+			sdk.NewAttribute(types.TypeAttributeFeePayer, user.String()),
+			sdk.NewAttribute(types.TypeAttributeOriginalFeeAmount, fee.String()),
+			sdk.NewAttribute(types.TypeAttributeFee, charged.String()),
+		),
+	)
+
+	return charged, nil
+}
+
+// RefundSponsor returns the refund of unused gas of a sponsored tx to the sponsor balance
+func (k Keeper) RefundSponsor(ctx sdk.Context, contract sdk.AccAddress, refund sdk.Coins) error {
+	// Nothing to refund
+	if refund.IsZero() {
+		return nil
+	}
+
+	// Get the sponsor
+	sponsor, err := k.GetSponsor(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// Move the refund from the fee collector back to the module account
+	// The refund was already removed from the collected fees by the conversion
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, refund); err != nil {
+		return err
+	}
+
+	// Update the sponsor balance, the refund was part of the spent fees
+	sponsor.Balance = sponsor.Balance.Add(refund...)
+	if spent, hasNeg := sponsor.Spent.SafeSub(refund...); !hasNeg {
+		sponsor.Spent = spent
+	}
+	if err := k.Sponsors.Set(ctx, contract, sponsor); err != nil {
+		return err
+	}
+
+	// Emit the sponsor refund event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSponsorRefund,
+			sdk.NewAttribute(types.TypeAttributeSponsor, contract.String()),
+			sdk.NewAttribute(types.TypeAttributeAmount, refund.String()),
+		),
+	)
+
+	return nil
+}
+
+// convertSponsorFee calculates the fee charged from the sponsor balance
+// The native denom is tried first, then the active fee tokens in order
+func (k Keeper) convertSponsorFee(ctx sdk.Context, balance sdk.Coins, fee sdk.Coin) (sdk.Coins, error) {
+	// Check the native balance
+	if balance.AmountOf(fee.Denom).GTE(fee.Amount) {
+		return sdk.Coins{fee}, nil
+	}
+
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	// Fee tokens can only be used for the native fees when the module is enabled
+	if params.Enabled && fee.Denom == params.NativeDenom {
+		feeTokens, err := k.FeeTokens.Get(ctx)
+		if err != nil {
+			return sdk.Coins{}, err
+		}
+
+		for _, feeToken := range feeTokens.Items {
+			// Check if the token is active
+			if !feeToken.IsActive() {
+				continue
+			}
+
+			// Convert the amount using the price
+			amount, err := calculateFeeTokenAmount(fee, feeToken)
+			if err != nil {
+				return sdk.Coins{}, err
+			}
+			if amount.IsZero() || balance.AmountOf(feeToken.Denom).LT(amount) {
+				continue
+			}
+
+			// Tokens that reached their volume caps are skipped
+			usage, err := k.GetFeeTokenUsage(ctx, feeToken.Denom)
+			if err != nil {
+				return sdk.Coins{}, err
+			}
+			if err := usage.CheckCaps(feeToken, amount); err != nil {
+				continue
+			}
+			if err := k.FeeTokenUsages.Set(ctx, feeToken.Denom, usage.Add(amount)); err != nil {
+				return sdk.Coins{}, err
+			}
+
+			return sdk.Coins{sdk.NewCoin(feeToken.Denom, amount)}, nil
+		}
+	}
+
+	return sdk.Coins{}, errorsmod.Wrapf(
+		errortypes.ErrInsufficientFunds,
+		"sponsor balance %s can't pay the fee %s",
+		balance,
+		fee,
+	)
+}
+
+// getSponsorForAdmin returns the fee sponsor of a contract if the sender is its admin
+func (k Keeper) getSponsorForAdmin(ctx sdk.Context, sender, contract sdk.AccAddress) (types.Sponsor, error) {
+	// Get the sponsor
+	sponsor, err := k.GetSponsor(ctx, contract)
+	if err != nil {
+		return types.Sponsor{}, err
+	}
+
+	// Check the admin
+	if sponsor.Admin != sender.String() {
+		return types.Sponsor{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the admin of sponsor %s", sender, contract)
+	}
+
+	return sponsor, nil
+}
+
+// getSponsoredMsgs returns the information of the msgs checked against the sponsor policy
+// The called contract is filled for wasm executions and EVM calls
+func getSponsoredMsgs(msgs []sdk.Msg) []types.SponsoredMsg {
+	sponsoredMsgs := make([]types.SponsoredMsg, 0, len(msgs))
+	for _, msg := range msgs {
+		sponsoredMsg := types.SponsoredMsg{TypeURL: sdk.MsgTypeURL(msg)}
+		switch m := msg.(type) {
+		case *wasmtypes.MsgExecuteContract:
+			sponsoredMsg.Contract = m.Contract
+		case *evmtypes.MsgEthereumTx:
+			if to := m.AsTransaction().To(); to != nil {
+				sponsoredMsg.Contract = sdk.AccAddress(to.Bytes()).String()
+			}
+		}
+		sponsoredMsgs = append(sponsoredMsgs, sponsoredMsg)
+	}
+	return sponsoredMsgs
+}
@@ -0,0 +1,345 @@
+package keeper_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/app/apptesting"
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestRegisterSponsor tests the RegisterSponsor function
+func (s *KeeperTestSuite) TestRegisterSponsor() {
+	// The deployer and the contract deployed with the nonce 3
+	deployer := apptesting.RandomAccountAddress()
+	contract := sdk.AccAddress(crypto.CreateAddress(common.BytesToAddress(deployer), 3).Bytes())
+	deposit := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+
+	// Build the test cases
+	testCases := []struct {
+		name        string
+		malleate    func(sdk.Context)
+		sender      sdk.AccAddress
+		contract    sdk.AccAddress
+		nonces      []uint64
+		deposit     sdk.Coins
+		errContains string
+	}{
+		{
+			name:        "fail - address is not a contract",
+			sender:      deployer,
+			contract:    apptesting.RandomAccountAddress(),
+			nonces:      []uint64{3},
+			errContains: "is not a wasm or EVM contract",
+		},
+		{
+			name:        "fail - EVM contract without nonces",
+			sender:      deployer,
+			contract:    contract,
+			errContains: "deployer nonces are required",
+		},
+		{
+			name:        "fail - sender is not the deployer",
+			sender:      deployer,
+			contract:    contract,
+			nonces:      []uint64{2},
+			errContains: "is not the deployer of contract",
+		},
+		{
+			name: "fail - sponsor already registered",
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.Sponsors.Set(ctx, contract, types.NewSponsor(contract.String(), deployer.String(), types.SponsorPolicy{}))
+				s.Require().NoError(err)
+			},
+			sender:      deployer,
+			contract:    contract,
+			nonces:      []uint64{3},
+			errContains: "fee sponsor already registered",
+		},
+		{
+			name:     "fail - insufficient funds for the deposit",
+			sender:   deployer,
+			contract: contract,
+			nonces:   []uint64{3},
+			deposit:  deposit,
+			// The deployer has no funds
+			errContains: "insufficient funds",
+		},
+		{
+			name:     "success - contract registers itself",
+			sender:   contract,
+			contract: contract,
+		},
+		{
+			name: "success - deployer registers the contract with a deposit",
+			malleate: func(ctx sdk.Context) {
+				s.fundAccount(ctx, deployer, deposit)
+			},
+			sender:   deployer,
+			contract: contract,
+			nonces:   []uint64{3},
+			deposit:  deposit,
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// Set the contract account
+			s.setContractAccount(ctx, contract)
+
+			// Run the malleate function
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// Register the sponsor
+			err := s.keeper.RegisterSponsor(ctx, tc.sender, tc.contract, tc.nonces, tc.deposit, types.SponsorPolicy{})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Check the sponsor
+			sponsor, err := s.keeper.GetSponsor(ctx, tc.contract)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.sender.String(), sponsor.Admin)
+			s.Require().Equal(tc.deposit.String(), sponsor.Balance.String())
+
+			// Check the module balance
+			moduleAddr := s.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			s.Require().Equal(tc.deposit.String(), sdk.NewCoins(s.app.BankKeeper.GetAllBalances(ctx, moduleAddr)...).String())
+		})
+	}
+}
+
+// TestChargeSponsor tests the ChargeSponsor function
+func (s *KeeperTestSuite) TestChargeSponsor() {
+	// The sponsor and the user
+	contract := apptesting.RandomAccountAddress()
+	admin := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+	allowedMsg := &wasmtypes.MsgExecuteContract{Sender: user.String(), Contract: contract.String(), Msg: []byte("{}")}
+	sendMsg := banktypes.NewMsgSend(user, admin, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1))))
+	contractPolicy := types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+	fees := sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18)))
+
+	// The gas limit of the tx, the fees have a gas price of 5e12 akii
+	gasLimit := uint64(200_000)
+
+	// Build the test cases
+	testCases := []struct {
+		name        string
+		policy      types.SponsorPolicy
+		balance     sdk.Coins
+		malleate    func(sdk.Context)
+		msgs        []sdk.Msg
+		expected    sdk.Coins
+		errContains string
+	}{
+		{
+			name:        "fail - empty policy allows nothing",
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "the sponsor policy allows no msgs",
+		},
+		{
+			name:        "fail - msg type not allowed",
+			policy:      types.NewSponsorPolicy([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "msg type /cosmwasm.wasm.v1.MsgExecuteContract is not allowed",
+		},
+		{
+			name:        "fail - msg doesn't call the sponsor",
+			policy:      types.NewSponsorPolicy([]string{"/cosmos.bank.v1beta1.MsgSend"}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			balance:     fees,
+			msgs:        []sdk.Msg{sendMsg},
+			errContains: "doesn't call the sponsor or an allowed contract",
+		},
+		{
+			name:        "fail - one of the msgs doesn't call the sponsor",
+			policy:      contractPolicy,
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg, sendMsg},
+			errContains: "doesn't call the sponsor or an allowed contract",
+		},
+		{
+			name:    "fail - user tx quota reached",
+			policy:  types.NewSponsorPolicy(nil, []string{contract.String()}, 1, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			balance: fees,
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.SponsorUsages.Set(ctx, collections.Join(contract, user), types.NewSponsorUsage().Add(math.OneInt()))
+				s.Require().NoError(err)
+			},
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "max of 1 sponsored txs reached",
+		},
+		{
+			name:        "fail - user fee quota reached",
+			policy:      types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.NewInt(1000), math.ZeroInt(), math.LegacyZeroDec()),
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "max of 1000 sponsored fees reached",
+		},
+		{
+			name:        "fail - fee over the max fee per tx",
+			policy:      types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), fees[0].Amount.SubRaw(1), math.LegacyZeroDec()),
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "is over the max fee per tx",
+		},
+		{
+			name:        "fail - gas price over the max gas price",
+			policy:      types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyNewDec(1_000_000_000_000)),
+			balance:     fees,
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "is over the max gas price",
+		},
+		{
+			name:     "success - fee and gas price on the policy limits",
+			policy:   types.NewSponsorPolicy(nil, []string{contract.String()}, 0, math.ZeroInt(), fees[0].Amount, math.LegacyNewDec(5_000_000_000_000)),
+			balance:  fees,
+			msgs:     []sdk.Msg{allowedMsg},
+			expected: fees,
+		},
+		{
+			name:        "fail - sponsor balance can't pay the fee",
+			policy:      contractPolicy,
+			balance:     sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+			msgs:        []sdk.Msg{allowedMsg},
+			errContains: "can't pay the fee",
+		},
+		{
+			name:     "success - sponsor pays with the native denom",
+			policy:   types.NewSponsorPolicy([]string{"/cosmwasm.wasm.v1.MsgExecuteContract"}, nil, 1, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			balance:  fees,
+			msgs:     []sdk.Msg{allowedMsg},
+			expected: fees,
+		},
+		{
+			name:    "success - sponsor pays with a fee token",
+			policy:  contractPolicy,
+			balance: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))),
+			malleate: func(ctx sdk.Context) {
+				// 1 atom per kii
+				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+			},
+			msgs:     []sdk.Msg{allowedMsg},
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))),
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			// Register the sponsor and fund the module account
+			sponsor := types.NewSponsor(contract.String(), admin.String(), tc.policy)
+			sponsor.Balance = tc.balance
+			s.Require().NoError(s.keeper.Sponsors.Set(ctx, contract, sponsor))
+			s.fundModule(ctx, tc.balance)
+
+			// Run the malleate function
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			// Charge the sponsor
+			charged, err := s.keeper.ChargeSponsor(ctx, contract, user, tc.msgs, fees, gasLimit)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected.String(), charged.String())
+
+			// Check the sponsor state
+			sponsor, err = s.keeper.GetSponsor(ctx, contract)
+			s.Require().NoError(err)
+			s.Require().True(sponsor.Balance.IsZero())
+			s.Require().Equal(tc.expected.String(), sponsor.Spent.String())
+			s.Require().Equal(uint64(1), sponsor.TxCount)
+
+			// Check the user usage
+			usage, err := s.keeper.GetSponsorUsage(ctx, contract, user)
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), usage.TxCount)
+			s.Require().Equal(fees[0].Amount, usage.Fees)
+		})
+	}
+}
+
+// TestSponsorFunds tests the FundSponsor and WithdrawSponsorFunds functions
+func (s *KeeperTestSuite) TestSponsorFunds() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Register the sponsor
+	contract := apptesting.RandomAccountAddress()
+	admin := apptesting.RandomAccountAddress()
+	funder := apptesting.RandomAccountAddress()
+	err := s.keeper.Sponsors.Set(ctx, contract, types.NewSponsor(contract.String(), admin.String(), types.SponsorPolicy{}))
+	s.Require().NoError(err)
+
+	// Fund the sponsor from any account
+	amount := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+	s.fundAccount(ctx, funder, amount)
+	s.Require().NoError(s.keeper.FundSponsor(ctx, funder, contract, amount))
+
+	// Only the admin can withdraw
+	err = s.keeper.WithdrawSponsorFunds(ctx, funder, contract, amount)
+	s.Require().ErrorContains(err, "is not the admin of sponsor")
+
+	// The balance can't be exceeded
+	err = s.keeper.WithdrawSponsorFunds(ctx, admin, contract, amount.Add(amount...))
+	s.Require().ErrorContains(err, "is smaller than")
+
+	// Withdraw the funds to the admin
+	s.Require().NoError(s.keeper.WithdrawSponsorFunds(ctx, admin, contract, amount))
+	s.Require().Equal(amount.String(), s.app.BankKeeper.GetAllBalances(ctx, admin).String())
+
+	// Check the sponsor through the querier
+	res, err := s.querier.Sponsor(ctx, &types.QuerySponsorRequest{Contract: contract.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.Sponsor.Balance.IsZero())
+
+	// Unknown sponsors can't be funded
+	err = s.keeper.FundSponsor(ctx, funder, apptesting.RandomAccountAddress(), amount)
+	s.Require().ErrorIs(err, types.ErrUnknownSponsor)
+}
+
+// setContractAccount sets an EVM contract account on the address
+func (s *KeeperTestSuite) setContractAccount(ctx sdk.Context, contract sdk.AccAddress) {
+	err := s.app.EVMKeeper.SetAccount(ctx, common.BytesToAddress(contract), statedb.Account{
+		Nonce:    0,
+		Balance:  uint256.NewInt(0),
+		CodeHash: crypto.Keccak256([]byte("contract code")),
+	})
+	s.Require().NoError(err)
+}
+
+// fundModule is a helper function to fund the module account with a specific amount of coins
+func (s *KeeperTestSuite) fundModule(ctx sdk.Context, amount sdk.Coins) {
+	err := s.app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, amount)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(ctx, evmtypes.ModuleName, types.ModuleName, amount)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// GetFeeTokenStats returns the statistics of a fee token on a day
+// Empty statistics are returned if the token wasn't used on the day
+func (k Keeper) GetFeeTokenStats(ctx sdk.Context, day int64, denom string) (types.FeeTokenStats, error) {
+	stats, err := k.FeeTokenStats.Get(ctx, collections.Join(day, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewFeeTokenStats(denom, day), nil
+	}
+	return stats, err
+}
+
+// RecordFeeTokenStats adds a fee paid with a fee token to the statistics of the current day
+// Nothing is recorded if the statistics are disabled
+func (k Keeper) RecordFeeTokenStats(ctx sdk.Context, denom string, amount, nativeValue math.Int, source types.FeePaymentSource) error {
+	// Check if the statistics are enabled
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.StatsRetentionDays == 0 {
+		return nil
+	}
+
+	// Add the payment to the statistics of the day
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+	stats, err := k.GetFeeTokenStats(ctx, day, denom)
+	if err != nil {
+		return err
+	}
+	return k.FeeTokenStats.Set(ctx, collections.Join(day, denom), stats.Add(amount, nativeValue, source))
+}
+
+// SubFeeTokenStats removes a refunded part of a fee paid with a fee token from the statistics of the current day
+// Nothing is changed if the statistics are disabled
+func (k Keeper) SubFeeTokenStats(ctx sdk.Context, denom string, amount, nativeValue math.Int) error {
+	// Check if the statistics are enabled
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.StatsRetentionDays == 0 {
+		return nil
+	}
+
+	// Remove the refund from the statistics of the day
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+	stats, err := k.GetFeeTokenStats(ctx, day, denom)
+	if err != nil {
+		return err
+	}
+	return k.FeeTokenStats.Set(ctx, collections.Join(day, denom), stats.SubRefund(amount, nativeValue))
+}
+
+// PruneFeeTokenStats removes the statistics older than the retention
+// The statistics are keyed by day first, so only the expired entries are iterated
+func (k Keeper) PruneFeeTokenStats(ctx sdk.Context) error {
+	// Get the retention, disabled statistics are all pruned
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+	cutoff := types.GetStatsCutoffDay(day, params.StatsRetentionDays)
+
+	// Collect the expired keys
+	var expired []collections.Pair[int64, string]
+	ranger := new(collections.Range[collections.Pair[int64, string]]).EndExclusive(collections.PairPrefix[int64, string](cutoff))
+	err = k.FeeTokenStats.Walk(ctx, ranger, func(key collections.Pair[int64, string], _ types.FeeTokenStats) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Remove the expired statistics
+	for _, key := range expired {
+		if err := k.FeeTokenStats.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestRecordFeeTokenStats tests the RecordFeeTokenStats function
+func (s *KeeperTestSuite) TestRecordFeeTokenStats() {
+	ctx, _ := s.ctx.CacheContext()
+	day := types.DayFromUnix(ctx.BlockTime().Unix())
+
+	// Record payments on the current day
+	err := s.keeper.RecordFeeTokenStats(ctx, "uatom", math.NewInt(100), math.NewInt(1000), types.FeePaymentSourceBalance)
+	s.Require().NoError(err)
+	err = s.keeper.RecordFeeTokenStats(ctx, "uatom", math.NewInt(200), math.NewInt(2000), types.FeePaymentSourceERC20Unwrap)
+	s.Require().NoError(err)
+
+	// Check the statistics
+	stats, err := s.keeper.GetFeeTokenStats(ctx, day, "uatom")
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), stats.TxCount)
+	s.Require().Equal(math.NewInt(300), stats.ConvertedAmount)
+	s.Require().Equal(math.NewInt(3000), stats.NativeValue)
+	s.Require().Equal(uint64(1), stats.BalancePayments)
+	s.Require().Equal(uint64(1), stats.ERC20Unwraps)
+
+	// Nothing is recorded with the statistics disabled
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.StatsRetentionDays = 0
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+	err = s.keeper.RecordFeeTokenStats(ctx, "uosmo", math.NewInt(100), math.NewInt(1000), types.FeePaymentSourceBalance)
+	s.Require().NoError(err)
+	has, err := s.keeper.FeeTokenStats.Has(ctx, collections.Join(day, "uosmo"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+// TestPruneFeeTokenStats tests the PruneFeeTokenStats function
+func (s *KeeperTestSuite) TestPruneFeeTokenStats() {
+	ctx, _ := s.ctx.CacheContext()
+
+	// Keep the statistics for 2 days
+	params, err := s.keeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.StatsRetentionDays = 2
+	s.Require().NoError(s.keeper.Params.Set(ctx, params))
+
+	// Record payments on three consecutive days
+	start := ctx.BlockTime()
+	for i := range 3 {
+		dayCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * 24 * time.Hour))
+		for _, denom := range []string{"uatom", "uosmo"} {
+			err := s.keeper.RecordFeeTokenStats(dayCtx, denom, math.OneInt(), math.OneInt(), types.FeePaymentSourceBalance)
+			s.Require().NoError(err)
+		}
+	}
+
+	// Prune on the last day, only the first day is removed
+	ctx = ctx.WithBlockTime(start.Add(2 * 24 * time.Hour))
+	s.Require().NoError(s.keeper.PruneFeeTokenStats(ctx))
+	firstDay := types.DayFromUnix(start.Unix())
+	has, err := s.keeper.FeeTokenStats.Has(ctx, collections.Join(firstDay, "uatom"))
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// Check the remaining statistics through the querier
+	res, err := s.querier.FeeTokenStats(ctx, &types.QueryFeeTokenStatsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Stats, 4)
+	s.Require().Equal(firstDay+1, res.Stats[0].Day)
+
+	// Filter the statistics by denom
+	res, err = s.querier.FeeTokenStats(ctx, &types.QueryFeeTokenStatsRequest{Denom: "uosmo"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Stats, 2)
+	for _, stats := range res.Stats {
+		s.Require().Equal("uosmo", stats.Denom)
+	}
+}
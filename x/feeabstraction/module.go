@@ -28,6 +28,7 @@ var (
 	_ module.AppModuleBasic     = AppModuleBasic{}
 	_ module.HasGenesisBasics   = AppModuleBasic{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 	_ module.AppModule          = AppModule{}
 	_ module.HasABCIGenesis     = AppModule{}
 )
@@ -168,3 +169,8 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	return am.keeper.BeginBlocker(ctx)
 }
+
+// EndBlock returns the end blocker for the module
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(ctx)
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*FeeTokenAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*FeeTokenAllowance)(nil)
+)
+
+// NewFeeTokenAllowance creates a new FeeTokenAllowance instance
+func NewFeeTokenAllowance(allowance feegrant.FeeAllowanceI, feeToken string) (*FeeTokenAllowance, error) {
+	a := &FeeTokenAllowance{FeeToken: feeToken}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *FeeTokenAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped allowance
+func (a *FeeTokenAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped allowance
+func (a *FeeTokenAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	anyAllowance, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	a.Allowance = anyAllowance
+	return nil
+}
+
+// Accept checks that the fees are on the fee token and spends them from the wrapped allowance
+func (a *FeeTokenAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	// The fees must be converted to the fee token before using the allowance
+	for _, coin := range fee {
+		if coin.Denom != a.FeeToken {
+			return false, errorsmod.Wrapf(ErrInvalidFeeTokenAllowance, "fee %s is not on the allowance fee token %s", fee, a.FeeToken)
+		}
+	}
+
+	// Spend the fees from the wrapped allowance
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic validates the fee token and the wrapped allowance
+func (a *FeeTokenAllowance) ValidateBasic() error {
+	// Validate the fee token
+	if err := sdk.ValidateDenom(a.FeeToken); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenAllowance, "invalid fee token %s: %s", a.FeeToken, err)
+	}
+
+	// Validate the wrapped allowance
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	if GetAllowanceFeeToken(allowance) != "" {
+		return errorsmod.Wrap(ErrInvalidFeeTokenAllowance, "fee token allowances can't be nested")
+	}
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the wrapped allowance
+func (a *FeeTokenAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// GetAllowanceFeeToken returns the fee token named by an allowance
+// Fee token allowances wrapped by a msg filter are also found, an empty denom is returned
+// if the allowance doesn't name a fee token
+func GetAllowanceFeeToken(allowance feegrant.FeeAllowanceI) string {
+	switch a := allowance.(type) {
+	case *FeeTokenAllowance:
+		return a.FeeToken
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := a.GetAllowance()
+		if err != nil {
+			return ""
+		}
+		return GetAllowanceFeeToken(inner)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabstraction/v1beta1/allowance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeTokenAllowance is a fee grant allowance spent on an alternative fee
+// token, the grantee fees are converted to the fee token at its price and paid
+// by the granter
+type FeeTokenAllowance struct {
+	// allowance is the wrapped allowance, its limits are on the fee token denom
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// fee_token is the denom of the fee token the allowance is spent on
+	FeeToken string `protobuf:"bytes,2,opt,name=fee_token,json=feeToken,proto3" json:"fee_token,omitempty"`
+}
+
+func (m *FeeTokenAllowance) Reset()         { *m = FeeTokenAllowance{} }
+func (m *FeeTokenAllowance) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenAllowance) ProtoMessage()    {}
+func (*FeeTokenAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24b1bb8b293a0b47, []int{0}
+}
+func (m *FeeTokenAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenAllowance.Merge(m, src)
+}
+func (m *FeeTokenAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FeeTokenAllowance)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenAllowance")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/allowance.proto", fileDescriptor_24b1bb8b293a0b47)
+}
+
+var fileDescriptor_24b1bb8b293a0b47 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xce, 0xcc, 0x4c,
+	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x4b, 0x4d, 0x4d, 0x4c, 0x2a, 0x2e, 0x29, 0x4a, 0x4c, 0x2e,
+	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcc, 0xc9, 0xc9,
+	0x2f, 0x4f, 0xcc, 0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x69, 0xd0,
+	0x43, 0xd5, 0xa0, 0x07, 0xd5, 0x20, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xab, 0x0f, 0x62,
+	0x41, 0xb4, 0x49, 0x49, 0xa6, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x83, 0x79, 0x49, 0xa5, 0x69,
+	0xfa, 0x89, 0x79, 0x95, 0x50, 0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x53,
+	0x9d, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f, 0x31, 0x06, 0xc2, 0x81, 0x48, 0x29, 0x3d, 0x66,
+	0xe4, 0x12, 0x74, 0x4b, 0x4d, 0x0d, 0xc9, 0xcf, 0x4e, 0xcd, 0x73, 0x84, 0xb9, 0x4d, 0x28, 0x96,
+	0x8b, 0x13, 0xee, 0x50, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d, 0x88, 0x95, 0x7a,
+	0x30, 0x2b, 0xf5, 0x1c, 0xf3, 0x2a, 0x9d, 0x34, 0x4f, 0x6d, 0xd1, 0x55, 0x85, 0x1a, 0x98, 0x96,
+	0x9a, 0x9a, 0x5e, 0x94, 0x98, 0x57, 0x02, 0x73, 0xba, 0x9e, 0x5b, 0x6a, 0x2a, 0xdc, 0x48, 0xcf,
+	0x20, 0x84, 0x89, 0x42, 0xd2, 0x5c, 0x9c, 0x69, 0xa9, 0xa9, 0xf1, 0x25, 0x20, 0x4b, 0x25, 0x98,
+	0x14, 0x18, 0x35, 0x38, 0x83, 0x38, 0xd2, 0xa0, 0x8e, 0xb0, 0x0a, 0xea, 0x58, 0x20, 0xcf, 0x40,
+	0xb4, 0xb1, 0x5d, 0xcf, 0x37, 0x68, 0x29, 0xa0, 0x85, 0x35, 0x86, 0x7f, 0x9c, 0x7c, 0x4f, 0x3c,
+	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
+	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
+	0x2f, 0x39, 0x3f, 0x17, 0x11, 0x77, 0x70, 0x46, 0x05, 0x7a, 0x34, 0x96, 0x54, 0x16, 0xa4, 0x16,
+	0x27, 0xb1, 0x81, 0xc3, 0xc0, 0x18, 0x30, 0x00, 0xdd, 0x64, 0x4c, 0x10, 0xee, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeTokenAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeToken) > 0 {
+		i -= len(m.FeeToken)
+		copy(dAtA[i:], m.FeeToken)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.FeeToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAllowance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeTokenAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	l = len(m.FeeToken)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	return n
+}
+
+func sovAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowance(x uint64) (n int) {
+	return sovAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeTokenAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestFeeTokenAllowanceAccept tests the Accept method of FeeTokenAllowance
+func TestFeeTokenAllowanceAccept(t *testing.T) {
+	// Prepare the context and the msgs
+	ctx := sdk.Context{}.WithBlockTime(time.Now())
+	msgs := []sdk.Msg{banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)}
+
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		remove      bool
+		left        sdk.Coins
+		errContains string
+	}{
+		{
+			name: "valid - fee on the fee token",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 40)),
+			left: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 60)),
+		},
+		{
+			name:   "valid - fee spends the whole allowance",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+			remove: true,
+		},
+		{
+			name:        "invalid - fee on another denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("akii", 40)),
+			errContains: "is not on the allowance fee token uusdc",
+		},
+		{
+			name:        "invalid - fee over the limit",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uusdc", 101)),
+			errContains: "fee limit exceeded",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create the allowance
+			allowance, err := types.NewFeeTokenAllowance(&feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+			}, "uusdc")
+			require.NoError(t, err)
+
+			// Spend the fee
+			remove, err := allowance.Accept(ctx, tc.fee, msgs)
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.remove, remove)
+
+			// Check the remaining limit
+			if !remove {
+				inner, err := allowance.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.left, inner.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+// TestFeeTokenAllowanceValidateBasic tests the ValidateBasic method of FeeTokenAllowance
+func TestFeeTokenAllowanceValidateBasic(t *testing.T) {
+	// A valid inner allowance
+	basic := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))}
+
+	// A valid allowance
+	allowance, err := types.NewFeeTokenAllowance(basic, "uusdc")
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// An invalid fee token
+	allowance, err = types.NewFeeTokenAllowance(basic, "1")
+	require.NoError(t, err)
+	require.ErrorContains(t, allowance.ValidateBasic(), "invalid fee token")
+
+	// An empty allowance
+	require.ErrorContains(t, (&types.FeeTokenAllowance{FeeToken: "uusdc"}).ValidateBasic(), "allowance should not be empty")
+
+	// Nested fee token allowances
+	inner, err := types.NewFeeTokenAllowance(basic, "uusdc")
+	require.NoError(t, err)
+	allowance, err = types.NewFeeTokenAllowance(inner, "uusdc")
+	require.NoError(t, err)
+	require.ErrorContains(t, allowance.ValidateBasic(), "can't be nested")
+}
+
+// TestGetAllowanceFeeToken tests the GetAllowanceFeeToken function
+func TestGetAllowanceFeeToken(t *testing.T) {
+	// A basic allowance names no fee token
+	basic := &feegrant.BasicAllowance{}
+	require.Equal(t, "", types.GetAllowanceFeeToken(basic))
+
+	// A fee token allowance names its fee token
+	allowance, err := types.NewFeeTokenAllowance(basic, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", types.GetAllowanceFeeToken(allowance))
+
+	// A fee token allowance wrapped by a msg filter is found
+	filtered, err := feegrant.NewAllowedMsgAllowance(allowance, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	require.NoError(t, err)
+	require.Equal(t, "uusdc", types.GetAllowanceFeeToken(filtered))
+}
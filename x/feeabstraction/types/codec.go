@@ -1,15 +1,34 @@
 package types
 
 import (
+	"cosmossdk.io/x/feegrant"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 const (
 	MsgUpdateParamsName    = "feeabstraction/update-params"
 	MsgUpdateFeeTokensName = "feeabstraction/update-fee-tokens"
+
+	MsgSetPreferredFeeTokenName = "feeabstraction/set-preferred-fee-token"
+
+	MsgAddFeeTokenName        = "feeabstraction/add-fee-token"
+	MsgRegisterFeeTokenName   = "feeabstraction/register-fee-token"
+	MsgRemoveFeeTokenName     = "feeabstraction/remove-fee-token"
+	MsgSetFeeTokenEnabledName = "feeabstraction/set-fee-token-enabled"
+
+	MsgRegisterSponsorName      = "feeabstraction/register-sponsor"
+	MsgUpdateSponsorPolicyName  = "feeabstraction/update-sponsor-policy"
+	MsgFundSponsorName          = "feeabstraction/fund-sponsor"
+	MsgWithdrawSponsorFundsName = "feeabstraction/withdraw-sponsor-funds"
+	MsgSweepERC20FeesName       = "feeabstraction/sweep-erc20-fees"
+	MsgWithdrawRevenueName      = "feeabstraction/withdraw-revenue"
+
+	FeeTokenAllowanceName = "feeabstraction/FeeTokenAllowance"
 )
 
 // RegisterInterfaces register all the proto interfaces into the app
@@ -19,6 +38,31 @@ func RegisterInterfaces(r codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgUpdateFeeTokens{},
+		&MsgSetPreferredFeeToken{},
+		&MsgAddFeeToken{},
+		&MsgRegisterFeeToken{},
+		&MsgRemoveFeeToken{},
+		&MsgSetFeeTokenEnabled{},
+		&MsgRegisterSponsor{},
+		&MsgUpdateSponsorPolicy{},
+		&MsgFundSponsor{},
+		&MsgWithdrawSponsorFunds{},
+		&MsgSweepERC20Fees{},
+		&MsgWithdrawRevenue{},
+	)
+
+	// Register the tx extension options
+	r.RegisterImplementations(
+		(*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionPreferredFeeToken{},
+		&ExtensionOptionFeeSponsor{},
+		&ExtensionOptionERC20Permit{},
+	)
+
+	// Register the fee grant allowances
+	r.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&FeeTokenAllowance{},
 	)
 
 	// Register on the message service
@@ -30,4 +74,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Register all the concrete types
 	cdc.RegisterConcrete(&MsgUpdateParams{}, MsgUpdateParamsName, nil)
 	cdc.RegisterConcrete(&MsgUpdateFeeTokens{}, MsgUpdateFeeTokensName, nil)
+	cdc.RegisterConcrete(&MsgSetPreferredFeeToken{}, MsgSetPreferredFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgAddFeeToken{}, MsgAddFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgRegisterFeeToken{}, MsgRegisterFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeToken{}, MsgRemoveFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokenEnabled{}, MsgSetFeeTokenEnabledName, nil)
+	cdc.RegisterConcrete(&MsgRegisterSponsor{}, MsgRegisterSponsorName, nil)
+	cdc.RegisterConcrete(&MsgUpdateSponsorPolicy{}, MsgUpdateSponsorPolicyName, nil)
+	cdc.RegisterConcrete(&MsgFundSponsor{}, MsgFundSponsorName, nil)
+	cdc.RegisterConcrete(&MsgWithdrawSponsorFunds{}, MsgWithdrawSponsorFundsName, nil)
+	cdc.RegisterConcrete(&MsgSweepERC20Fees{}, MsgSweepERC20FeesName, nil)
+	cdc.RegisterConcrete(&MsgWithdrawRevenue{}, MsgWithdrawRevenueName, nil)
+	cdc.RegisterConcrete(&FeeTokenAllowance{}, FeeTokenAllowanceName, nil)
 }
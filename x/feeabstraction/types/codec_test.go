@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/x/feegrant"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +18,7 @@ func TestRegisterInterfaces(t *testing.T) {
 	// Initialize an empty registry
 	registry := codectypes.NewInterfaceRegistry()
 	registry.RegisterInterface(sdk.MsgInterfaceProtoName, (*sdk.Msg)(nil))
+	registry.RegisterInterface("cosmos.feegrant.v1beta1.FeeAllowanceI", (*feegrant.FeeAllowanceI)(nil))
 
 	// Run the register interfaces
 	types.RegisterInterfaces(registry)
@@ -27,5 +30,19 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.ElementsMatch(t, interfaces, []string{
 		"/kiichain.feeabstraction.v1beta1.MsgUpdateParams",
 		"/kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens",
+		"/kiichain.feeabstraction.v1beta1.MsgSetPreferredFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgAddFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgRegisterFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgRemoveFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabled",
+		"/kiichain.feeabstraction.v1beta1.MsgRegisterSponsor",
+		"/kiichain.feeabstraction.v1beta1.MsgUpdateSponsorPolicy",
+		"/kiichain.feeabstraction.v1beta1.MsgFundSponsor",
+		"/kiichain.feeabstraction.v1beta1.MsgWithdrawSponsorFunds",
+		"/kiichain.feeabstraction.v1beta1.MsgSweepERC20Fees",
+		"/kiichain.feeabstraction.v1beta1.MsgWithdrawRevenue",
 	})
+
+	// Check the fee grant allowances
+	require.Contains(t, registry.ListImplementations("cosmos.feegrant.v1beta1.FeeAllowanceI"), "/kiichain.feeabstraction.v1beta1.FeeTokenAllowance")
 }
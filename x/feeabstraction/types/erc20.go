@@ -0,0 +1,106 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// ERC20DenomPrefix is the prefix of the denom of the fee tokens collected from an ERC20 contract
+const ERC20DenomPrefix = "erc20:"
+
+// erc20PermitABI is the ABI of the ERC20 functions used to collect the fees, including the EIP-2612 permit
+//
+//go:embed erc20_permit.json
+var erc20PermitABI []byte
+
+// ERC20PermitABI is the parsed ABI used to call the ERC20 fee tokens
+var ERC20PermitABI abi.ABI
+
+func init() {
+	var err error
+	ERC20PermitABI, err = abi.JSON(bytes.NewReader(erc20PermitABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ContextERC20PermitKey is the context key holding the ERC20 permit sent with the tx
+type ContextERC20PermitKey struct{}
+
+// GetERC20FeeTokenDenom returns the denom of the fee token collected from the ERC20 contract
+func GetERC20FeeTokenDenom(contract common.Address) string {
+	return ERC20DenomPrefix + contract.Hex()
+}
+
+// IsERC20 returns true if the fee token is collected from an ERC20 contract without a token pair
+func (f FeeTokenMetadata) IsERC20() bool {
+	return f.Erc20Address != ""
+}
+
+// GetERC20Contract returns the ERC20 contract of the fee token
+func (f FeeTokenMetadata) GetERC20Contract() common.Address {
+	return common.HexToAddress(f.Erc20Address)
+}
+
+// validateERC20 validates the ERC20 contract of the fee token
+// The denom must be derived from the contract, so it can't clash with the bank denoms
+func (f FeeTokenMetadata) validateERC20() error {
+	if !f.IsERC20() {
+		return nil
+	}
+
+	// Validate the contract and the denom
+	if !common.IsHexAddress(f.Erc20Address) {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "invalid ERC20 address: %s", f.Erc20Address)
+	}
+	if f.Denom != GetERC20FeeTokenDenom(f.GetERC20Contract()) {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "denom of the ERC20 fee token must be %s", GetERC20FeeTokenDenom(f.GetERC20Contract()))
+	}
+
+	return nil
+}
+
+// NewERC20Permit creates a new ExtensionOptionERC20Permit instance
+func NewERC20Permit(contract common.Address, value math.Int, deadline uint64, v uint8, r, s [32]byte) *ExtensionOptionERC20Permit {
+	return &ExtensionOptionERC20Permit{
+		Contract: contract.Hex(),
+		Value:    value,
+		Deadline: deadline,
+		V:        uint32(v),
+		R:        r[:],
+		S:        s[:],
+	}
+}
+
+// Validate validates the ERC20 permit
+func (p ExtensionOptionERC20Permit) Validate() error {
+	if !common.IsHexAddress(p.Contract) {
+		return errorsmod.Wrapf(ErrInvalidERC20Permit, "invalid contract address: %s", p.Contract)
+	}
+	if p.Value.IsNil() || !p.Value.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidERC20Permit, "value must be positive")
+	}
+	if p.V > 255 {
+		return errorsmod.Wrapf(ErrInvalidERC20Permit, "invalid signature recovery id: %d", p.V)
+	}
+	if len(p.R) != 32 || len(p.S) != 32 {
+		return errorsmod.Wrap(ErrInvalidERC20Permit, "signature r and s must have 32 bytes")
+	}
+	return nil
+}
+
+// GetERC20PermitFromContext returns the ERC20 permit set on the context for the contract
+func GetERC20PermitFromContext(ctx context.Context, contract common.Address) (*ExtensionOptionERC20Permit, bool) {
+	permit, ok := ctx.Value(ContextERC20PermitKey{}).(*ExtensionOptionERC20Permit)
+	if !ok || permit == nil || common.HexToAddress(permit.Contract) != contract {
+		return nil, false
+	}
+	return permit, true
+}
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestERC20PermitValidate tests the Validate method of ExtensionOptionERC20Permit
+func TestERC20PermitValidate(t *testing.T) {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		permit      *types.ExtensionOptionERC20Permit
+		errContains string
+	}{
+		{
+			name:   "valid - permit",
+			permit: types.NewERC20Permit(contract, math.NewInt(100), 1000, 27, [32]byte{1}, [32]byte{2}),
+		},
+		{
+			name: "invalid - contract address",
+			permit: func() *types.ExtensionOptionERC20Permit {
+				permit := types.NewERC20Permit(contract, math.NewInt(100), 1000, 27, [32]byte{1}, [32]byte{2})
+				permit.Contract = "invalid"
+				return permit
+			}(),
+			errContains: "invalid contract address: invalid",
+		},
+		{
+			name:        "invalid - zero value",
+			permit:      types.NewERC20Permit(contract, math.ZeroInt(), 1000, 27, [32]byte{1}, [32]byte{2}),
+			errContains: "value must be positive",
+		},
+		{
+			name: "invalid - short signature",
+			permit: func() *types.ExtensionOptionERC20Permit {
+				permit := types.NewERC20Permit(contract, math.NewInt(100), 1000, 27, [32]byte{1}, [32]byte{2})
+				permit.S = []byte{2}
+				return permit
+			}(),
+			errContains: "signature r and s must have 32 bytes",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.permit.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestGetERC20PermitFromContext tests reading the ERC20 permit set on the context
+func TestGetERC20PermitFromContext(t *testing.T) {
+	contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+	permit := types.NewERC20Permit(contract, math.NewInt(100), 1000, 27, [32]byte{1}, [32]byte{2})
+
+	// No permit is set on the context
+	_, found := types.GetERC20PermitFromContext(context.Background(), contract)
+	require.False(t, found)
+
+	// The permit is returned for its contract
+	ctx := context.WithValue(context.Background(), types.ContextERC20PermitKey{}, permit)
+	got, found := types.GetERC20PermitFromContext(ctx, contract)
+	require.True(t, found)
+	require.Equal(t, permit, got)
+
+	// The permit is ignored for other contracts
+	_, found = types.GetERC20PermitFromContext(ctx, common.HexToAddress("0x0000000000000000000000000000000000000001"))
+	require.False(t, found)
+
+	// A nil permit is ignored
+	ctx = context.WithValue(context.Background(), types.ContextERC20PermitKey{}, (*types.ExtensionOptionERC20Permit)(nil))
+	_, found = types.GetERC20PermitFromContext(ctx, contract)
+	require.False(t, found)
+}
@@ -6,6 +6,21 @@ import (
 
 // x/feeabstraction module errors
 var (
-	ErrInvalidFeeTokenMetadata = errorsmod.Register(ModuleName, 1, "invalid fee token metadata")
-	ErrInvalidParams           = errorsmod.Register(ModuleName, 2, "invalid fee abstraction params")
+	ErrInvalidFeeTokenMetadata  = errorsmod.Register(ModuleName, 1, "invalid fee token metadata")
+	ErrInvalidParams            = errorsmod.Register(ModuleName, 2, "invalid fee abstraction params")
+	ErrUnknownFeeToken          = errorsmod.Register(ModuleName, 3, "unknown fee token")
+	ErrFeeTokenDisabled         = errorsmod.Register(ModuleName, 4, "fee token is disabled")
+	ErrFeeTokenVolumeCap        = errorsmod.Register(ModuleName, 5, "fee token volume cap reached")
+	ErrInvalidSponsor           = errorsmod.Register(ModuleName, 6, "invalid fee sponsor")
+	ErrUnknownSponsor           = errorsmod.Register(ModuleName, 7, "unknown fee sponsor")
+	ErrSponsorExists            = errorsmod.Register(ModuleName, 8, "fee sponsor already registered")
+	ErrSponsorNotAllowed        = errorsmod.Register(ModuleName, 9, "tx not allowed by the fee sponsor policy")
+	ErrSponsorQuotaReached      = errorsmod.Register(ModuleName, 10, "fee sponsor user quota reached")
+	ErrInvalidFeeRevenue        = errorsmod.Register(ModuleName, 11, "invalid fee revenue")
+	ErrInvalidERC20Permit       = errorsmod.Register(ModuleName, 12, "invalid ERC20 permit")
+	ErrERC20FeeCollection       = errorsmod.Register(ModuleName, 13, "failed to collect the ERC20 fee")
+	ErrInvalidFeeTokenStats     = errorsmod.Register(ModuleName, 14, "invalid fee token statistics")
+	ErrInvalidFeeTokenAllowance = errorsmod.Register(ModuleName, 15, "invalid fee token allowance")
+	ErrFeeTokenRegistration     = errorsmod.Register(ModuleName, 16, "failed to register the fee token")
+	ErrFeeTokenPendingRevenue   = errorsmod.Register(ModuleName, 17, "fee token revenue pending settlement")
 )
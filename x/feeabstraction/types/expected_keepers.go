@@ -7,9 +7,20 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	oracletypes "github.com/kiichain/kiichain/v5/x/oracle/types"
 )
@@ -32,7 +43,17 @@ type Erc20Keeper interface {
 // BankKeeper defines the expected interface for the Bank keeper
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	HasSupply(ctx context.Context, denom string) bool
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// DistributionKeeper defines the expected interface for the Distribution keeper
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // OracleKeeper define the expected interface for the Oracle keeper
@@ -41,3 +62,33 @@ type OracleKeeper interface {
 	ValidateLookBackSeconds(ctx sdk.Context, lookBackSeconds uint64) error
 	GetVoteTargets(ctx sdk.Context) ([]string, error)
 }
+
+// FeeMarketKeeper defines the expected interface for the FeeMarket keeper
+type FeeMarketKeeper interface {
+	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+}
+
+// EVMKeeper defines the expected interface for the EVM keeper
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	CallEVM(
+		ctx sdk.Context,
+		abi abi.ABI,
+		from, contract common.Address,
+		commit bool,
+		gasCap *big.Int,
+		method string,
+		args ...interface{},
+	) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// TransferKeeper defines the expected interface for the IBC transfer keeper
+type TransferKeeper interface {
+	GetDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) (transfertypes.Denom, bool)
+}
+
+// WasmKeeper defines the expected interface for the Wasm keeper
+type WasmKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
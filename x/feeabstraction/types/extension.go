@@ -0,0 +1,115 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+const (
+	// ExtensionOptionPreferredFeeTokenTypeURL is the type URL of the preferred fee token extension option
+	ExtensionOptionPreferredFeeTokenTypeURL = "/kiichain.feeabstraction.v1beta1.ExtensionOptionPreferredFeeToken"
+	// ExtensionOptionFeeSponsorTypeURL is the type URL of the fee sponsor extension option
+	ExtensionOptionFeeSponsorTypeURL = "/kiichain.feeabstraction.v1beta1.ExtensionOptionFeeSponsor"
+	// ExtensionOptionERC20PermitTypeURL is the type URL of the ERC20 permit extension option
+	ExtensionOptionERC20PermitTypeURL = "/kiichain.feeabstraction.v1beta1.ExtensionOptionERC20Permit"
+)
+
+// HasPreferredFeeTokenExtensionOption returns true if the extension option is the preferred fee token option
+func HasPreferredFeeTokenExtensionOption(any *codectypes.Any) bool {
+	return any.GetTypeUrl() == ExtensionOptionPreferredFeeTokenTypeURL
+}
+
+// HasFeeSponsorExtensionOption returns true if the extension option is the fee sponsor option
+func HasFeeSponsorExtensionOption(any *codectypes.Any) bool {
+	return any.GetTypeUrl() == ExtensionOptionFeeSponsorTypeURL
+}
+
+// HasERC20PermitExtensionOption returns true if the extension option is the ERC20 permit option
+func HasERC20PermitExtensionOption(any *codectypes.Any) bool {
+	return any.GetTypeUrl() == ExtensionOptionERC20PermitTypeURL
+}
+
+// GetPreferredFeeTokenFromTx returns the preferred fee token set on the tx extension options
+// An empty denom is returned if the tx has no preference
+func GetPreferredFeeTokenFromTx(tx sdk.Tx) (string, error) {
+	// Check if the tx supports extension options
+	txWithExtensions, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return "", nil
+	}
+
+	// Look for the preferred fee token option
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		if !HasPreferredFeeTokenExtensionOption(opt) {
+			continue
+		}
+
+		// Decode the option
+		var option ExtensionOptionPreferredFeeToken
+		if err := option.Unmarshal(opt.Value); err != nil {
+			return "", err
+		}
+		return option.Denom, nil
+	}
+
+	// No preference was found
+	return "", nil
+}
+
+// GetFeeSponsorFromTx returns the fee sponsor set on the tx extension options
+// An empty sponsor is returned if the tx doesn't name a sponsor
+func GetFeeSponsorFromTx(tx sdk.Tx) (string, error) {
+	// Check if the tx supports extension options
+	txWithExtensions, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return "", nil
+	}
+
+	// Look for the fee sponsor option
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		if !HasFeeSponsorExtensionOption(opt) {
+			continue
+		}
+
+		// Decode the option
+		var option ExtensionOptionFeeSponsor
+		if err := option.Unmarshal(opt.Value); err != nil {
+			return "", err
+		}
+		return option.Sponsor, nil
+	}
+
+	// No sponsor was found
+	return "", nil
+}
+
+// GetERC20PermitFromTx returns the ERC20 permit set on the tx extension options
+// A nil permit is returned if the tx has no permit
+func GetERC20PermitFromTx(tx sdk.Tx) (*ExtensionOptionERC20Permit, error) {
+	// Check if the tx supports extension options
+	txWithExtensions, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	// Look for the permit option
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		if !HasERC20PermitExtensionOption(opt) {
+			continue
+		}
+
+		// Decode and validate the option
+		var option ExtensionOptionERC20Permit
+		if err := option.Unmarshal(opt.Value); err != nil {
+			return nil, err
+		}
+		if err := option.Validate(); err != nil {
+			return nil, err
+		}
+		return &option, nil
+	}
+
+	// No permit was found
+	return nil, nil
+}
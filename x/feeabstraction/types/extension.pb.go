@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabstraction/v1beta1/extension.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionPreferredFeeToken defines a tx extension option used to
+// select the fee token that should be charged for the tx fees
+type ExtensionOptionPreferredFeeToken struct {
+	// denom is the fee token denom that should be tried first
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *ExtensionOptionPreferredFeeToken) Reset()         { *m = ExtensionOptionPreferredFeeToken{} }
+func (m *ExtensionOptionPreferredFeeToken) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionPreferredFeeToken) ProtoMessage()    {}
+func (*ExtensionOptionPreferredFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36e7a1acf605bef, []int{0}
+}
+func (m *ExtensionOptionPreferredFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionPreferredFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionPreferredFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionPreferredFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionPreferredFeeToken.Merge(m, src)
+}
+func (m *ExtensionOptionPreferredFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionPreferredFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionPreferredFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionPreferredFeeToken proto.InternalMessageInfo
+
+func (m *ExtensionOptionPreferredFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ExtensionOptionFeeSponsor defines a tx extension option used to name the
+// sponsor that pays the tx fees
+type ExtensionOptionFeeSponsor struct {
+	// sponsor is the sponsor contract address
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *ExtensionOptionFeeSponsor) Reset()         { *m = ExtensionOptionFeeSponsor{} }
+func (m *ExtensionOptionFeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionFeeSponsor) ProtoMessage()    {}
+func (*ExtensionOptionFeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36e7a1acf605bef, []int{1}
+}
+func (m *ExtensionOptionFeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionFeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionFeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionFeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionFeeSponsor.Merge(m, src)
+}
+func (m *ExtensionOptionFeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionFeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionFeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionFeeSponsor proto.InternalMessageInfo
+
+func (m *ExtensionOptionFeeSponsor) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// ExtensionOptionERC20Permit defines a tx extension option carrying an EIP-2612
+// permit, used to approve the module address to collect the fees on an ERC20
+// fee token
+type ExtensionOptionERC20Permit struct {
+	// contract is the ERC20 contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// value is the amount approved by the permit
+	Value cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=cosmossdk.io/math.Int" json:"value"`
+	// deadline is the permit deadline, as a unix timestamp
+	Deadline uint64 `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// v is the recovery id of the permit signature
+	V uint32 `protobuf:"varint,4,opt,name=v,proto3" json:"v,omitempty"`
+	// r is the r value of the permit signature
+	R []byte `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	// s is the s value of the permit signature
+	S []byte `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *ExtensionOptionERC20Permit) Reset()         { *m = ExtensionOptionERC20Permit{} }
+func (m *ExtensionOptionERC20Permit) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionERC20Permit) ProtoMessage()    {}
+func (*ExtensionOptionERC20Permit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e36e7a1acf605bef, []int{2}
+}
+func (m *ExtensionOptionERC20Permit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionERC20Permit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionERC20Permit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionERC20Permit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionERC20Permit.Merge(m, src)
+}
+func (m *ExtensionOptionERC20Permit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionERC20Permit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionERC20Permit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionERC20Permit proto.InternalMessageInfo
+
+func (m *ExtensionOptionERC20Permit) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ExtensionOptionERC20Permit) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *ExtensionOptionERC20Permit) GetV() uint32 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+func (m *ExtensionOptionERC20Permit) GetR() []byte {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+func (m *ExtensionOptionERC20Permit) GetS() []byte {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExtensionOptionPreferredFeeToken)(nil), "kiichain.feeabstraction.v1beta1.ExtensionOptionPreferredFeeToken")
+	proto.RegisterType((*ExtensionOptionFeeSponsor)(nil), "kiichain.feeabstraction.v1beta1.ExtensionOptionFeeSponsor")
+	proto.RegisterType((*ExtensionOptionERC20Permit)(nil), "kiichain.feeabstraction.v1beta1.ExtensionOptionERC20Permit")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/extension.proto", fileDescriptor_e36e7a1acf605bef)
+}
+
+var fileDescriptor_e36e7a1acf605bef = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x33, 0xf7, 0xaa, 0xf7, 0xde, 0xc1, 0xbb, 0x09, 0x16, 0xa6, 0x42, 0x63, 0x70, 0x95,
+	0x55, 0x52, 0x2b, 0x85, 0xae, 0x2d, 0x0a, 0x5d, 0x94, 0x4a, 0xda, 0x55, 0x77, 0x93, 0xe4, 0xa8,
+	0x83, 0x66, 0x4e, 0x98, 0x19, 0x83, 0x7d, 0x8b, 0xbe, 0x4b, 0x5f, 0xc2, 0xa5, 0xcb, 0xd2, 0x85,
+	0x14, 0x7d, 0x91, 0x12, 0xa3, 0x96, 0xba, 0xfb, 0x3f, 0xe6, 0x7c, 0x87, 0xe1, 0xfc, 0x34, 0x98,
+	0x0a, 0x11, 0x4f, 0xb8, 0x90, 0xc1, 0x08, 0x80, 0x47, 0xda, 0x28, 0x1e, 0x1b, 0x81, 0x32, 0xc8,
+	0x3b, 0x11, 0x18, 0xde, 0x09, 0x60, 0x61, 0x40, 0x6a, 0x81, 0xd2, 0xcf, 0x14, 0x1a, 0xb4, 0x5b,
+	0x07, 0xc1, 0xff, 0x29, 0xf8, 0x7b, 0xa1, 0xd9, 0x18, 0xe3, 0x18, 0x77, 0xb3, 0x41, 0x91, 0x4a,
+	0xad, 0x7d, 0x43, 0xdd, 0xfe, 0x61, 0xd3, 0x43, 0x56, 0x08, 0x43, 0x05, 0x23, 0x50, 0x0a, 0x92,
+	0x01, 0xc0, 0x13, 0x4e, 0x41, 0xda, 0x0d, 0x5a, 0x4d, 0x40, 0x62, 0xca, 0x88, 0x4b, 0xbc, 0x7f,
+	0x61, 0x09, 0xed, 0x6b, 0x7a, 0x7e, 0x62, 0x0e, 0x00, 0x1e, 0x33, 0x94, 0x1a, 0x95, 0xcd, 0xe8,
+	0x1f, 0x5d, 0xc6, 0xbd, 0x74, 0xc0, 0xf6, 0x1b, 0xa1, 0xcd, 0x13, 0xaf, 0x1f, 0xde, 0x5e, 0x5d,
+	0x0e, 0x41, 0xa5, 0xc2, 0xd8, 0x4d, 0xfa, 0x37, 0x46, 0xb9, 0xfb, 0xfc, 0xde, 0x3c, 0xb2, 0xdd,
+	0xa5, 0xd5, 0x9c, 0xcf, 0xe6, 0xc0, 0x7e, 0x15, 0x0f, 0xbd, 0x8b, 0xe5, 0xba, 0x65, 0x7d, 0xac,
+	0x5b, 0x67, 0x31, 0xea, 0x14, 0xb5, 0x4e, 0xa6, 0xbe, 0xc0, 0x20, 0xe5, 0x66, 0xe2, 0xdf, 0x49,
+	0x13, 0x96, 0xb3, 0xc5, 0xc2, 0x04, 0x78, 0x32, 0x13, 0x12, 0xd8, 0x6f, 0x97, 0x78, 0x95, 0xf0,
+	0xc8, 0x76, 0x9d, 0x92, 0x9c, 0x55, 0x5c, 0xe2, 0xfd, 0x0f, 0x49, 0x5e, 0x90, 0x62, 0x55, 0x97,
+	0x78, 0xf5, 0x90, 0xa8, 0x82, 0x34, 0xab, 0x95, 0xa4, 0x7b, 0xf7, 0xcb, 0x8d, 0x43, 0x56, 0x1b,
+	0x87, 0x7c, 0x6e, 0x1c, 0xf2, 0xba, 0x75, 0xac, 0xd5, 0xd6, 0xb1, 0xde, 0xb7, 0x8e, 0xf5, 0xdc,
+	0x1d, 0x0b, 0x33, 0x99, 0x47, 0x7e, 0x8c, 0xe9, 0x77, 0x67, 0xc7, 0xb0, 0x38, 0xad, 0xcf, 0xbc,
+	0x64, 0xa0, 0xa3, 0xda, 0xee, 0xf8, 0xdd, 0xaf, 0x01, 0x00, 0xee, 0x11, 0x8b, 0xdd, 0xe6, 0x01,
+	0x00, 0x00,
+}
+
+func (m *ExtensionOptionPreferredFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionPreferredFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionPreferredFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionFeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionFeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionFeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionERC20Permit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionERC20Permit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionERC20Permit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.V != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionPreferredFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionFeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionERC20Permit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovExtension(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovExtension(uint64(m.Deadline))
+	}
+	if m.V != 0 {
+		n += 1 + sovExtension(uint64(m.V))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	return n
+}
+
+func sovExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtension(x uint64) (n int) {
+	return sovExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionPreferredFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionPreferredFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionPreferredFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionFeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionFeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionFeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionERC20Permit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionERC20Permit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionERC20Permit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	stdmath "math"
 
 	"cosmossdk.io/math"
 )
@@ -71,3 +72,28 @@ func ClampPrice(prevPrice, newPrice, clampFactor math.LegacyDec) math.LegacyDec
 	// Return the new price as it is within bounds
 	return newPrice
 }
+
+// AdjustPriority adjusts a tx priority computed over the native fee to the native value of the charged fee
+// The fee checkers compute the priority as (effective gas price - base fee) / priority reduction, so the
+// difference between the charged value and the native fee is added to the effective gas price
+// The result is kept between zero and the max int64
+func AdjustPriority(priority int64, nativeFee, chargedValue math.Int, gas uint64, priorityReduction math.Int) int64 {
+	// Nothing to adjust if the charged value is the native fee
+	if gas == 0 || chargedValue.Equal(nativeFee) {
+		return priority
+	}
+
+	// Calculate the difference on the priority
+	diff := chargedValue.Sub(nativeFee).Quo(math.NewIntFromUint64(gas)).Quo(priorityReduction)
+	adjusted := math.NewInt(priority).Add(diff)
+
+	// Keep the priority in bounds
+	if adjusted.IsNegative() {
+		return 0
+	}
+	if !adjusted.IsInt64() {
+		return stdmath.MaxInt64
+	}
+
+	return adjusted.Int64()
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	stdmath "math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -238,3 +239,73 @@ func TestClampPrice(t *testing.T) {
 		require.Equal(t, tc.expected, result)
 	}
 }
+
+// TestAdjustPriority tests the AdjustPriority function
+func TestAdjustPriority(t *testing.T) {
+	reduction := math.NewInt(1_000_000)
+
+	// Prepare the test cases
+	testCases := []struct {
+		name         string
+		priority     int64
+		nativeFee    math.Int
+		chargedValue math.Int
+		gas          uint64
+		expected     int64
+	}{
+		{
+			name:         "same value keeps the priority",
+			priority:     10,
+			nativeFee:    math.NewInt(1_000_000_000),
+			chargedValue: math.NewInt(1_000_000_000),
+			gas:          100,
+			expected:     10,
+		},
+		{
+			name:         "zero gas keeps the priority",
+			priority:     10,
+			nativeFee:    math.NewInt(1_000_000_000),
+			chargedValue: math.NewInt(2_000_000_000),
+			expected:     10,
+		},
+		{
+			name:         "higher value increases the priority",
+			priority:     10,
+			nativeFee:    math.NewInt(1_000_000_000),
+			chargedValue: math.NewInt(2_000_000_000),
+			gas:          100,
+			expected:     20,
+		},
+		{
+			name:         "lower value decreases the priority",
+			priority:     10,
+			nativeFee:    math.NewInt(1_000_000_000),
+			chargedValue: math.NewInt(500_000_000),
+			gas:          100,
+			expected:     5,
+		},
+		{
+			name:         "priority can't be negative",
+			priority:     10,
+			nativeFee:    math.NewInt(1_000_000_000),
+			chargedValue: math.ZeroInt(),
+			gas:          1,
+			expected:     0,
+		},
+		{
+			name:         "priority is capped to the max",
+			priority:     10,
+			nativeFee:    math.ZeroInt(),
+			chargedValue: math.NewInt(stdmath.MaxInt64).MulRaw(1_000_000),
+			gas:          1,
+			expected:     stdmath.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := types.AdjustPriority(tc.priority, tc.nativeFee, tc.chargedValue, tc.gas, reduction)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
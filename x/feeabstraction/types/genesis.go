@@ -1,7 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState constructs a genesis state
@@ -39,5 +43,77 @@ func (gs *GenesisState) Validate() error {
 		denomSet[token.Denom] = struct{}{}
 	}
 
+	// Validate each preferred fee token and check for duplicate accounts
+	accountSet := make(map[string]struct{})
+	for _, preference := range gs.PreferredFeeTokens {
+		if _, err := sdk.AccAddressFromBech32(preference.Address); err != nil {
+			return errorsmod.Wrapf(ErrUnknownFeeToken, "invalid preference address %s: %s", preference.Address, err)
+		}
+		// Preferences for removed fee tokens are kept and ignored when paying fees
+		if err := sdk.ValidateDenom(preference.Denom); err != nil {
+			return errorsmod.Wrapf(ErrUnknownFeeToken, "invalid preferred denom %s: %s", preference.Denom, err)
+		}
+		if _, exists := accountSet[preference.Address]; exists {
+			return errorsmod.Wrapf(ErrUnknownFeeToken, "duplicate preference found: %s", preference.Address)
+		}
+		accountSet[preference.Address] = struct{}{}
+	}
+
+	// Validate each sponsor and check for duplicate contracts
+	sponsorSet := make(map[string]struct{})
+	for _, sponsor := range gs.Sponsors {
+		if err := sponsor.Validate(); err != nil {
+			return err
+		}
+		if _, exists := sponsorSet[sponsor.Contract]; exists {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "duplicate sponsor found: %s", sponsor.Contract)
+		}
+		sponsorSet[sponsor.Contract] = struct{}{}
+	}
+
+	// Validate each sponsor usage
+	usageSet := make(map[string]struct{})
+	for _, entry := range gs.SponsorUsages {
+		if _, exists := sponsorSet[entry.Sponsor]; !exists {
+			return errorsmod.Wrapf(ErrUnknownSponsor, "usage for unknown sponsor: %s", entry.Sponsor)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.User); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "invalid usage user %s: %s", entry.User, err)
+		}
+		if err := entry.Usage.Validate(); err != nil {
+			return err
+		}
+		key := entry.Sponsor + "/" + entry.User
+		if _, exists := usageSet[key]; exists {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "duplicate sponsor usage found: %s", key)
+		}
+		usageSet[key] = struct{}{}
+	}
+
+	// Validate each fee revenue and check for duplicate denoms
+	revenueSet := make(map[string]struct{})
+	for _, revenue := range gs.FeeRevenues {
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+		if _, exists := revenueSet[revenue.Denom]; exists {
+			return errorsmod.Wrapf(ErrInvalidFeeRevenue, "duplicate fee revenue found: %s", revenue.Denom)
+		}
+		revenueSet[revenue.Denom] = struct{}{}
+	}
+
+	// Validate each fee token statistics and check for duplicate days
+	statsSet := make(map[string]struct{})
+	for _, stats := range gs.FeeTokenStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s", stats.Day, stats.Denom)
+		if _, exists := statsSet[key]; exists {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "duplicate fee token statistics found: %s", key)
+		}
+		statsSet[key] = struct{}{}
+	}
+
 	return nil
 }
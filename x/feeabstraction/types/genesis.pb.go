@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +30,16 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// fee_tokens defines the list of fee tokens
 	FeeTokens *FeeTokenMetadataCollection `protobuf:"bytes,2,opt,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens,omitempty"`
+	// preferred_fee_tokens defines the fee token preference of each account
+	PreferredFeeTokens []PreferredFeeToken `protobuf:"bytes,3,rep,name=preferred_fee_tokens,json=preferredFeeTokens,proto3" json:"preferred_fee_tokens"`
+	// sponsors defines the registered fee sponsors
+	Sponsors []Sponsor `protobuf:"bytes,4,rep,name=sponsors,proto3" json:"sponsors"`
+	// sponsor_usages defines the usage of the sponsors by each user
+	SponsorUsages []SponsorUsageEntry `protobuf:"bytes,5,rep,name=sponsor_usages,json=sponsorUsages,proto3" json:"sponsor_usages"`
+	// fee_revenues defines the fees collected and routed on each fee token
+	FeeRevenues []FeeRevenue `protobuf:"bytes,6,rep,name=fee_revenues,json=feeRevenues,proto3" json:"fee_revenues"`
+	// fee_token_stats defines the daily usage statistics of the fee tokens
+	FeeTokenStats []FeeTokenStats `protobuf:"bytes,7,rep,name=fee_token_stats,json=feeTokenStats,proto3" json:"fee_token_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,8 +89,164 @@ func (m *GenesisState) GetFeeTokens() *FeeTokenMetadataCollection {
 	return nil
 }
 
+func (m *GenesisState) GetPreferredFeeTokens() []PreferredFeeToken {
+	if m != nil {
+		return m.PreferredFeeTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsors() []Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorUsages() []SponsorUsageEntry {
+	if m != nil {
+		return m.SponsorUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRevenues() []FeeRevenue {
+	if m != nil {
+		return m.FeeRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokenStats() []FeeTokenStats {
+	if m != nil {
+		return m.FeeTokenStats
+	}
+	return nil
+}
+
+// PreferredFeeToken defines the fee token preferred by an account
+type PreferredFeeToken struct {
+	// address is the account address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the preferred fee token denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *PreferredFeeToken) Reset()         { *m = PreferredFeeToken{} }
+func (m *PreferredFeeToken) String() string { return proto.CompactTextString(m) }
+func (*PreferredFeeToken) ProtoMessage()    {}
+func (*PreferredFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6ed7e5c38ad11fa, []int{1}
+}
+func (m *PreferredFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreferredFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreferredFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PreferredFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreferredFeeToken.Merge(m, src)
+}
+func (m *PreferredFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreferredFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreferredFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreferredFeeToken proto.InternalMessageInfo
+
+func (m *PreferredFeeToken) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PreferredFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SponsorUsageEntry defines the usage of a sponsor by a user
+type SponsorUsageEntry struct {
+	// sponsor is the sponsor contract address
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// user is the sponsored account address
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// usage is the sponsor usage by the user
+	Usage SponsorUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *SponsorUsageEntry) Reset()         { *m = SponsorUsageEntry{} }
+func (m *SponsorUsageEntry) String() string { return proto.CompactTextString(m) }
+func (*SponsorUsageEntry) ProtoMessage()    {}
+func (*SponsorUsageEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6ed7e5c38ad11fa, []int{2}
+}
+func (m *SponsorUsageEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorUsageEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorUsageEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorUsageEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorUsageEntry.Merge(m, src)
+}
+func (m *SponsorUsageEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorUsageEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorUsageEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorUsageEntry proto.InternalMessageInfo
+
+func (m *SponsorUsageEntry) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsorUsageEntry) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SponsorUsageEntry) GetUsage() SponsorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return SponsorUsage{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.feeabstraction.v1beta1.GenesisState")
+	proto.RegisterType((*PreferredFeeToken)(nil), "kiichain.feeabstraction.v1beta1.PreferredFeeToken")
+	proto.RegisterType((*SponsorUsageEntry)(nil), "kiichain.feeabstraction.v1beta1.SponsorUsageEntry")
 }
 
 func init() {
@@ -87,23 +254,40 @@ func init() {
 }
 
 var fileDescriptor_a6ed7e5c38ad11fa = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x4b, 0x4d, 0x4d, 0x4c, 0x2a, 0x2e, 0x29, 0x4a, 0x4c, 0x2e,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x29, 0xd7, 0x43, 0x55,
-	0xae, 0x07, 0x55, 0x2e, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xab, 0x0f, 0x62, 0x41, 0xb4,
-	0x49, 0xe9, 0x10, 0xb2, 0xa5, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x89, 0xd2, 0x46, 0x46, 0x2e,
-	0x1e, 0x77, 0x88, 0xb5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xae, 0x5c, 0x6c, 0x10, 0x05, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xea, 0x7a, 0x04, 0x9c, 0xa1, 0x17, 0x00, 0x56, 0xee, 0xc4,
-	0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb3, 0x50, 0x14, 0x17, 0x57, 0x5a, 0x6a, 0x6a, 0x7c,
-	0x49, 0x7e, 0x76, 0x6a, 0x5e, 0xb1, 0x04, 0x13, 0xd8, 0x28, 0x6b, 0x82, 0x46, 0xb9, 0xa5, 0xa6,
-	0x86, 0x80, 0x74, 0xf8, 0xa6, 0x96, 0x24, 0xa6, 0x24, 0x96, 0x24, 0x3a, 0xe7, 0xe7, 0xe4, 0xa4,
-	0x82, 0x95, 0x04, 0x71, 0xa6, 0x41, 0xe5, 0x8a, 0x9d, 0x7c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x1f, 0x1e, 0x0c, 0x70, 0x46, 0x05, 0x7a, 0x88, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x43, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x07, 0xd5, 0x1a, 0xb0, 0x9f, 0x01, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x8f, 0x92, 0x4d, 0x0b, 0xea, 0x2a, 0x07, 0xd3, 0x83, 0x5b, 0xe5, 0x42, 0xa4,
+	0x36, 0xb6, 0x9a, 0x1e, 0x39, 0x11, 0x54, 0x10, 0x48, 0x95, 0x90, 0x53, 0x2e, 0x15, 0xc8, 0xda,
+	0xc4, 0x63, 0xd7, 0xb4, 0xf1, 0x5a, 0x3b, 0x9b, 0x8a, 0xfe, 0x0b, 0x7e, 0x0c, 0x3f, 0x80, 0x63,
+	0x8f, 0x15, 0x27, 0x4e, 0x08, 0x25, 0xff, 0x03, 0x21, 0xef, 0xae, 0xad, 0xd0, 0x1e, 0x9c, 0xde,
+	0x76, 0x77, 0xde, 0x7b, 0x3b, 0x7a, 0xf3, 0x86, 0x0c, 0x2e, 0x93, 0x64, 0x7a, 0xc1, 0x92, 0xd4,
+	0x8b, 0x00, 0xd8, 0x04, 0xa5, 0x60, 0x53, 0x99, 0xf0, 0xd4, 0xbb, 0x3e, 0x9a, 0x80, 0x64, 0x47,
+	0x5e, 0x0c, 0x29, 0x60, 0x82, 0x6e, 0x26, 0xb8, 0xe4, 0x74, 0xaf, 0x80, 0xbb, 0xff, 0xc3, 0x5d,
+	0x03, 0xdf, 0xed, 0xc6, 0x3c, 0xe6, 0x0a, 0xeb, 0xe5, 0x27, 0x4d, 0xdb, 0x7d, 0x3e, 0xe5, 0x38,
+	0xe3, 0x18, 0xe8, 0x82, 0xbe, 0x98, 0xd2, 0x61, 0x55, 0x03, 0x19, 0x13, 0x6c, 0x56, 0xa0, 0x2b,
+	0xdb, 0x15, 0x70, 0x0d, 0xe9, 0x1c, 0xd6, 0x85, 0x63, 0xc6, 0x53, 0xe4, 0xc2, 0xc0, 0x0f, 0x2a,
+	0xe1, 0x92, 0x49, 0xd3, 0x4a, 0xef, 0x6f, 0x83, 0x6c, 0xbd, 0xd5, 0xe6, 0x8c, 0x25, 0x93, 0x40,
+	0x4f, 0x48, 0x4b, 0xf7, 0x6a, 0x5b, 0xfb, 0x56, 0xbf, 0x33, 0x7c, 0xe1, 0x56, 0x98, 0xe5, 0x7e,
+	0x50, 0xf0, 0x51, 0xe3, 0xf6, 0xf7, 0x5e, 0xcd, 0x37, 0x64, 0x7a, 0x4e, 0x48, 0x04, 0x10, 0x48,
+	0x7e, 0x09, 0x29, 0xda, 0x1b, 0x4a, 0xea, 0x65, 0xa5, 0xd4, 0x1b, 0x80, 0xb3, 0x9c, 0x71, 0x0a,
+	0x92, 0x85, 0x4c, 0xb2, 0xd7, 0xfc, 0xea, 0x0a, 0x14, 0xc4, 0x6f, 0x47, 0xa6, 0x86, 0xf4, 0x0b,
+	0xe9, 0x66, 0x02, 0x22, 0x10, 0x02, 0xc2, 0x60, 0xe5, 0x97, 0xfa, 0x7e, 0xbd, 0xdf, 0x19, 0x0e,
+	0xab, 0x1b, 0x2e, 0xc8, 0xc5, 0x77, 0xa6, 0x77, 0x9a, 0xdd, 0x2f, 0x20, 0x7d, 0x4f, 0x9e, 0x18,
+	0x77, 0xd1, 0x6e, 0x28, 0xfd, 0x7e, 0xa5, 0xfe, 0x58, 0x13, 0x8c, 0x6a, 0xc9, 0xa7, 0x01, 0x79,
+	0x6a, 0xce, 0xc1, 0x1c, 0x59, 0x0c, 0x68, 0x37, 0xd7, 0xec, 0xd8, 0x28, 0x7e, 0xcc, 0x59, 0x27,
+	0xa9, 0x14, 0x37, 0x46, 0x7b, 0x1b, 0x57, 0x0a, 0x48, 0xcf, 0xc8, 0x56, 0x6e, 0x87, 0x49, 0x0f,
+	0xda, 0x2d, 0x25, 0x7f, 0xb0, 0x8e, 0xed, 0xbe, 0xe6, 0x18, 0xdd, 0x4e, 0x54, 0xbe, 0x20, 0xfd,
+	0x44, 0x9e, 0x95, 0x26, 0x07, 0x2a, 0x3b, 0xf6, 0xa6, 0x12, 0x76, 0xd7, 0x9e, 0x67, 0x1e, 0xad,
+	0x22, 0x21, 0xdb, 0xd1, 0xea, 0x63, 0xef, 0x33, 0xd9, 0x79, 0x30, 0x0f, 0x3a, 0x24, 0x9b, 0x2c,
+	0x0c, 0x05, 0xa0, 0x4e, 0x61, 0x7b, 0x64, 0xff, 0xfc, 0x3e, 0xe8, 0x9a, 0x8d, 0x7b, 0xa5, 0x2b,
+	0x63, 0x29, 0x92, 0x34, 0xf6, 0x0b, 0x20, 0xed, 0x92, 0x66, 0x08, 0x29, 0x9f, 0xa9, 0xb0, 0xb5,
+	0x7d, 0x7d, 0xe9, 0xfd, 0xb0, 0xc8, 0xce, 0x03, 0xf7, 0x72, 0x7d, 0xe3, 0x5c, 0xb5, 0xbe, 0x01,
+	0xd2, 0x43, 0xd2, 0x98, 0x23, 0x08, 0x7b, 0xa3, 0x82, 0xa0, 0x50, 0xf4, 0x1d, 0x69, 0xaa, 0x19,
+	0xdb, 0x75, 0x15, 0xfd, 0xc1, 0xa3, 0x46, 0x6c, 0x9c, 0xd2, 0x0a, 0xa3, 0xd3, 0xdb, 0x85, 0x63,
+	0xdd, 0x2d, 0x1c, 0xeb, 0xcf, 0xc2, 0xb1, 0xbe, 0x2d, 0x9d, 0xda, 0xdd, 0xd2, 0xa9, 0xfd, 0x5a,
+	0x3a, 0xb5, 0xf3, 0xe3, 0x38, 0x91, 0x17, 0xf3, 0x89, 0x3b, 0xe5, 0x33, 0xaf, 0x5c, 0xfa, 0xf2,
+	0xf0, 0xf5, 0xfe, 0xfe, 0xcb, 0x9b, 0x0c, 0x70, 0xd2, 0x52, 0x8b, 0x7f, 0xfc, 0x6f, 0x00, 0x25,
+	0x5a, 0x41, 0x52, 0x34, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +310,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokenStats) > 0 {
+		for iNdEx := len(m.FeeTokenStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeRevenues) > 0 {
+		for iNdEx := len(m.FeeRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SponsorUsages) > 0 {
+		for iNdEx := len(m.SponsorUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Sponsors) > 0 {
+		for iNdEx := len(m.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PreferredFeeTokens) > 0 {
+		for iNdEx := len(m.PreferredFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreferredFeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.FeeTokens != nil {
 		{
 			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
@@ -151,6 +405,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PreferredFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreferredFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreferredFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorUsageEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorUsageEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorUsageEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -174,6 +512,72 @@ func (m *GenesisState) Size() (n int) {
 		l = m.FeeTokens.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PreferredFeeTokens) > 0 {
+		for _, e := range m.PreferredFeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsors) > 0 {
+		for _, e := range m.Sponsors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsorUsages) > 0 {
+		for _, e := range m.SponsorUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRevenues) > 0 {
+		for _, e := range m.FeeRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokenStats) > 0 {
+		for _, e := range m.FeeTokenStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PreferredFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *SponsorUsageEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -281,6 +685,437 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredFeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredFeeTokens = append(m.PreferredFeeTokens, PreferredFeeToken{})
+			if err := m.PreferredFeeTokens[len(m.PreferredFeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsors = append(m.Sponsors, Sponsor{})
+			if err := m.Sponsors[len(m.Sponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorUsages = append(m.SponsorUsages, SponsorUsageEntry{})
+			if err := m.SponsorUsages[len(m.SponsorUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRevenues = append(m.FeeRevenues, FeeRevenue{})
+			if err := m.FeeRevenues[len(m.FeeRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokenStats = append(m.FeeTokenStats, FeeTokenStats{})
+			if err := m.FeeTokenStats[len(m.FeeTokenStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreferredFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreferredFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreferredFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorUsageEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorUsageEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorUsageEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,11 +7,17 @@ import (
 
 	"cosmossdk.io/math"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
 	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
 )
 
 // TestGenesisStateValidate tests the Validate method of GenesisState
 func TestGenesisStateValidate(t *testing.T) {
+	// The sponsor addresses
+	contract := authtypes.NewModuleAddress("contract").String()
+	admin := authtypes.NewModuleAddress("admin").String()
+
 	// Create all the test cases
 	testCases := []struct {
 		name         string
@@ -26,7 +32,7 @@ func TestGenesisStateValidate(t *testing.T) {
 			name: "valid - custom genesis state",
 			genesisState: types.NewGenesisState(
 				types.NewParams(
-					"coin", "coinoracle", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true),
+					"coin", "coinoracle", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true, types.DefaultReenableBlocks),
 				types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor),
 					types.NewFeeTokenMetadata("two", "oracletwo", 18, types.DefaultClampFactor.MulInt64(2)),
@@ -36,7 +42,7 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			name: "invalid - bad param",
 			genesisState: types.NewGenesisState(
-				types.NewParams("", "coinoracle", types.DefaultClampFactor, math.LegacyZeroDec(), 0, true),
+				types.NewParams("", "coinoracle", types.DefaultClampFactor, math.LegacyZeroDec(), 0, true, types.DefaultReenableBlocks),
 				types.NewFeeTokenMetadataCollection(),
 			),
 			errContains: "native denom is invalid",
@@ -62,6 +68,108 @@ func TestGenesisStateValidate(t *testing.T) {
 			),
 			errContains: "duplicate denom found: coin",
 		},
+		{
+			name: "valid - preferred fee token",
+			genesisState: withPreferredFeeTokens(
+				types.NewGenesisState(
+					types.DefaultParams(),
+					types.NewFeeTokenMetadataCollection(
+						types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor),
+					),
+				),
+				types.PreferredFeeToken{Address: authtypes.NewModuleAddress("alice").String(), Denom: "coin"},
+			),
+		},
+		{
+			name: "invalid - preferred fee token with bad denom",
+			genesisState: withPreferredFeeTokens(
+				types.NewGenesisState(
+					types.DefaultParams(),
+					types.NewFeeTokenMetadataCollection(
+						types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor),
+					),
+				),
+				types.PreferredFeeToken{Address: authtypes.NewModuleAddress("alice").String(), Denom: "!other"},
+			),
+			errContains: "invalid preferred denom !other",
+		},
+		{
+			name: "invalid - preferred fee token with bad address",
+			genesisState: withPreferredFeeTokens(
+				types.NewGenesisState(
+					types.DefaultParams(),
+					types.NewFeeTokenMetadataCollection(
+						types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor),
+					),
+				),
+				types.PreferredFeeToken{Address: "invalid", Denom: "coin"},
+			),
+			errContains: "invalid preference address",
+		},
+		{
+			name: "invalid - duplicate preferred fee token account",
+			genesisState: withPreferredFeeTokens(
+				types.NewGenesisState(
+					types.DefaultParams(),
+					types.NewFeeTokenMetadataCollection(
+						types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor),
+					),
+				),
+				types.PreferredFeeToken{Address: authtypes.NewModuleAddress("alice").String(), Denom: "coin"},
+				types.PreferredFeeToken{Address: authtypes.NewModuleAddress("alice").String(), Denom: "coin"},
+			),
+			errContains: "duplicate preference found",
+		},
+		{
+			name: "valid - sponsor with usage",
+			genesisState: withSponsors(
+				types.DefaultGenesisState(),
+				[]types.Sponsor{types.NewSponsor(contract, admin, types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()))},
+				types.SponsorUsageEntry{Sponsor: contract, User: admin, Usage: types.NewSponsorUsage().Add(math.OneInt())},
+			),
+		},
+		{
+			name: "invalid - duplicate sponsor",
+			genesisState: withSponsors(
+				types.DefaultGenesisState(),
+				[]types.Sponsor{
+					types.NewSponsor(contract, admin, types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())),
+					types.NewSponsor(contract, admin, types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())),
+				},
+			),
+			errContains: "duplicate sponsor found",
+		},
+		{
+			name: "invalid - usage for unknown sponsor",
+			genesisState: withSponsors(
+				types.DefaultGenesisState(),
+				nil,
+				types.SponsorUsageEntry{Sponsor: contract, User: admin, Usage: types.NewSponsorUsage()},
+			),
+			errContains: "usage for unknown sponsor",
+		},
+		{
+			name:         "valid - fee revenues",
+			genesisState: withFeeRevenues(types.DefaultGenesisState(), types.NewFeeRevenue("uatom"), types.NewFeeRevenue("uosmo")),
+		},
+		{
+			name:         "invalid - duplicate fee revenue",
+			genesisState: withFeeRevenues(types.DefaultGenesisState(), types.NewFeeRevenue("uatom"), types.NewFeeRevenue("uatom")),
+			errContains:  "duplicate fee revenue found: uatom",
+		},
+		{
+			name: "valid - fee token stats",
+			genesisState: withFeeTokenStats(
+				types.DefaultGenesisState(), types.NewFeeTokenStats("uatom", 1), types.NewFeeTokenStats("uatom", 2),
+			),
+		},
+		{
+			name: "invalid - duplicate fee token stats",
+			genesisState: withFeeTokenStats(
+				types.DefaultGenesisState(), types.NewFeeTokenStats("uatom", 1), types.NewFeeTokenStats("uatom", 1),
+			),
+			errContains: "duplicate fee token statistics found: 1/uatom",
+		},
 	}
 
 	// Iterate through the test cases
@@ -79,3 +187,28 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+// withPreferredFeeTokens sets the preferred fee tokens on a genesis state
+func withPreferredFeeTokens(gs *types.GenesisState, preferences ...types.PreferredFeeToken) *types.GenesisState {
+	gs.PreferredFeeTokens = preferences
+	return gs
+}
+
+// withSponsors sets the sponsors and their usages on a genesis state
+func withSponsors(gs *types.GenesisState, sponsors []types.Sponsor, usages ...types.SponsorUsageEntry) *types.GenesisState {
+	gs.Sponsors = sponsors
+	gs.SponsorUsages = usages
+	return gs
+}
+
+// withFeeRevenues sets the fee revenues on a genesis state
+func withFeeRevenues(gs *types.GenesisState, revenues ...types.FeeRevenue) *types.GenesisState {
+	gs.FeeRevenues = revenues
+	return gs
+}
+
+// withFeeTokenStats sets the fee token statistics on a genesis state
+func withFeeTokenStats(gs *types.GenesisState, stats ...types.FeeTokenStats) *types.GenesisState {
+	gs.FeeTokenStats = stats
+	return gs
+}
@@ -4,8 +4,21 @@ import "cosmossdk.io/collections"
 
 // Defines all the KV keys for the collections
 var (
-	ParamsKey    = collections.NewPrefix(0)
-	FeeTokensKey = collections.NewPrefix(1)
+	ParamsKey             = collections.NewPrefix(0)
+	FeeTokensKey          = collections.NewPrefix(1)
+	PreferredFeeTokensKey = collections.NewPrefix(2)
+	FeeTokenUsagesKey     = collections.NewPrefix(3)
+	SponsorsKey           = collections.NewPrefix(4)
+	SponsorUsagesKey      = collections.NewPrefix(5)
+	FeeRevenuesKey        = collections.NewPrefix(6)
+	FeeTokenStatsKey      = collections.NewPrefix(7)
+
+	// ChargedFeesKey is kept on the transient store
+	ChargedFeesKey = collections.NewPrefix(8)
+
+	// CollectedFeesKey holds the fee tokens collected since the last routing
+	// It's kept on the KV store, as the routing is skipped while the module is disabled
+	CollectedFeesKey = collections.NewPrefix(9)
 )
 
 const (
@@ -15,9 +28,18 @@ const (
 	// StoreKey defines the module store key
 	StoreKey = ModuleName
 
+	// TStoreKey defines the module transient store key
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route
 	RouterKey = ModuleName
 
 	// QuerierRoute defines the module query routing key
 	QuerierRoute = ModuleName
+
+	// TreasuryName defines the module account receiving the fees routed to the treasury
+	TreasuryName = "feeabstraction_treasury"
+
+	// ReserveName defines the module account holding the native reserve used on the settlements
+	ReserveName = "feeabstraction_reserve"
 )
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +12,17 @@ import (
 var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgUpdateFeeTokens)(nil)
+	_ sdk.Msg = (*MsgSetPreferredFeeToken)(nil)
+	_ sdk.Msg = (*MsgAddFeeToken)(nil)
+	_ sdk.Msg = (*MsgRegisterFeeToken)(nil)
+	_ sdk.Msg = (*MsgRemoveFeeToken)(nil)
+	_ sdk.Msg = (*MsgSetFeeTokenEnabled)(nil)
+	_ sdk.Msg = (*MsgRegisterSponsor)(nil)
+	_ sdk.Msg = (*MsgUpdateSponsorPolicy)(nil)
+	_ sdk.Msg = (*MsgFundSponsor)(nil)
+	_ sdk.Msg = (*MsgWithdrawSponsorFunds)(nil)
+	_ sdk.Msg = (*MsgSweepERC20Fees)(nil)
+	_ sdk.Msg = (*MsgWithdrawRevenue)(nil)
 
 	// Define the types for the events
 	TypeEventConvertFees           = "convert_fees"
@@ -15,6 +30,44 @@ var (
 	TypeAttributeOriginalFeeAmount = "original_fee"
 	TypeAttributeConvertedFee      = "converted_fee"
 	TypeAttributePrice             = "price"
+
+	TypeEventSetPreferredFeeToken = "set_preferred_fee_token"
+	TypeAttributeAccount          = "account"
+	TypeAttributeDenom            = "denom"
+
+	TypeEventFeeTokenSuspended = "fee_token_suspended"
+	TypeEventFeeTokenReenabled = "fee_token_reenabled"
+	TypeAttributeOracleDenom   = "oracle_denom"
+
+	TypeEventAddFeeToken        = "add_fee_token"
+	TypeEventRegisterFeeToken   = "register_fee_token"
+	TypeAttributeDecimals       = "decimals"
+	TypeEventRemoveFeeToken     = "remove_fee_token"
+	TypeEventSetFeeTokenEnabled = "set_fee_token_enabled"
+	TypeAttributeEnabled        = "enabled"
+
+	TypeEventRegisterSponsor      = "register_sponsor"
+	TypeEventUpdateSponsorPolicy  = "update_sponsor_policy"
+	TypeEventFundSponsor          = "fund_sponsor"
+	TypeEventWithdrawSponsorFunds = "withdraw_sponsor_funds"
+	TypeEventSponsorFee           = "sponsor_fee"
+	TypeEventSponsorRefund        = "sponsor_refund"
+	TypeEventSweepERC20Fees       = "sweep_erc20_fees"
+	TypeEventWithdrawRevenue      = "withdraw_revenue"
+	TypeAttributeRecipient        = "recipient"
+	TypeAttributeSponsor          = "sponsor"
+	TypeAttributeSender           = "sender"
+	TypeAttributeAmount           = "amount"
+	TypeAttributeFee              = "fee"
+
+	TypeEventRouteFeeRevenue  = "route_fee_revenue"
+	TypeEventSettleFeeRevenue = "settle_fee_revenue"
+	TypeAttributeDestination  = "destination"
+	TypeAttributeNativeAmount = "native_amount"
+
+	TypeEventRefundFees          = "refund_fees"
+	TypeAttributeOriginalRefund  = "original_refund"
+	TypeAttributeConvertedRefund = "converted_refund"
 )
 
 // NewMessageUpdateParams creates a new MsgUpdateParams instance
@@ -54,3 +107,280 @@ func (msg *MsgUpdateFeeTokens) Validate() error {
 	// Validate the fee tokens
 	return msg.FeeTokens.Validate()
 }
+
+// NewMessageSetPreferredFeeToken creates a new MsgSetPreferredFeeToken instance
+func NewMessageSetPreferredFeeToken(sender string, denom string) *MsgSetPreferredFeeToken {
+	return &MsgSetPreferredFeeToken{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Validate performs basic validation on the MsgSetPreferredFeeToken message
+func (msg *MsgSetPreferredFeeToken) Validate() error {
+	// Validate the sender
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	// An empty denom clears the preference
+	if msg.Denom == "" {
+		return nil
+	}
+
+	// Validate the denom
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// NewMessageAddFeeToken creates a new MsgAddFeeToken instance
+func NewMessageAddFeeToken(authority string, feeToken FeeTokenMetadata) *MsgAddFeeToken {
+	return &MsgAddFeeToken{
+		Authority: authority,
+		FeeToken:  feeToken,
+	}
+}
+
+// Validate performs basic validation on the MsgAddFeeToken message
+func (msg *MsgAddFeeToken) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// New tokens can't start suspended, the runtime state is managed by the module
+	if msg.FeeToken.Suspended || msg.FeeToken.ValidPriceBlocks != 0 {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "fee token can't be added as suspended")
+	}
+
+	// Validate the fee token
+	return msg.FeeToken.Validate()
+}
+
+// NewMessageRegisterFeeToken creates a new MsgRegisterFeeToken instance
+func NewMessageRegisterFeeToken(authority, token, oracleDenom string) *MsgRegisterFeeToken {
+	return &MsgRegisterFeeToken{
+		Authority:   authority,
+		Token:       token,
+		OracleDenom: oracleDenom,
+	}
+}
+
+// Validate performs basic validation on the MsgRegisterFeeToken message
+func (msg *MsgRegisterFeeToken) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// The token is either an ERC20 contract or a denom
+	if !common.IsHexAddress(msg.Token) {
+		if err := sdk.ValidateDenom(msg.Token); err != nil {
+			return errorsmod.Wrapf(ErrFeeTokenRegistration, "invalid token %s: %s", msg.Token, err)
+		}
+	}
+
+	// Validate the oracle denom
+	if err := sdk.ValidateDenom(msg.OracleDenom); err != nil {
+		return errorsmod.Wrapf(ErrFeeTokenRegistration, "invalid oracle denom %s: %s", msg.OracleDenom, err)
+	}
+
+	return nil
+}
+
+// NewMessageRemoveFeeToken creates a new MsgRemoveFeeToken instance
+func NewMessageRemoveFeeToken(authority string, denom string) *MsgRemoveFeeToken {
+	return &MsgRemoveFeeToken{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// Validate performs basic validation on the MsgRemoveFeeToken message
+func (msg *MsgRemoveFeeToken) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// NewMessageSetFeeTokenEnabled creates a new MsgSetFeeTokenEnabled instance
+func NewMessageSetFeeTokenEnabled(authority string, denom string, enabled bool) *MsgSetFeeTokenEnabled {
+	return &MsgSetFeeTokenEnabled{
+		Authority: authority,
+		Denom:     denom,
+		Enabled:   enabled,
+	}
+}
+
+// Validate performs basic validation on the MsgSetFeeTokenEnabled message
+func (msg *MsgSetFeeTokenEnabled) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+// NewMessageRegisterSponsor creates a new MsgRegisterSponsor instance
+func NewMessageRegisterSponsor(sender, contract string, nonces []uint64, deposit sdk.Coins, policy SponsorPolicy) *MsgRegisterSponsor {
+	return &MsgRegisterSponsor{
+		Sender:   sender,
+		Contract: contract,
+		Nonces:   nonces,
+		Deposit:  deposit,
+		Policy:   policy,
+	}
+}
+
+// Validate performs basic validation on the MsgRegisterSponsor message
+func (msg *MsgRegisterSponsor) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return err
+	}
+
+	// Validate the nonces
+	if len(msg.Nonces) > MaxSponsorNonces {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "max of %d nonces allowed, got %d", MaxSponsorNonces, len(msg.Nonces))
+	}
+
+	// Validate the deposit
+	if err := msg.Deposit.Validate(); err != nil {
+		return err
+	}
+
+	// Validate the policy
+	return msg.Policy.Validate()
+}
+
+// NewMessageUpdateSponsorPolicy creates a new MsgUpdateSponsorPolicy instance
+func NewMessageUpdateSponsorPolicy(sender, contract string, policy SponsorPolicy) *MsgUpdateSponsorPolicy {
+	return &MsgUpdateSponsorPolicy{
+		Sender:   sender,
+		Contract: contract,
+		Policy:   policy,
+	}
+}
+
+// Validate performs basic validation on the MsgUpdateSponsorPolicy message
+func (msg *MsgUpdateSponsorPolicy) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return err
+	}
+
+	// Validate the policy
+	return msg.Policy.Validate()
+}
+
+// NewMessageFundSponsor creates a new MsgFundSponsor instance
+func NewMessageFundSponsor(sender, contract string, amount sdk.Coins) *MsgFundSponsor {
+	return &MsgFundSponsor{
+		Sender:   sender,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+// Validate performs basic validation on the MsgFundSponsor message
+func (msg *MsgFundSponsor) Validate() error {
+	return validateSponsorFundsMsg(msg.Sender, msg.Contract, msg.Amount)
+}
+
+// NewMessageWithdrawSponsorFunds creates a new MsgWithdrawSponsorFunds instance
+func NewMessageWithdrawSponsorFunds(sender, contract string, amount sdk.Coins) *MsgWithdrawSponsorFunds {
+	return &MsgWithdrawSponsorFunds{
+		Sender:   sender,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+// Validate performs basic validation on the MsgWithdrawSponsorFunds message
+func (msg *MsgWithdrawSponsorFunds) Validate() error {
+	return validateSponsorFundsMsg(msg.Sender, msg.Contract, msg.Amount)
+}
+
+// NewMessageSweepERC20Fees creates a new MsgSweepERC20Fees instance
+func NewMessageSweepERC20Fees(authority, contract, recipient string) *MsgSweepERC20Fees {
+	return &MsgSweepERC20Fees{
+		Authority: authority,
+		Contract:  contract,
+		Recipient: recipient,
+	}
+}
+
+// Validate performs basic validation on the MsgSweepERC20Fees message
+func (msg *MsgSweepERC20Fees) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return err
+	}
+
+	// Validate the contract
+	if !common.IsHexAddress(msg.Contract) {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "invalid ERC20 address: %s", msg.Contract)
+	}
+	return nil
+}
+
+// NewMessageWithdrawRevenue creates a new MsgWithdrawRevenue instance
+func NewMessageWithdrawRevenue(authority, account, recipient string, amount sdk.Coins) *MsgWithdrawRevenue {
+	return &MsgWithdrawRevenue{
+		Authority: authority,
+		Account:   account,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// Validate performs basic validation on the MsgWithdrawRevenue message
+func (msg *MsgWithdrawRevenue) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return err
+	}
+
+	// Validate the account and the amount
+	if _, found := GetRevenueAccountName(msg.Account); !found {
+		return errorsmod.Wrapf(ErrInvalidFeeRevenue, "unknown revenue account: %s", msg.Account)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidFeeRevenue, "invalid amount: %s", msg.Amount)
+	}
+	return nil
+}
+
+// validateSponsorFundsMsg validates the fields of the msgs moving sponsor funds
+func validateSponsorFundsMsg(sender, contract string, amount sdk.Coins) error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return err
+	}
+
+	// Validate the amount
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid amount: %s", amount)
+	}
+	return nil
+}
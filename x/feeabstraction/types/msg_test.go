@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -32,7 +33,7 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 			name: "valid - custom params",
 			msg: types.NewMessageUpdateParams(
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				types.NewParams("coin", "coinoracle", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true),
+				types.NewParams("coin", "coinoracle", types.DefaultClampFactor, types.DefaultFallbackNativePrice, types.DefaultTwapLookbackWindow, true, types.DefaultReenableBlocks),
 			),
 		},
 		{
@@ -44,7 +45,7 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 			name: "invalid - bad params",
 			msg: types.NewMessageUpdateParams(
 				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-				types.NewParams("", "coinoracle", types.DefaultClampFactor, math.LegacyZeroDec(), 0, true),
+				types.NewParams("", "coinoracle", types.DefaultClampFactor, math.LegacyZeroDec(), 0, true, types.DefaultReenableBlocks),
 			),
 			errContains: "native denom is invalid: invalid fee abstraction params",
 		},
@@ -127,3 +128,471 @@ func TestMsgUpdateFeeTokensValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestMsgSetPreferredFeeTokenValidate tests the Validate method of MsgSetPreferredFeeToken
+func TestMsgSetPreferredFeeTokenValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetPreferredFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - set preference",
+			msg:  types.NewMessageSetPreferredFeeToken(authtypes.NewModuleAddress("alice").String(), "coin"),
+		},
+		{
+			name: "valid - clear preference",
+			msg:  types.NewMessageSetPreferredFeeToken(authtypes.NewModuleAddress("alice").String(), ""),
+		},
+		{
+			name:        "invalid - empty sender",
+			msg:         types.NewMessageSetPreferredFeeToken("", "coin"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageSetPreferredFeeToken(authtypes.NewModuleAddress("alice").String(), "!coin"),
+			errContains: "invalid denom",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgAddFeeTokenValidate tests the Validate method of MsgAddFeeToken
+func TestMsgAddFeeTokenValidate(t *testing.T) {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// A suspended fee token
+	suspendedFeeToken := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyOneDec())
+	suspendedFeeToken.Suspended = true
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgAddFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - fee token",
+			msg:  types.NewMessageAddFeeToken(authority, types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyOneDec())),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageAddFeeToken("", types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyOneDec())),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad fee token",
+			msg:         types.NewMessageAddFeeToken(authority, types.NewFeeTokenMetadata("coin", "oraclecoin", 0, math.LegacyOneDec())),
+			errContains: "decimals must be between 1 and 18",
+		},
+		{
+			name:        "invalid - suspended fee token",
+			msg:         types.NewMessageAddFeeToken(authority, suspendedFeeToken),
+			errContains: "fee token can't be added as suspended",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgRegisterFeeTokenValidate tests the Validate method of MsgRegisterFeeToken
+func TestMsgRegisterFeeTokenValidate(t *testing.T) {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRegisterFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - ERC20 contract",
+			msg:  types.NewMessageRegisterFeeToken(authority, "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd", "usdc"),
+		},
+		{
+			name: "valid - IBC denom",
+			msg:  types.NewMessageRegisterFeeToken(authority, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "atom"),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageRegisterFeeToken("", "uatom", "atom"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad token",
+			msg:         types.NewMessageRegisterFeeToken(authority, "", "atom"),
+			errContains: "invalid token",
+		},
+		{
+			name:        "invalid - bad oracle denom",
+			msg:         types.NewMessageRegisterFeeToken(authority, "uatom", ""),
+			errContains: "invalid oracle denom",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgRemoveFeeTokenValidate tests the Validate method of MsgRemoveFeeToken
+func TestMsgRemoveFeeTokenValidate(t *testing.T) {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRemoveFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - denom",
+			msg:  types.NewMessageRemoveFeeToken(authority, "coin"),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageRemoveFeeToken("", "coin"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageRemoveFeeToken(authority, "1coin"),
+			errContains: "invalid denom",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgSetFeeTokenEnabledValidate tests the Validate method of MsgSetFeeTokenEnabled
+func TestMsgSetFeeTokenEnabledValidate(t *testing.T) {
+	// The governance authority
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetFeeTokenEnabled
+		errContains string
+	}{
+		{
+			name: "valid - enable",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, "coin", true),
+		},
+		{
+			name: "valid - disable",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, "coin", false),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageSetFeeTokenEnabled("", "coin", true),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageSetFeeTokenEnabled(authority, "1coin", true),
+			errContains: "invalid denom",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgRegisterSponsorValidate tests the validate function for MsgRegisterSponsor
+func TestMsgRegisterSponsorValidate(t *testing.T) {
+	// The sender and the contract
+	sender := authtypes.NewModuleAddress("sender").String()
+	contract := authtypes.NewModuleAddress("contract").String()
+	deposit := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+	policy := types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRegisterSponsor
+		errContains string
+	}{
+		{
+			name: "valid - wasm contract",
+			msg:  types.NewMessageRegisterSponsor(sender, contract, nil, deposit, policy),
+		},
+		{
+			name: "valid - EVM contract with nonces and no deposit",
+			msg:  types.NewMessageRegisterSponsor(sender, contract, []uint64{1, 2}, sdk.Coins{}, policy),
+		},
+		{
+			name:        "invalid - empty sender",
+			msg:         types.NewMessageRegisterSponsor("", contract, nil, deposit, policy),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad contract",
+			msg:         types.NewMessageRegisterSponsor(sender, "contract", nil, deposit, policy),
+			errContains: "decoding bech32 failed",
+		},
+		{
+			name:        "invalid - too many nonces",
+			msg:         types.NewMessageRegisterSponsor(sender, contract, make([]uint64, types.MaxSponsorNonces+1), deposit, policy),
+			errContains: "max of 20 nonces allowed",
+		},
+		{
+			name:        "invalid - bad deposit",
+			msg:         types.NewMessageRegisterSponsor(sender, contract, nil, sdk.Coins{sdk.Coin{Denom: "akii", Amount: math.NewInt(-1)}}, policy),
+			errContains: "amount is not positive",
+		},
+		{
+			name: "invalid - bad policy",
+			msg: types.NewMessageRegisterSponsor(sender, contract, nil, deposit,
+				types.NewSponsorPolicy(nil, []string{"contract"}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			),
+			errContains: "invalid allowed contract",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgSponsorFundsValidate tests the validate function for MsgFundSponsor and MsgWithdrawSponsorFunds
+func TestMsgSponsorFundsValidate(t *testing.T) {
+	// The sender and the contract
+	sender := authtypes.NewModuleAddress("sender").String()
+	contract := authtypes.NewModuleAddress("contract").String()
+	amount := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		sender      string
+		contract    string
+		amount      sdk.Coins
+		errContains string
+	}{
+		{
+			name:     "valid",
+			sender:   sender,
+			contract: contract,
+			amount:   amount,
+		},
+		{
+			name:        "invalid - empty sender",
+			contract:    contract,
+			amount:      amount,
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - empty contract",
+			sender:      sender,
+			amount:      amount,
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - zero amount",
+			sender:      sender,
+			contract:    contract,
+			amount:      sdk.Coins{},
+			errContains: "invalid amount",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Both messages share the same validation
+			for _, msg := range []interface{ Validate() error }{
+				types.NewMessageFundSponsor(tc.sender, tc.contract, tc.amount),
+				types.NewMessageWithdrawSponsorFunds(tc.sender, tc.contract, tc.amount),
+			} {
+				err := msg.Validate()
+				if tc.errContains == "" {
+					require.NoError(t, err)
+				} else {
+					require.ErrorContains(t, err, tc.errContains)
+				}
+			}
+		})
+	}
+}
+
+// TestMsgSweepERC20FeesValidate tests the Validate method of MsgSweepERC20Fees
+func TestMsgSweepERC20FeesValidate(t *testing.T) {
+	// The governance authority and the recipient
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	contract := "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSweepERC20Fees
+		errContains string
+	}{
+		{
+			name: "valid - sweep",
+			msg:  types.NewMessageSweepERC20Fees(authority, contract, recipient),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageSweepERC20Fees("", contract, recipient),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad recipient",
+			msg:         types.NewMessageSweepERC20Fees(authority, contract, "recipient"),
+			errContains: "decoding bech32 failed",
+		},
+		{
+			name:        "invalid - bad contract",
+			msg:         types.NewMessageSweepERC20Fees(authority, "0x1234", recipient),
+			errContains: "invalid ERC20 address",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgWithdrawRevenueValidate tests the Validate method of MsgWithdrawRevenue
+func TestMsgWithdrawRevenueValidate(t *testing.T) {
+	// The governance authority and the recipient
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgWithdrawRevenue
+		errContains string
+	}{
+		{
+			name: "valid - treasury",
+			msg:  types.NewMessageWithdrawRevenue(authority, types.RevenueAccountTreasury, recipient, amount),
+		},
+		{
+			name: "valid - reserve",
+			msg:  types.NewMessageWithdrawRevenue(authority, types.RevenueAccountReserve, recipient, amount),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageWithdrawRevenue("", types.RevenueAccountTreasury, recipient, amount),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad recipient",
+			msg:         types.NewMessageWithdrawRevenue(authority, types.RevenueAccountTreasury, "recipient", amount),
+			errContains: "decoding bech32 failed",
+		},
+		{
+			name:        "invalid - unknown account",
+			msg:         types.NewMessageWithdrawRevenue(authority, "fee_collector", recipient, amount),
+			errContains: "unknown revenue account: fee_collector",
+		},
+		{
+			name:        "invalid - empty amount",
+			msg:         types.NewMessageWithdrawRevenue(authority, types.RevenueAccountTreasury, recipient, sdk.NewCoins()),
+			errContains: "invalid amount",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
@@ -14,14 +14,23 @@ var (
 	DefaultClampFactor         = math.LegacyMustNewDecFromStr("0.10") // 10%
 	DefaultFallbackNativePrice = math.LegacyMustNewDecFromStr("0.01") // 0.01 USD
 	DefaultTwapLookbackWindow  = uint64(120)                          // 120 seconds (2 minutes)
+	DefaultReenableBlocks      = uint64(10)                           // 10 blocks
+	DefaultFeelessPriority     = int64(1_000_000)                     // Same as a tip of 1000 gwei per gas
+	DefaultMinTwapCoverage     = math.LegacyMustNewDecFromStr("0.5")  // 50% of the lookback window
+	DefaultStatsRetentionDays  = uint64(30)                           // 30 days
 )
 
+// MaxFeelessPriority is the max priority of the feeless txs, the same as a tip of 1,000,000 gwei per gas
+// Higher priorities would let the feeless txs outrank any fee paying tx
+const MaxFeelessPriority = int64(1_000_000_000)
+
 // NewParams returns a new params instance
 func NewParams(
 	nativeDenom, nativeOracleDenom string,
 	clampFactor, fallbackNativePrice math.LegacyDec,
 	twapLookbackWindow uint64,
 	enabled bool,
+	reenableBlocks uint64,
 ) Params {
 	return Params{
 		NativeDenom:         nativeDenom,
@@ -30,6 +39,8 @@ func NewParams(
 		Enabled:             enabled,
 		FallbackNativePrice: fallbackNativePrice,
 		TwapLookbackWindow:  twapLookbackWindow,
+		ReenableBlocks:      reenableBlocks,
+		MinTwapCoverage:     math.LegacyZeroDec(),
 	}
 }
 
@@ -42,6 +53,10 @@ func DefaultParams() Params {
 		FallbackNativePrice: DefaultFallbackNativePrice,
 		TwapLookbackWindow:  DefaultTwapLookbackWindow,
 		Enabled:             true,
+		ReenableBlocks:      DefaultReenableBlocks,
+		FeelessPriority:     DefaultFeelessPriority,
+		MinTwapCoverage:     DefaultMinTwapCoverage,
+		StatsRetentionDays:  DefaultStatsRetentionDays,
 	}
 }
 
@@ -72,6 +87,85 @@ func (p Params) Validate() error {
 		return errorsmod.Wrap(ErrInvalidParams, "twap lookback window must be greater than 0")
 	}
 
+	// Validate the reenable blocks
+	if p.ReenableBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "reenable blocks must be greater than 0")
+	}
+
+	// Validate the feeless priority
+	if p.FeelessPriority < 0 || p.FeelessPriority > MaxFeelessPriority {
+		return errorsmod.Wrapf(ErrInvalidParams, "feeless priority must be between 0 and %d", MaxFeelessPriority)
+	}
+
+	// Validate the min twap coverage, zero disables the check
+	if !p.MinTwapCoverage.IsNil() && (p.MinTwapCoverage.IsNegative() || p.MinTwapCoverage.GT(math.LegacyOneDec())) {
+		return errorsmod.Wrap(ErrInvalidParams, "min twap coverage must be between 0 and 1")
+	}
+
+	// Validate the stats retention, zero disables the statistics
+	if p.StatsRetentionDays > MaxStatsRetentionDays {
+		return errorsmod.Wrapf(ErrInvalidParams, "stats retention days must be at most %d", MaxStatsRetentionDays)
+	}
+
+	// Validate the revenue routes and check for duplicate denoms
+	routeSet := make(map[string]struct{})
+	for _, route := range p.RevenueRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if _, exists := routeSet[route.Denom]; exists {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate revenue route found: %s", route.Denom)
+		}
+		routeSet[route.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetRevenueDestination returns where the fees collected on the denom are routed
+// Denoms without a route stay on the fee collector
+func (p Params) GetRevenueDestination(denom string) RevenueDestination {
+	for _, route := range p.RevenueRoutes {
+		if route.Denom == denom {
+			return route.Destination
+		}
+	}
+
+	return RevenueDestinationFeeCollector
+}
+
+// HasTwapCoverage returns true if a TWAP with the lookback covers enough of the lookback window
+func (p Params) HasTwapCoverage(lookbackSeconds int64) bool {
+	// The check is disabled without a min coverage
+	if p.MinTwapCoverage.IsNil() || p.MinTwapCoverage.IsZero() || p.TwapLookbackWindow == 0 {
+		return true
+	}
+
+	// Calculate the covered ratio of the window
+	coverage := math.LegacyNewDec(lookbackSeconds).QuoInt64(int64(p.TwapLookbackWindow))
+	return coverage.GTE(p.MinTwapCoverage)
+}
+
+// NewRevenueRoute creates a new RevenueRoute instance
+func NewRevenueRoute(denom string, destination RevenueDestination) RevenueRoute {
+	return RevenueRoute{
+		Denom:       denom,
+		Destination: destination,
+	}
+}
+
+// Validate validates the revenue route
+func (r RevenueRoute) Validate() error {
+	// Validate the denom
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "revenue route denom is invalid: %s", err)
+	}
+
+	// Validate the destination
+	if _, ok := RevenueDestination_name[int32(r.Destination)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown revenue destination: %d", r.Destination)
+	}
+
 	return nil
 }
 
@@ -82,11 +176,14 @@ func NewFeeTokenMetadata(
 	price math.LegacyDec,
 ) FeeTokenMetadata {
 	return FeeTokenMetadata{
-		Denom:       denom,
-		OracleDenom: oracleDenom,
-		Decimals:    decimals,
-		Price:       price,
-		Enabled:     true,
+		Denom:           denom,
+		OracleDenom:     oracleDenom,
+		Decimals:        decimals,
+		Price:           price,
+		Enabled:         true,
+		PriceMultiplier: math.LegacyOneDec(),
+		MaxBlockVolume:  math.ZeroInt(),
+		MaxDailyVolume:  math.ZeroInt(),
 	}
 }
 
@@ -107,11 +204,51 @@ func (f FeeTokenMetadata) Validate() error {
 	}
 
 	// Validate the price, must be greater than 0
-	if f.Price.IsNegative() || f.Price.IsZero() {
+	// Suspended tokens have their price reset until the oracle recovers
+	if f.Price.IsNil() || f.Price.IsNegative() || (f.Price.IsZero() && !f.Suspended) {
 		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "price must be greater than 0")
 	}
 
-	return nil
+	// Validate the price multiplier, zero disables it
+	if !f.PriceMultiplier.IsNil() && f.PriceMultiplier.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "price multiplier can't be negative")
+	}
+
+	// Validate the volume caps, zero means no limit
+	if !f.MaxBlockVolume.IsNil() && f.MaxBlockVolume.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "max block volume can't be negative")
+	}
+	if !f.MaxDailyVolume.IsNil() && f.MaxDailyVolume.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "max daily volume can't be negative")
+	}
+
+	// Validate the ERC20 contract
+	return f.validateERC20()
+}
+
+// GetEffectivePriceMultiplier returns the multiplier applied over the token price
+// An unset or zero multiplier is the same as no multiplier
+func (f FeeTokenMetadata) GetEffectivePriceMultiplier() math.LegacyDec {
+	if f.PriceMultiplier.IsNil() || f.PriceMultiplier.IsZero() {
+		return math.LegacyOneDec()
+	}
+	return f.PriceMultiplier
+}
+
+// HasBlockVolumeCap returns true if the token has a per block volume cap
+func (f FeeTokenMetadata) HasBlockVolumeCap() bool {
+	return !f.MaxBlockVolume.IsNil() && f.MaxBlockVolume.IsPositive()
+}
+
+// HasDailyVolumeCap returns true if the token has a per day volume cap
+func (f FeeTokenMetadata) HasDailyVolumeCap() bool {
+	return !f.MaxDailyVolume.IsNil() && f.MaxDailyVolume.IsPositive()
+}
+
+// IsActive returns true if the token can be used to pay fees
+// The token must be enabled by governance and not suspended by the oracle checks
+func (f FeeTokenMetadata) IsActive() bool {
+	return f.Enabled && !f.Suspended
 }
 
 // NewFeeTokenMetadataCollection creates a new FeeTokenMetadataCollection
@@ -142,3 +279,15 @@ func (c *FeeTokenMetadataCollection) Validate() error {
 
 	return nil
 }
+
+// GetByDenom returns the fee token metadata for the given denom
+func (c *FeeTokenMetadataCollection) GetByDenom(denom string) (FeeTokenMetadata, bool) {
+	// Iterate over the items looking for the denom
+	for _, token := range c.Items {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+
+	return FeeTokenMetadata{}, false
+}
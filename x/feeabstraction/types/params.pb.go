@@ -24,6 +24,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevenueDestination defines where the fees collected on a fee token go
+type RevenueDestination int32
+
+const (
+	// REVENUE_DESTINATION_FEE_COLLECTOR keeps the fees on the fee collector to
+	// be distributed to the stakers
+	RevenueDestinationFeeCollector RevenueDestination = 0
+	// REVENUE_DESTINATION_COMMUNITY_POOL sends the fees to the community pool
+	RevenueDestinationCommunityPool RevenueDestination = 1
+	// REVENUE_DESTINATION_BURN burns the fees
+	RevenueDestinationBurn RevenueDestination = 2
+	// REVENUE_DESTINATION_TREASURY sends the fees to the treasury module account
+	RevenueDestinationTreasury RevenueDestination = 3
+)
+
+var RevenueDestination_name = map[int32]string{
+	0: "REVENUE_DESTINATION_FEE_COLLECTOR",
+	1: "REVENUE_DESTINATION_COMMUNITY_POOL",
+	2: "REVENUE_DESTINATION_BURN",
+	3: "REVENUE_DESTINATION_TREASURY",
+}
+
+var RevenueDestination_value = map[string]int32{
+	"REVENUE_DESTINATION_FEE_COLLECTOR":  0,
+	"REVENUE_DESTINATION_COMMUNITY_POOL": 1,
+	"REVENUE_DESTINATION_BURN":           2,
+	"REVENUE_DESTINATION_TREASURY":       3,
+}
+
+func (x RevenueDestination) String() string {
+	return proto.EnumName(RevenueDestination_name, int32(x))
+}
+
+func (RevenueDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{0}
+}
+
 // Params defines the parameters for the fee abstraction module
 type Params struct {
 	// Native denom
@@ -39,6 +76,28 @@ type Params struct {
 	// FallbackNativePrice is the fallback price for the native token if the
 	// oracle price is not available (in USD)
 	FallbackNativePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fallback_native_price,json=fallbackNativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fallback_native_price" yaml:"fallback_native_price"`
+	// ReenableBlocks is the number of consecutive blocks with a valid oracle
+	// price required to re-enable an auto-suspended fee token
+	ReenableBlocks uint64 `protobuf:"varint,7,opt,name=reenable_blocks,json=reenableBlocks,proto3" json:"reenable_blocks,omitempty"`
+	// RevenueRoutes defines where the fees collected on each fee token are
+	// routed, tokens without a route stay on the fee collector
+	RevenueRoutes []RevenueRoute `protobuf:"bytes,8,rep,name=revenue_routes,json=revenueRoutes,proto3" json:"revenue_routes"`
+	// SettlementInterval is the number of blocks between the settlements of the
+	// fee tokens routed to the fee collector against the native reserve
+	// Zero disables the settlement
+	SettlementInterval uint64 `protobuf:"varint,9,opt,name=settlement_interval,json=settlementInterval,proto3" json:"settlement_interval,omitempty"`
+	// FeelessPriority is the priority given to feeless txs, such as the first
+	// oracle vote of a validator on each vote period
+	FeelessPriority int64 `protobuf:"varint,10,opt,name=feeless_priority,json=feelessPriority,proto3" json:"feeless_priority,omitempty"`
+	// MinTwapCoverage is the min ratio of the TWAP lookback window that an oracle
+	// TWAP must cover to be used as a price, zero disables the check
+	MinTwapCoverage cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=min_twap_coverage,json=minTwapCoverage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_twap_coverage" yaml:"min_twap_coverage"`
+	// SuspendOnLowCoverage suspends the fee tokens whose TWAP covers less than
+	// the min coverage, otherwise they keep their previous price
+	SuspendOnLowCoverage bool `protobuf:"varint,12,opt,name=suspend_on_low_coverage,json=suspendOnLowCoverage,proto3" json:"suspend_on_low_coverage,omitempty"`
+	// StatsRetentionDays is the number of days the fee token usage statistics
+	// are kept, zero disables the statistics
+	StatsRetentionDays uint64 `protobuf:"varint,13,opt,name=stats_retention_days,json=statsRetentionDays,proto3" json:"stats_retention_days,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,6 +161,103 @@ func (m *Params) GetTwapLookbackWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetReenableBlocks() uint64 {
+	if m != nil {
+		return m.ReenableBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRevenueRoutes() []RevenueRoute {
+	if m != nil {
+		return m.RevenueRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetSettlementInterval() uint64 {
+	if m != nil {
+		return m.SettlementInterval
+	}
+	return 0
+}
+
+func (m *Params) GetFeelessPriority() int64 {
+	if m != nil {
+		return m.FeelessPriority
+	}
+	return 0
+}
+
+func (m *Params) GetSuspendOnLowCoverage() bool {
+	if m != nil {
+		return m.SuspendOnLowCoverage
+	}
+	return false
+}
+
+func (m *Params) GetStatsRetentionDays() uint64 {
+	if m != nil {
+		return m.StatsRetentionDays
+	}
+	return 0
+}
+
+// RevenueRoute defines the destination of the fees collected on a fee token
+type RevenueRoute struct {
+	// Denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Destination is where the fees are routed
+	Destination RevenueDestination `protobuf:"varint,2,opt,name=destination,proto3,enum=kiichain.feeabstraction.v1beta1.RevenueDestination" json:"destination,omitempty"`
+}
+
+func (m *RevenueRoute) Reset()         { *m = RevenueRoute{} }
+func (m *RevenueRoute) String() string { return proto.CompactTextString(m) }
+func (*RevenueRoute) ProtoMessage()    {}
+func (*RevenueRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{1}
+}
+func (m *RevenueRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevenueRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevenueRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevenueRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueRoute.Merge(m, src)
+}
+func (m *RevenueRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevenueRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevenueRoute proto.InternalMessageInfo
+
+func (m *RevenueRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RevenueRoute) GetDestination() RevenueDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return RevenueDestinationFeeCollector
+}
+
 // FeeTokenMetadata defines the metadata for a fee token
 type FeeTokenMetadata struct {
 	// Denom is the token denom
@@ -115,14 +271,36 @@ type FeeTokenMetadata struct {
 	// So, this equals to the token/native denom
 	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price" yaml:"price"`
 	// Enabled indicates if the token is enabled for fee abstraction
+	// This is controlled by governance
 	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Suspended indicates if the token was automatically suspended due to a
+	// missing oracle price
+	Suspended bool `protobuf:"varint,7,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// ValidPriceBlocks is the number of consecutive blocks with a valid oracle
+	// price while the token is suspended
+	ValidPriceBlocks uint64 `protobuf:"varint,8,opt,name=valid_price_blocks,json=validPriceBlocks,proto3" json:"valid_price_blocks,omitempty"`
+	// PriceMultiplier is applied over the oracle price when charging fees
+	// Values above one add a premium and values below one add a discount, zero
+	// disables the multiplier
+	PriceMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=price_multiplier,json=priceMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_multiplier" yaml:"price_multiplier"`
+	// MaxBlockVolume is the max amount of the token charged as fees per block
+	// Zero means no limit
+	MaxBlockVolume cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=max_block_volume,json=maxBlockVolume,proto3,customtype=cosmossdk.io/math.Int" json:"max_block_volume"`
+	// MaxDailyVolume is the max amount of the token charged as fees per day
+	// Zero means no limit
+	MaxDailyVolume cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=max_daily_volume,json=maxDailyVolume,proto3,customtype=cosmossdk.io/math.Int" json:"max_daily_volume"`
+	// ERC20Address is the contract of an ERC20 token without a registered token
+	// pair, the fees are collected from the ERC20 balance with an allowance or an
+	// EIP-2612 permit given to the module address
+	// Empty for tokens held on the bank module
+	Erc20Address string `protobuf:"bytes,12,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
 }
 
 func (m *FeeTokenMetadata) Reset()         { *m = FeeTokenMetadata{} }
 func (m *FeeTokenMetadata) String() string { return proto.CompactTextString(m) }
 func (*FeeTokenMetadata) ProtoMessage()    {}
 func (*FeeTokenMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c9ebe382042ec91, []int{1}
+	return fileDescriptor_4c9ebe382042ec91, []int{2}
 }
 func (m *FeeTokenMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,6 +357,86 @@ func (m *FeeTokenMetadata) GetEnabled() bool {
 	return false
 }
 
+func (m *FeeTokenMetadata) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *FeeTokenMetadata) GetValidPriceBlocks() uint64 {
+	if m != nil {
+		return m.ValidPriceBlocks
+	}
+	return 0
+}
+
+func (m *FeeTokenMetadata) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// FeeTokenUsage tracks the amount of a fee token charged as fees
+type FeeTokenUsage struct {
+	// BlockHeight is the height of the block tracked by the block volume
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// BlockVolume is the amount charged on the block
+	BlockVolume cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=block_volume,json=blockVolume,proto3,customtype=cosmossdk.io/math.Int" json:"block_volume"`
+	// Day is the day tracked by the daily volume, as days since the unix epoch
+	Day int64 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// DailyVolume is the amount charged on the day
+	DailyVolume cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=daily_volume,json=dailyVolume,proto3,customtype=cosmossdk.io/math.Int" json:"daily_volume"`
+}
+
+func (m *FeeTokenUsage) Reset()         { *m = FeeTokenUsage{} }
+func (m *FeeTokenUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenUsage) ProtoMessage()    {}
+func (*FeeTokenUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{3}
+}
+func (m *FeeTokenUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenUsage.Merge(m, src)
+}
+func (m *FeeTokenUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenUsage proto.InternalMessageInfo
+
+func (m *FeeTokenUsage) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FeeTokenUsage) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
 // Defines a collection of fee token metadata
 type FeeTokenMetadataCollection struct {
 	// Items is a repeated field of FeeTokenMetadata
@@ -189,7 +447,7 @@ func (m *FeeTokenMetadataCollection) Reset()         { *m = FeeTokenMetadataColl
 func (m *FeeTokenMetadataCollection) String() string { return proto.CompactTextString(m) }
 func (*FeeTokenMetadataCollection) ProtoMessage()    {}
 func (*FeeTokenMetadataCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c9ebe382042ec91, []int{2}
+	return fileDescriptor_4c9ebe382042ec91, []int{4}
 }
 func (m *FeeTokenMetadataCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,8 +484,11 @@ func (m *FeeTokenMetadataCollection) GetItems() []FeeTokenMetadata {
 }
 
 func init() {
+	proto.RegisterEnum("kiichain.feeabstraction.v1beta1.RevenueDestination", RevenueDestination_name, RevenueDestination_value)
 	proto.RegisterType((*Params)(nil), "kiichain.feeabstraction.v1beta1.Params")
+	proto.RegisterType((*RevenueRoute)(nil), "kiichain.feeabstraction.v1beta1.RevenueRoute")
 	proto.RegisterType((*FeeTokenMetadata)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenMetadata")
+	proto.RegisterType((*FeeTokenUsage)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenUsage")
 	proto.RegisterType((*FeeTokenMetadataCollection)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenMetadataCollection")
 }
 
@@ -236,38 +497,75 @@ func init() {
 }
 
 var fileDescriptor_4c9ebe382042ec91 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0x76, 0xdb, 0xba, 0x4e, 0x2b, 0xe8, 0xb4, 0x42, 0xa8, 0x92, 0xd6, 0x9c, 0x7a, 0x90,
-	0xc4, 0xda, 0xdb, 0x1e, 0xbb, 0xcb, 0x82, 0xb0, 0xd5, 0x25, 0x08, 0x82, 0x20, 0xe5, 0x65, 0x32,
-	0x6d, 0x87, 0xcc, 0x64, 0x42, 0x32, 0x6e, 0xed, 0xbf, 0xf0, 0x67, 0xed, 0xc1, 0xc3, 0x1e, 0xc5,
-	0x43, 0x91, 0xf6, 0x1f, 0xf8, 0x07, 0x94, 0xcc, 0xa4, 0xa5, 0x5b, 0x16, 0xdc, 0x5b, 0xde, 0xf7,
-	0x7d, 0xf9, 0x78, 0xdf, 0x7b, 0xf3, 0xd0, 0xeb, 0x98, 0x31, 0x32, 0x07, 0x96, 0xf8, 0x53, 0x4a,
-	0x21, 0xcc, 0x55, 0x06, 0x44, 0x31, 0x99, 0xf8, 0xd7, 0x83, 0x90, 0x2a, 0x18, 0xf8, 0x29, 0x64,
-	0x20, 0x72, 0x2f, 0xcd, 0xa4, 0x92, 0xb8, 0xbb, 0x55, 0x7b, 0x77, 0xd5, 0x5e, 0xa9, 0xee, 0xb4,
-	0x67, 0x72, 0x26, 0xb5, 0xd6, 0x2f, 0xbe, 0xcc, 0x6f, 0xee, 0xdf, 0x23, 0x54, 0xbf, 0xd2, 0x3e,
-	0xf8, 0x15, 0x6a, 0x26, 0xa0, 0xd8, 0x35, 0x9d, 0x44, 0x34, 0x91, 0xc2, 0xb6, 0x7a, 0x56, 0xff,
-	0x71, 0xd0, 0x30, 0xd8, 0x79, 0x01, 0x61, 0x0f, 0xb5, 0x4a, 0x89, 0xcc, 0x80, 0xf0, 0xad, 0xf2,
-	0x48, 0x2b, 0x9f, 0x19, 0xea, 0x83, 0x66, 0x8c, 0xde, 0x46, 0x8f, 0x68, 0x02, 0x21, 0xa7, 0x91,
-	0x7d, 0xdc, 0xb3, 0xfa, 0x27, 0xc1, 0xb6, 0xc4, 0x5f, 0x50, 0x93, 0x70, 0x10, 0xe9, 0x64, 0x0a,
-	0x44, 0xc9, 0xcc, 0xae, 0x16, 0x16, 0xa3, 0xd3, 0x9b, 0x55, 0xb7, 0xf2, 0x6b, 0xd5, 0x7d, 0x41,
-	0x64, 0x2e, 0x64, 0x9e, 0x47, 0xb1, 0xc7, 0xa4, 0x2f, 0x40, 0xcd, 0xbd, 0x4b, 0x3a, 0x03, 0xb2,
-	0x3c, 0xa7, 0xe4, 0xcf, 0xaa, 0xdb, 0x5a, 0x82, 0xe0, 0xa7, 0xee, 0xbe, 0x81, 0x1b, 0x34, 0x74,
-	0x79, 0xa1, 0x2b, 0xfc, 0x06, 0xb5, 0xd5, 0x02, 0xd2, 0x09, 0x97, 0x32, 0x0e, 0x81, 0xc4, 0x93,
-	0x05, 0x4b, 0x22, 0xb9, 0xb0, 0x6b, 0x3d, 0xab, 0x5f, 0x0d, 0x70, 0xc1, 0x5d, 0x96, 0xd4, 0x27,
-	0xcd, 0xe0, 0x05, 0x7a, 0x3e, 0x05, 0xce, 0xb5, 0xb8, 0xcc, 0x98, 0x66, 0x8c, 0x50, 0xbb, 0xae,
-	0x3b, 0x3b, 0x7b, 0x58, 0x67, 0x2f, 0x4d, 0x67, 0xf7, 0x3a, 0xb9, 0x41, 0x6b, 0x8b, 0xbf, 0xd7,
-	0xf0, 0x95, 0x46, 0x7f, 0x58, 0xe8, 0xe9, 0x05, 0xa5, 0x1f, 0x65, 0x4c, 0x93, 0x31, 0x55, 0x10,
-	0x81, 0x02, 0xdc, 0x46, 0xb5, 0xfd, 0x25, 0x98, 0xa2, 0xd8, 0xd0, 0x3d, 0x73, 0x6f, 0xc8, 0xbd,
-	0x89, 0x77, 0xd0, 0x49, 0x44, 0x09, 0x13, 0xc0, 0x73, 0x3d, 0xf2, 0x27, 0xc1, 0xae, 0xc6, 0xef,
-	0x50, 0xcd, 0x44, 0x32, 0xc3, 0x1e, 0x3e, 0x2c, 0x52, 0xd3, 0x44, 0x2a, 0x23, 0x18, 0x87, 0xfd,
-	0xc5, 0xd6, 0xef, 0x2c, 0xd6, 0x8d, 0x51, 0xe7, 0x30, 0xcd, 0x99, 0xe4, 0x9c, 0xea, 0xc7, 0x88,
-	0xc7, 0xa8, 0xc6, 0x14, 0x15, 0xb9, 0x6d, 0xf5, 0x8e, 0xfb, 0x8d, 0xb7, 0x03, 0xef, 0x3f, 0xaf,
-	0xd6, 0x3b, 0xf4, 0x1a, 0x55, 0x8b, 0xae, 0x03, 0xe3, 0x32, 0x1a, 0xdf, 0xac, 0x1d, 0xeb, 0x76,
-	0xed, 0x58, 0xbf, 0xd7, 0x8e, 0xf5, 0x7d, 0xe3, 0x54, 0x6e, 0x37, 0x4e, 0xe5, 0xe7, 0xc6, 0xa9,
-	0x7c, 0x1e, 0xce, 0x98, 0x9a, 0x7f, 0x0d, 0x3d, 0x22, 0x85, 0xbf, 0xbb, 0xa3, 0xdd, 0xc7, 0xb7,
-	0xc3, 0x93, 0x52, 0xcb, 0x94, 0xe6, 0x61, 0x5d, 0xdf, 0xc4, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x5c, 0xee, 0xa2, 0x85, 0x7a, 0x03, 0x00, 0x00,
+	// 1085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x4e, 0x23, 0x47,
+	0x17, 0xb5, 0xb1, 0x61, 0x70, 0xd9, 0x80, 0xa7, 0x60, 0xbe, 0x69, 0xf9, 0x23, 0xc6, 0xf4, 0x2c,
+	0x42, 0xa2, 0x89, 0xcd, 0x8f, 0x22, 0x45, 0xb3, 0x48, 0x06, 0xff, 0x90, 0x58, 0xf1, 0x0f, 0xaa,
+	0xb1, 0x27, 0x9a, 0x91, 0xa2, 0x56, 0xb9, 0xfb, 0x62, 0x4a, 0xee, 0xee, 0xb2, 0xba, 0xca, 0x36,
+	0x56, 0x5e, 0x20, 0x62, 0x95, 0x17, 0x60, 0x95, 0x7d, 0x96, 0x59, 0xe4, 0x09, 0x58, 0xce, 0x32,
+	0xca, 0x02, 0x45, 0xf0, 0x06, 0x79, 0x82, 0xa8, 0xab, 0xdb, 0x60, 0x30, 0xd1, 0x78, 0xd7, 0x75,
+	0xee, 0xbd, 0xa7, 0x4f, 0xdd, 0x7b, 0x4f, 0xab, 0xd1, 0xcb, 0x1e, 0x63, 0xe6, 0x29, 0x65, 0x6e,
+	0xe1, 0x04, 0x80, 0x76, 0x84, 0xf4, 0xa8, 0x29, 0x19, 0x77, 0x0b, 0xc3, 0xbd, 0x0e, 0x48, 0xba,
+	0x57, 0xe8, 0x53, 0x8f, 0x3a, 0x22, 0xdf, 0xf7, 0xb8, 0xe4, 0x78, 0x6b, 0x92, 0x9d, 0xbf, 0x9f,
+	0x9d, 0x0f, 0xb3, 0x33, 0x1b, 0x5d, 0xde, 0xe5, 0x2a, 0xb7, 0xe0, 0x3f, 0x05, 0x65, 0xfa, 0xef,
+	0x4b, 0x68, 0xe9, 0x58, 0xf1, 0xe0, 0x6d, 0x94, 0x72, 0xa9, 0x64, 0x43, 0x30, 0x2c, 0x70, 0xb9,
+	0xa3, 0x45, 0x73, 0xd1, 0x9d, 0x04, 0x49, 0x06, 0x58, 0xd9, 0x87, 0x70, 0x1e, 0xad, 0x87, 0x29,
+	0xdc, 0xa3, 0xa6, 0x3d, 0xc9, 0x5c, 0x50, 0x99, 0x4f, 0x83, 0x50, 0x53, 0x45, 0x82, 0x7c, 0x0d,
+	0x3d, 0x01, 0x97, 0x76, 0x6c, 0xb0, 0xb4, 0x58, 0x2e, 0xba, 0xb3, 0x4c, 0x26, 0x47, 0xfc, 0x23,
+	0x4a, 0x99, 0x36, 0x75, 0xfa, 0xc6, 0x09, 0x35, 0x25, 0xf7, 0xb4, 0xb8, 0x4f, 0x51, 0x7c, 0x75,
+	0x79, 0xb5, 0x15, 0xf9, 0xeb, 0x6a, 0xeb, 0xff, 0x26, 0x17, 0x0e, 0x17, 0xc2, 0xea, 0xe5, 0x19,
+	0x2f, 0x38, 0x54, 0x9e, 0xe6, 0x6b, 0xd0, 0xa5, 0xe6, 0xb8, 0x0c, 0xe6, 0x3f, 0x57, 0x5b, 0xeb,
+	0x63, 0xea, 0xd8, 0xaf, 0xf4, 0x69, 0x02, 0x9d, 0x24, 0xd5, 0xf1, 0x48, 0x9d, 0xf0, 0x2e, 0xda,
+	0x90, 0x23, 0xda, 0x37, 0x6c, 0xce, 0x7b, 0x1d, 0x6a, 0xf6, 0x8c, 0x11, 0x73, 0x2d, 0x3e, 0xd2,
+	0x16, 0x73, 0xd1, 0x9d, 0x38, 0xc1, 0x7e, 0xac, 0x16, 0x86, 0x7e, 0x50, 0x11, 0x3c, 0x42, 0xcf,
+	0x4e, 0xa8, 0x6d, 0xab, 0xe4, 0xf0, 0x8e, 0x7d, 0x8f, 0x99, 0xa0, 0x2d, 0x29, 0x65, 0xa5, 0xf9,
+	0x94, 0x6d, 0x06, 0xca, 0x1e, 0x65, 0xd2, 0xc9, 0xfa, 0x04, 0x6f, 0x28, 0xf8, 0xd8, 0x47, 0xf1,
+	0xa7, 0x68, 0xcd, 0x83, 0xa0, 0x2d, 0x46, 0xc7, 0xe6, 0x66, 0x4f, 0x68, 0x4f, 0x94, 0xca, 0xd5,
+	0x09, 0x5c, 0x54, 0x28, 0x7e, 0x8f, 0x56, 0x3d, 0x18, 0x82, 0x3b, 0x00, 0xc3, 0xe3, 0x03, 0x09,
+	0x42, 0x5b, 0xce, 0xc5, 0x76, 0x92, 0xfb, 0x5f, 0xe4, 0x3f, 0x32, 0xfa, 0x3c, 0x09, 0xca, 0x88,
+	0x5f, 0x55, 0x8c, 0xfb, 0x37, 0x21, 0x2b, 0xde, 0x14, 0x26, 0x70, 0x01, 0xad, 0x0b, 0x90, 0xd2,
+	0x06, 0x07, 0x5c, 0x69, 0x30, 0x57, 0x82, 0x37, 0xa4, 0xb6, 0x96, 0x08, 0xda, 0x75, 0x17, 0xaa,
+	0x86, 0x11, 0xfc, 0x19, 0x4a, 0x9f, 0x00, 0xd8, 0x20, 0x84, 0x7f, 0x39, 0xee, 0x31, 0x39, 0xd6,
+	0x50, 0x2e, 0xba, 0x13, 0x23, 0x6b, 0x21, 0x7e, 0x1c, 0xc2, 0xb8, 0x87, 0x9e, 0x3a, 0xcc, 0x35,
+	0xd4, 0x3c, 0x4c, 0x3e, 0x04, 0x8f, 0x76, 0x41, 0x4b, 0xaa, 0xae, 0x7e, 0x33, 0x5f, 0x57, 0xb5,
+	0xa0, 0xab, 0x33, 0x2c, 0x3a, 0x59, 0x73, 0x98, 0xdb, 0x1a, 0xd1, 0x7e, 0x29, 0x44, 0xf0, 0x97,
+	0xe8, 0xb9, 0x18, 0x88, 0x3e, 0xb8, 0x96, 0xc1, 0x5d, 0xc3, 0xe6, 0xa3, 0xbb, 0x57, 0xa6, 0xd4,
+	0x06, 0x6e, 0x84, 0xe1, 0xa6, 0x5b, 0xe3, 0xa3, 0xdb, 0xb2, 0x5d, 0xb4, 0x21, 0x24, 0x95, 0xc2,
+	0xf0, 0x40, 0x82, 0xeb, 0x37, 0xcf, 0xb0, 0xe8, 0x58, 0x68, 0x2b, 0x61, 0x03, 0xfc, 0x18, 0x99,
+	0x84, 0xca, 0x74, 0x2c, 0xf4, 0x9f, 0x50, 0x6a, 0xba, 0xad, 0x78, 0x03, 0x2d, 0x4e, 0xdb, 0x26,
+	0x38, 0xe0, 0x36, 0x4a, 0x5a, 0x20, 0x24, 0xf3, 0xf7, 0x80, 0xbb, 0xca, 0x28, 0xab, 0xfb, 0x07,
+	0xf3, 0x0e, 0xac, 0x7c, 0x57, 0x4a, 0xa6, 0x79, 0xf4, 0x3f, 0xe2, 0x28, 0x7d, 0x04, 0xd0, 0xe2,
+	0x3d, 0x70, 0xeb, 0x20, 0xa9, 0x45, 0x25, 0xfd, 0x0f, 0x05, 0xdb, 0x28, 0xf5, 0x88, 0x57, 0x93,
+	0x7c, 0xca, 0xa5, 0x19, 0xb4, 0x6c, 0x81, 0xc9, 0x1c, 0x6a, 0x0b, 0x65, 0xd3, 0x15, 0x72, 0x7b,
+	0xc6, 0x55, 0xb4, 0x18, 0xd8, 0x20, 0x30, 0xe8, 0xc1, 0x7c, 0x03, 0x4b, 0x05, 0x03, 0x0b, 0xd7,
+	0x3e, 0x60, 0x98, 0xfe, 0x18, 0x2c, 0xdd, 0xff, 0x18, 0x6c, 0xa2, 0x44, 0x38, 0x15, 0xb0, 0xd4,
+	0xf2, 0x2f, 0x93, 0x3b, 0x00, 0xbf, 0x44, 0x78, 0x48, 0x6d, 0x66, 0x05, 0x2e, 0x9a, 0x78, 0x64,
+	0x59, 0x4d, 0x26, 0xad, 0x22, 0xca, 0x48, 0xa1, 0x4b, 0x18, 0x4a, 0x07, 0x79, 0xce, 0xc0, 0x96,
+	0xac, 0x6f, 0x33, 0xf0, 0xd4, 0x1a, 0x27, 0x8a, 0x5f, 0xcf, 0xa7, 0xfd, 0xf9, 0x94, 0xf6, 0x29,
+	0x12, 0x9d, 0xac, 0x29, 0xa8, 0x7e, 0x8b, 0xe0, 0x6f, 0x51, 0xda, 0xa1, 0x67, 0x81, 0x20, 0x63,
+	0xc8, 0xed, 0x81, 0x03, 0xca, 0x03, 0x89, 0xe2, 0x27, 0xe1, 0xab, 0x9e, 0xcd, 0xbe, 0xaa, 0xea,
+	0x4a, 0xb2, 0xea, 0xd0, 0x33, 0x25, 0xf7, 0xad, 0x2a, 0x9a, 0x10, 0x59, 0x94, 0xd9, 0xe3, 0x09,
+	0x51, 0x72, 0x5e, 0xa2, 0xb2, 0x5f, 0x15, 0x12, 0xbd, 0x40, 0x2b, 0xe0, 0x99, 0xfb, 0xbb, 0x06,
+	0xb5, 0x2c, 0x0f, 0x84, 0x50, 0x3b, 0x9f, 0x20, 0x29, 0x05, 0x1e, 0x06, 0x98, 0x7e, 0x19, 0x45,
+	0x2b, 0x93, 0xe5, 0x69, 0x0b, 0x7f, 0xfb, 0xb7, 0x51, 0x2a, 0xb8, 0xc4, 0x29, 0xb0, 0xee, 0xa9,
+	0x54, 0x0b, 0x14, 0x23, 0x49, 0x85, 0x7d, 0xa7, 0x20, 0xfc, 0x7a, 0x92, 0x12, 0xca, 0x5b, 0x98,
+	0x47, 0x5e, 0xc0, 0x10, 0x6a, 0x4b, 0xa3, 0x98, 0x45, 0xc7, 0x6a, 0xc1, 0x62, 0xc4, 0x7f, 0xf4,
+	0x39, 0xef, 0x5d, 0x39, 0x3e, 0x17, 0xa7, 0x75, 0x77, 0x5f, 0xbd, 0x87, 0x32, 0x0f, 0x6d, 0x50,
+	0xe2, 0xb6, 0x0d, 0xca, 0x4d, 0xb8, 0x8e, 0x16, 0x99, 0x04, 0x47, 0x68, 0x51, 0xf5, 0x9d, 0xdc,
+	0xfb, 0xa8, 0xed, 0x1e, 0x72, 0x85, 0xdf, 0xca, 0x80, 0xe5, 0xf3, 0xdf, 0x16, 0x10, 0x9e, 0x35,
+	0x26, 0xae, 0xa2, 0x6d, 0x52, 0x79, 0x5b, 0x69, 0xb4, 0x2b, 0x46, 0xb9, 0xf2, 0xa6, 0x55, 0x6d,
+	0x1c, 0xb6, 0xaa, 0xcd, 0x86, 0x71, 0x54, 0xa9, 0x18, 0xa5, 0x66, 0xad, 0x56, 0x29, 0xb5, 0x9a,
+	0x24, 0x1d, 0xc9, 0xe8, 0xe7, 0x17, 0xb9, 0xec, 0x6c, 0xf9, 0x11, 0x40, 0xa8, 0x98, 0x7b, 0xf8,
+	0x7b, 0xa4, 0x3f, 0x46, 0x55, 0x6a, 0xd6, 0xeb, 0xed, 0x46, 0xb5, 0xf5, 0xce, 0x38, 0x6e, 0x36,
+	0x6b, 0xe9, 0x68, 0xe6, 0xc5, 0xf9, 0x45, 0x6e, 0x6b, 0x96, 0xab, 0xc4, 0x1d, 0x67, 0xe0, 0x32,
+	0x39, 0x3e, 0xe6, 0xdc, 0xc6, 0x5f, 0x21, 0xed, 0x31, 0xb2, 0x62, 0x9b, 0x34, 0xd2, 0x0b, 0x99,
+	0xcc, 0xf9, 0x45, 0xee, 0x7f, 0xb3, 0x14, 0xc5, 0x81, 0xe7, 0xe2, 0xd7, 0x68, 0xf3, 0xb1, 0xca,
+	0x16, 0xa9, 0x1c, 0xbe, 0x69, 0x93, 0x77, 0xe9, 0x58, 0x26, 0x7b, 0x7e, 0x91, 0xcb, 0xcc, 0x56,
+	0xb7, 0x3c, 0xa0, 0x62, 0xe0, 0x8d, 0x33, 0xf1, 0x9f, 0x7f, 0xcd, 0x46, 0x8a, 0xf5, 0xcb, 0xeb,
+	0x6c, 0xf4, 0xc3, 0x75, 0x36, 0xfa, 0xf7, 0x75, 0x36, 0xfa, 0xcb, 0x4d, 0x36, 0xf2, 0xe1, 0x26,
+	0x1b, 0xf9, 0xf3, 0x26, 0x1b, 0x79, 0x7f, 0xd0, 0x65, 0xf2, 0x74, 0xd0, 0xc9, 0x9b, 0xdc, 0x29,
+	0xdc, 0xfe, 0xe5, 0xdc, 0x3e, 0x9c, 0x3d, 0xfc, 0xe1, 0x91, 0xe3, 0x3e, 0x88, 0xce, 0x92, 0xfa,
+	0x63, 0x39, 0xf8, 0x77, 0x00, 0x7e, 0x99, 0x01, 0x0e, 0x18, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -290,6 +588,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StatsRetentionDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StatsRetentionDays))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SuspendOnLowCoverage {
+		i--
+		if m.SuspendOnLowCoverage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.MinTwapCoverage.Size()
+		i -= size
+		if _, err := m.MinTwapCoverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.FeelessPriority != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeelessPriority))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SettlementInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettlementInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RevenueRoutes) > 0 {
+		for iNdEx := len(m.RevenueRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ReenableBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReenableBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
 	{
 		size := m.FallbackNativePrice.Size()
 		i -= size
@@ -342,6 +694,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevenueRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevenueRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevenueRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FeeTokenMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -362,6 +749,58 @@ func (m *FeeTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size := m.MaxDailyVolume.Size()
+		i -= size
+		if _, err := m.MaxDailyVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxBlockVolume.Size()
+		i -= size
+		if _, err := m.MaxBlockVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PriceMultiplier.Size()
+		i -= size
+		if _, err := m.PriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ValidPriceBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidPriceBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -404,7 +843,7 @@ func (m *FeeTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeTokenMetadataCollection) Marshal() (dAtA []byte, err error) {
+func (m *FeeTokenUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -414,39 +853,92 @@ func (m *FeeTokenMetadataCollection) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeTokenMetadataCollection) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeTokenUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeTokenMetadataCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeTokenUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size := m.DailyVolume.Size()
+		i -= size
+		if _, err := m.DailyVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Day != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BlockVolume.Size()
+		i -= size
+		if _, err := m.BlockVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *FeeTokenMetadataCollection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenMetadataCollection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenMetadataCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -476,6 +968,45 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.FallbackNativePrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.ReenableBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ReenableBlocks))
+	}
+	if len(m.RevenueRoutes) > 0 {
+		for _, e := range m.RevenueRoutes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SettlementInterval != 0 {
+		n += 1 + sovParams(uint64(m.SettlementInterval))
+	}
+	if m.FeelessPriority != 0 {
+		n += 1 + sovParams(uint64(m.FeelessPriority))
+	}
+	l = m.MinTwapCoverage.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SuspendOnLowCoverage {
+		n += 2
+	}
+	if m.StatsRetentionDays != 0 {
+		n += 1 + sovParams(uint64(m.StatsRetentionDays))
+	}
+	return n
+}
+
+func (m *RevenueRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovParams(uint64(m.Destination))
+	}
 	return n
 }
 
@@ -501,6 +1032,41 @@ func (m *FeeTokenMetadata) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.ValidPriceBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ValidPriceBlocks))
+	}
+	l = m.PriceMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBlockVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxDailyVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeTokenUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovParams(uint64(m.BlockHeight))
+	}
+	l = m.BlockVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Day != 0 {
+		n += 1 + sovParams(uint64(m.Day))
+	}
+	l = m.DailyVolume.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -725,61 +1291,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReenableBlocks", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReenableBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReenableBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeeTokenMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -789,29 +1324,31 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.RevenueRoutes = append(m.RevenueRoutes, RevenueRoute{})
+			if err := m.RevenueRoutes[len(m.RevenueRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementInterval", wireType)
 			}
-			var stringLen uint64
+			m.SettlementInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -821,29 +1358,16 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SettlementInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OracleDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeelessPriority", wireType)
 			}
-			m.Decimals = 0
+			m.FeelessPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -853,14 +1377,14 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.FeelessPriority |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTwapCoverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -888,13 +1412,13 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinTwapCoverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendOnLowCoverage", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -911,7 +1435,643 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			m.SuspendOnLowCoverage = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsRetentionDays", wireType)
+			}
+			m.StatsRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsRetentionDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevenueRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevenueRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevenueRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= RevenueDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidPriceBlocks", wireType)
+			}
+			m.ValidPriceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidPriceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlockVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDailyVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDailyVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
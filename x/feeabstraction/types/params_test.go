@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	stdmath "math"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
@@ -31,6 +33,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 		},
 		{
@@ -42,6 +45,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "native denom is invalid",
 		},
@@ -54,6 +58,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "native denom is invalid",
 		},
@@ -66,6 +71,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "native oracle denom is invalid",
 		},
@@ -78,6 +84,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "native oracle denom is invalid",
 		},
@@ -90,6 +97,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "clamp factor must be between 0 and 1",
 		},
@@ -102,6 +110,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice,
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "clamp factor must be between 0 and 1",
 		},
@@ -114,6 +123,7 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice.Neg(), // Negative value
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "fallback native price must be greater than 0",
 		},
@@ -126,6 +136,7 @@ func TestValidateParams(t *testing.T) {
 				math.LegacyZeroDec(), // Zero value
 				types.DefaultTwapLookbackWindow,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "fallback native price must be greater than 0",
 		},
@@ -138,9 +149,71 @@ func TestValidateParams(t *testing.T) {
 				types.DefaultFallbackNativePrice, // Negative value
 				0,
 				true,
+				types.DefaultReenableBlocks,
 			),
 			errContains: "twap lookback window must be greater than 0",
 		},
+		{
+			name: "invalid - reenable blocks zero",
+			params: types.NewParams(
+				"coin",
+				"oraclecoin",
+				types.DefaultClampFactor,
+				types.DefaultFallbackNativePrice,
+				types.DefaultTwapLookbackWindow,
+				true,
+				0,
+			),
+			errContains: "reenable blocks must be greater than 0",
+		},
+		{
+			name:   "valid - revenue routes",
+			params: withRevenueRoutes(types.NewRevenueRoute("uatom", types.RevenueDestinationBurn), types.NewRevenueRoute("uosmo", types.RevenueDestinationTreasury)),
+		},
+		{
+			name:        "invalid - revenue route with invalid denom",
+			params:      withRevenueRoutes(types.NewRevenueRoute("", types.RevenueDestinationBurn)),
+			errContains: "revenue route denom is invalid",
+		},
+		{
+			name:        "invalid - unknown revenue destination",
+			params:      withRevenueRoutes(types.NewRevenueRoute("uatom", types.RevenueDestination(10))),
+			errContains: "unknown revenue destination: 10",
+		},
+		{
+			name:        "invalid - duplicate revenue route",
+			params:      withRevenueRoutes(types.NewRevenueRoute("uatom", types.RevenueDestinationBurn), types.NewRevenueRoute("uatom", types.RevenueDestinationTreasury)),
+			errContains: "duplicate revenue route found: uatom",
+		},
+		{
+			name:        "invalid - min twap coverage above one",
+			params:      withMinTwapCoverage(math.LegacyMustNewDecFromStr("1.1")),
+			errContains: "min twap coverage must be between 0 and 1",
+		},
+		{
+			name:        "invalid - negative min twap coverage",
+			params:      withMinTwapCoverage(math.LegacyMustNewDecFromStr("-0.1")),
+			errContains: "min twap coverage must be between 0 and 1",
+		},
+		{
+			name:   "valid - max feeless priority",
+			params: withFeelessPriority(types.MaxFeelessPriority),
+		},
+		{
+			name:        "invalid - negative feeless priority",
+			params:      withFeelessPriority(-1),
+			errContains: "feeless priority must be between 0 and 1000000000",
+		},
+		{
+			name:        "invalid - feeless priority over the max",
+			params:      withFeelessPriority(types.MaxFeelessPriority + 1),
+			errContains: "feeless priority must be between 0 and 1000000000",
+		},
+		{
+			name:        "invalid - max int feeless priority",
+			params:      withFeelessPriority(stdmath.MaxInt64),
+			errContains: "feeless priority must be between 0 and 1000000000",
+		},
 	}
 
 	// Iterate through the test cases
@@ -159,6 +232,50 @@ func TestValidateParams(t *testing.T) {
 	}
 }
 
+// TestGetRevenueDestination tests the GetRevenueDestination method of Params
+func TestGetRevenueDestination(t *testing.T) {
+	params := withRevenueRoutes(types.NewRevenueRoute("uatom", types.RevenueDestinationCommunityPool))
+
+	// Routed denoms use their destination and the others stay on the fee collector
+	require.Equal(t, types.RevenueDestinationCommunityPool, params.GetRevenueDestination("uatom"))
+	require.Equal(t, types.RevenueDestinationFeeCollector, params.GetRevenueDestination("uosmo"))
+}
+
+// withRevenueRoutes returns the default params with the given revenue routes
+func withRevenueRoutes(routes ...types.RevenueRoute) types.Params {
+	params := types.DefaultParams()
+	params.RevenueRoutes = routes
+	return params
+}
+
+// TestHasTwapCoverage tests the HasTwapCoverage method of Params
+func TestHasTwapCoverage(t *testing.T) {
+	// The default window is 120 seconds and the min coverage is 50%
+	params := types.DefaultParams()
+	require.True(t, params.HasTwapCoverage(120))
+	require.True(t, params.HasTwapCoverage(60))
+	require.False(t, params.HasTwapCoverage(59))
+	require.False(t, params.HasTwapCoverage(0))
+
+	// Zero coverage disables the check
+	params = withMinTwapCoverage(math.LegacyZeroDec())
+	require.True(t, params.HasTwapCoverage(0))
+}
+
+// withMinTwapCoverage returns the default params with the given min twap coverage
+func withMinTwapCoverage(coverage math.LegacyDec) types.Params {
+	params := types.DefaultParams()
+	params.MinTwapCoverage = coverage
+	return params
+}
+
+// withFeelessPriority returns the default params with the given feeless priority
+func withFeelessPriority(priority int64) types.Params {
+	params := types.DefaultParams()
+	params.FeelessPriority = priority
+	return params
+}
+
 // TestFeeTokenMetadataValidate tests the Validate method of FeeTokenMetadata
 func TestFeeTokenMetadataValidate(t *testing.T) {
 	// Prepare test cases
@@ -211,6 +328,71 @@ func TestFeeTokenMetadataValidate(t *testing.T) {
 			metadata:    types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(0)),
 			errContains: "price must be greater than 0",
 		},
+		{
+			name: "invalid - negative price multiplier",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.PriceMultiplier = math.LegacyNewDec(-1)
+				return metadata
+			}(),
+			errContains: "price multiplier can't be negative",
+		},
+		{
+			name: "invalid - negative max block volume",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.MaxBlockVolume = math.NewInt(-1)
+				return metadata
+			}(),
+			errContains: "max block volume can't be negative",
+		},
+		{
+			name: "invalid - negative max daily volume",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.MaxDailyVolume = math.NewInt(-1)
+				return metadata
+			}(),
+			errContains: "max daily volume can't be negative",
+		},
+		{
+			name: "valid - zero price on a suspended token",
+			metadata: types.FeeTokenMetadata{
+				Denom:       "coin",
+				OracleDenom: "oraclecoin",
+				Decimals:    6,
+				Price:       math.LegacyZeroDec(),
+				Enabled:     true,
+				Suspended:   true,
+			},
+		},
+		{
+			name: "valid - ERC20 fee token",
+			metadata: func() types.FeeTokenMetadata {
+				contract := common.HexToAddress("0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd")
+				metadata := types.NewFeeTokenMetadata(types.GetERC20FeeTokenDenom(contract), "oraclecoin", 18, math.LegacyNewDec(100))
+				metadata.Erc20Address = contract.Hex()
+				return metadata
+			}(),
+		},
+		{
+			name: "invalid - invalid ERC20 address",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("erc20:0x1234", "oraclecoin", 18, math.LegacyNewDec(100))
+				metadata.Erc20Address = "0x1234"
+				return metadata
+			}(),
+			errContains: "invalid ERC20 address: 0x1234",
+		},
+		{
+			name: "invalid - ERC20 fee token with another denom",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 18, math.LegacyNewDec(100))
+				metadata.Erc20Address = "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"
+				return metadata
+			}(),
+			errContains: "denom of the ERC20 fee token must be erc20:0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+		},
 	}
 
 	// Iterate through the test cases
@@ -288,3 +470,55 @@ func TestFeeTokenMetadataCollectionValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestFeeTokenMetadataIsActive tests the IsActive method of FeeTokenMetadata
+func TestFeeTokenMetadataIsActive(t *testing.T) {
+	// Prepare test cases
+	testCases := []struct {
+		name      string
+		enabled   bool
+		suspended bool
+		expected  bool
+	}{
+		{name: "enabled and not suspended", enabled: true, suspended: false, expected: true},
+		{name: "enabled and suspended", enabled: true, suspended: true, expected: false},
+		{name: "disabled and not suspended", enabled: false, suspended: false, expected: false},
+		{name: "disabled and suspended", enabled: false, suspended: true, expected: false},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+			metadata.Enabled = tc.enabled
+			metadata.Suspended = tc.suspended
+
+			require.Equal(t, tc.expected, metadata.IsActive())
+		})
+	}
+}
+
+// TestFeeTokenMetadataGetEffectivePriceMultiplier tests the GetEffectivePriceMultiplier method of FeeTokenMetadata
+func TestFeeTokenMetadataGetEffectivePriceMultiplier(t *testing.T) {
+	// Prepare test cases
+	testCases := []struct {
+		name       string
+		multiplier math.LegacyDec
+		expected   math.LegacyDec
+	}{
+		{name: "unset multiplier", multiplier: math.LegacyDec{}, expected: math.LegacyOneDec()},
+		{name: "zero multiplier", multiplier: math.LegacyZeroDec(), expected: math.LegacyOneDec()},
+		{name: "premium", multiplier: math.LegacyMustNewDecFromStr("1.1"), expected: math.LegacyMustNewDecFromStr("1.1")},
+		{name: "discount", multiplier: math.LegacyMustNewDecFromStr("0.9"), expected: math.LegacyMustNewDecFromStr("0.9")},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+			metadata.PriceMultiplier = tc.multiplier
+
+			require.Equal(t, tc.expected, metadata.GetEffectivePriceMultiplier())
+		})
+	}
+}
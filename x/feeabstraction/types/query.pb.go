@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -197,339 +199,3744 @@ func (m *QueryFeeTokensResponse) GetFeeTokens() *FeeTokenMetadataCollection {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryFeeTokensRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensRequest")
-	proto.RegisterType((*QueryFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensResponse")
+// QueryPreferredFeeTokenRequest is the request type for the
+// Query/PreferredFeeToken RPC method
+type QueryPreferredFeeTokenRequest struct {
+	// address is the account address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kiichain/feeabstraction/v1beta1/query.proto", fileDescriptor_88edc16f4ff36bc7)
+func (m *QueryPreferredFeeTokenRequest) Reset()         { *m = QueryPreferredFeeTokenRequest{} }
+func (m *QueryPreferredFeeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreferredFeeTokenRequest) ProtoMessage()    {}
+func (*QueryPreferredFeeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{4}
 }
-
-var fileDescriptor_88edc16f4ff36bc7 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x90, 0x7b, 0x49, 0x98, 0xbb, 0x9b, 0xcb, 0xbd, 0x9a, 0xc6, 0x14, 0xd3, 0x0d, 0x1a,
-	0xb4, 0x13, 0xa8, 0xd1, 0x85, 0x3b, 0x8c, 0xee, 0x48, 0x94, 0xb8, 0x22, 0x26, 0x66, 0x8a, 0x87,
-	0xd2, 0x00, 0x9d, 0xd2, 0x0e, 0x46, 0xb6, 0x3e, 0x81, 0x89, 0xaf, 0xe0, 0x0b, 0xf8, 0x16, 0x2c,
-	0x49, 0xdc, 0xb8, 0x32, 0xa6, 0xf8, 0x20, 0x86, 0xe9, 0x00, 0x01, 0x17, 0x85, 0xdd, 0xe4, 0x9c,
-	0xef, 0xef, 0x9c, 0x33, 0xb8, 0xd4, 0xf1, 0xbc, 0x66, 0x9b, 0x79, 0x3e, 0x6d, 0x01, 0x30, 0x27,
-	0x12, 0x21, 0x6b, 0x0a, 0x8f, 0xfb, 0xf4, 0xbe, 0xec, 0x80, 0x60, 0x65, 0xda, 0x1f, 0x40, 0x38,
-	0xb4, 0x82, 0x90, 0x0b, 0x4e, 0x0a, 0x33, 0xb0, 0xb5, 0x0c, 0xb6, 0x14, 0x58, 0xcf, 0xbb, 0xdc,
-	0xe5, 0x12, 0x4b, 0xa7, 0xaf, 0x84, 0xa6, 0xef, 0xb8, 0x9c, 0xbb, 0x5d, 0xa0, 0x2c, 0xf0, 0x28,
-	0xf3, 0x7d, 0x2e, 0xd8, 0x94, 0x14, 0xa9, 0xee, 0x41, 0x5a, 0x82, 0x80, 0x85, 0xac, 0xa7, 0xd0,
-	0x66, 0x1e, 0x93, 0xab, 0x69, 0xa2, 0x4b, 0x59, 0xac, 0x43, 0x7f, 0x00, 0x91, 0x30, 0x6f, 0xf0,
-	0xdf, 0xa5, 0x6a, 0x14, 0x70, 0x3f, 0x02, 0x72, 0x8e, 0xb3, 0x09, 0x79, 0x1b, 0xed, 0xa2, 0xbd,
-	0x3f, 0x95, 0xa2, 0x95, 0x32, 0x80, 0x95, 0x08, 0x54, 0x7f, 0x8d, 0x3e, 0x0a, 0x5a, 0x5d, 0x91,
-	0xcd, 0x2d, 0xfc, 0x4f, 0xaa, 0x5f, 0x00, 0x5c, 0xf3, 0x0e, 0xf8, 0x73, 0x5b, 0x81, 0xff, 0xaf,
-	0x36, 0x94, 0x73, 0x03, 0xe3, 0x16, 0xc0, 0xad, 0x90, 0x55, 0xe5, 0x7e, 0x9a, 0xea, 0x3e, 0xd3,
-	0xa9, 0x81, 0x60, 0x77, 0x4c, 0xb0, 0x33, 0xde, 0xed, 0x82, 0x84, 0xd4, 0x73, 0xad, 0x99, 0x47,
-	0x25, 0xce, 0xe0, 0xdf, 0xd2, 0x96, 0xbc, 0x20, 0x9c, 0x4d, 0x12, 0x13, 0x3b, 0x55, 0xfc, 0xe7,
-	0xda, 0xf4, 0xa3, 0xcd, 0x48, 0xc9, 0x6c, 0x26, 0x7d, 0x7c, 0xfb, 0x7a, 0xce, 0xec, 0x93, 0x22,
-	0x5d, 0xef, 0x72, 0xe4, 0x15, 0xe1, 0xdc, 0x7c, 0x45, 0xe4, 0x78, 0x3d, 0xd3, 0xd5, 0x65, 0xeb,
-	0x27, 0x1b, 0xf3, 0x54, 0x5e, 0x5b, 0xe6, 0x3d, 0x24, 0xa5, 0xd4, 0xbc, 0x8b, 0x93, 0x55, 0x6b,
-	0xa3, 0xd8, 0x40, 0xe3, 0xd8, 0x40, 0x9f, 0xb1, 0x81, 0x9e, 0x26, 0x86, 0x36, 0x9e, 0x18, 0xda,
-	0xfb, 0xc4, 0xd0, 0x1a, 0xb6, 0xeb, 0x89, 0xf6, 0xc0, 0xb1, 0x9a, 0xbc, 0xb7, 0x10, 0x9c, 0x3f,
-	0x1e, 0x56, 0xb5, 0xc5, 0x30, 0x80, 0xc8, 0xc9, 0xca, 0xdf, 0x6b, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x5b, 0x0f, 0x3a, 0x93, 0x6f, 0x03, 0x00, 0x00,
+func (m *QueryPreferredFeeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreferredFeeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreferredFeeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreferredFeeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreferredFeeTokenRequest.Merge(m, src)
+}
+func (m *QueryPreferredFeeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreferredFeeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreferredFeeTokenRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPreferredFeeTokenRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the fee abstraction params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// FeeTokens defines a gRPC query method that returns the fee tokens
-	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+func (m *QueryPreferredFeeTokenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryPreferredFeeTokenResponse is the response type for the
+// Query/PreferredFeeToken RPC method
+type QueryPreferredFeeTokenResponse struct {
+	// denom is the preferred fee token, empty if no preference is set
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPreferredFeeTokenResponse) Reset()         { *m = QueryPreferredFeeTokenResponse{} }
+func (m *QueryPreferredFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreferredFeeTokenResponse) ProtoMessage()    {}
+func (*QueryPreferredFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{5}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPreferredFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreferredFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreferredFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPreferredFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreferredFeeTokenResponse.Merge(m, src)
+}
+func (m *QueryPreferredFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreferredFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreferredFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
-	out := new(QueryFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPreferredFeeTokenResponse proto.InternalMessageInfo
+
+func (m *QueryPreferredFeeTokenResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the fee abstraction params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// FeeTokens defines a gRPC query method that returns the fee tokens
-	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+// QueryEstimateFeeRequest is the request type for the Query/EstimateFee RPC
+// method
+type QueryEstimateFeeRequest struct {
+	// gas_limit is the gas limit of the tx
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// denom optionally restricts the estimation to a single fee token
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+func (m *QueryEstimateFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.feeabstraction.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FeeTokens",
-			Handler:    _Query_FeeTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/feeabstraction/v1beta1/query.proto",
+// QueryEstimateFeeResponse is the response type for the Query/EstimateFee RPC
+// method
+type QueryEstimateFeeResponse struct {
+	// native_fee is the fee charged on the native denom
+	NativeFee types.Coin `protobuf:"bytes,1,opt,name=native_fee,json=nativeFee,proto3" json:"native_fee"`
+	// fees is the fee charged on each enabled fee token, following the fee
+	// tokens order
+	Fees []types.Coin `protobuf:"bytes,2,rep,name=fees,proto3" json:"fees"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{7}
+}
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetNativeFee() types.Coin {
+	if m != nil {
+		return m.NativeFee
+	}
+	return types.Coin{}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateFeeResponse) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySponsorRequest is the request type for the Query/Sponsor RPC method
+type QuerySponsorRequest struct {
+	// contract is the sponsor contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySponsorRequest) Reset()         { *m = QuerySponsorRequest{} }
+func (m *QuerySponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorRequest) ProtoMessage()    {}
+func (*QuerySponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{8}
+}
+func (m *QuerySponsorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorRequest.Merge(m, src)
 }
-
-func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySponsorRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySponsorRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySponsorResponse is the response type for the Query/Sponsor RPC method
+type QuerySponsorResponse struct {
+	// sponsor is the fee sponsor
+	Sponsor Sponsor `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor"`
 }
 
-func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (m *QuerySponsorResponse) Reset()         { *m = QuerySponsorResponse{} }
+func (m *QuerySponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorResponse) ProtoMessage()    {}
+func (*QuerySponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{9}
+}
+func (m *QuerySponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorResponse.Merge(m, src)
+}
+func (m *QuerySponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorResponse) GetSponsor() Sponsor {
+	if m != nil {
+		return m.Sponsor
+	}
+	return Sponsor{}
+}
+
+// QuerySponsorsRequest is the request type for the Query/Sponsors RPC method
+type QuerySponsorsRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsRequest) Reset()         { *m = QuerySponsorsRequest{} }
+func (m *QuerySponsorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsRequest) ProtoMessage()    {}
+func (*QuerySponsorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{10}
+}
+func (m *QuerySponsorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsRequest.Merge(m, src)
+}
+func (m *QuerySponsorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorsResponse is the response type for the Query/Sponsors RPC method
+type QuerySponsorsResponse struct {
+	// sponsors are the registered fee sponsors
+	Sponsors []Sponsor `protobuf:"bytes,1,rep,name=sponsors,proto3" json:"sponsors"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorsResponse) Reset()         { *m = QuerySponsorsResponse{} }
+func (m *QuerySponsorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorsResponse) ProtoMessage()    {}
+func (*QuerySponsorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{11}
+}
+func (m *QuerySponsorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorsResponse.Merge(m, src)
+}
+func (m *QuerySponsorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorsResponse) GetSponsors() []Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *QuerySponsorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorUsageRequest is the request type for the Query/SponsorUsage RPC
+// method
+type QuerySponsorUsageRequest struct {
+	// contract is the sponsor contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// user is the sponsored account address
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QuerySponsorUsageRequest) Reset()         { *m = QuerySponsorUsageRequest{} }
+func (m *QuerySponsorUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsageRequest) ProtoMessage()    {}
+func (*QuerySponsorUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{12}
+}
+func (m *QuerySponsorUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsageRequest.Merge(m, src)
+}
+func (m *QuerySponsorUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsageRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorUsageRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QuerySponsorUsageRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QuerySponsorUsageResponse is the response type for the Query/SponsorUsage
+// RPC method
+type QuerySponsorUsageResponse struct {
+	// usage is the sponsor usage by the user
+	Usage SponsorUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *QuerySponsorUsageResponse) Reset()         { *m = QuerySponsorUsageResponse{} }
+func (m *QuerySponsorUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorUsageResponse) ProtoMessage()    {}
+func (*QuerySponsorUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{13}
+}
+func (m *QuerySponsorUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorUsageResponse.Merge(m, src)
+}
+func (m *QuerySponsorUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorUsageResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorUsageResponse) GetUsage() SponsorUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return SponsorUsage{}
+}
+
+// QueryFeeRevenueRequest is the request type for the Query/FeeRevenue RPC
+// method
+type QueryFeeRevenueRequest struct {
+	// denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeRevenueRequest) Reset()         { *m = QueryFeeRevenueRequest{} }
+func (m *QueryFeeRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueRequest) ProtoMessage()    {}
+func (*QueryFeeRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{14}
+}
+func (m *QueryFeeRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueRequest.Merge(m, src)
+}
+func (m *QueryFeeRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFeeRevenueResponse is the response type for the Query/FeeRevenue RPC
+// method
+type QueryFeeRevenueResponse struct {
+	// revenue is the fees collected and routed on the fee token
+	Revenue FeeRevenue `protobuf:"bytes,1,opt,name=revenue,proto3" json:"revenue"`
+}
+
+func (m *QueryFeeRevenueResponse) Reset()         { *m = QueryFeeRevenueResponse{} }
+func (m *QueryFeeRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueResponse) ProtoMessage()    {}
+func (*QueryFeeRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{15}
+}
+func (m *QueryFeeRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueResponse.Merge(m, src)
+}
+func (m *QueryFeeRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueResponse) GetRevenue() FeeRevenue {
+	if m != nil {
+		return m.Revenue
+	}
+	return FeeRevenue{}
+}
+
+// QueryFeeRevenuesRequest is the request type for the Query/FeeRevenues RPC
+// method
+type QueryFeeRevenuesRequest struct {
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeRevenuesRequest) Reset()         { *m = QueryFeeRevenuesRequest{} }
+func (m *QueryFeeRevenuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenuesRequest) ProtoMessage()    {}
+func (*QueryFeeRevenuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{16}
+}
+func (m *QueryFeeRevenuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRevenuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenuesRequest.Merge(m, src)
+}
+func (m *QueryFeeRevenuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRevenuesRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRevenuesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeRevenuesResponse is the response type for the Query/FeeRevenues RPC
+// method
+type QueryFeeRevenuesResponse struct {
+	// revenues are the fees collected and routed on each fee token
+	Revenues []FeeRevenue `protobuf:"bytes,1,rep,name=revenues,proto3" json:"revenues"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeRevenuesResponse) Reset()         { *m = QueryFeeRevenuesResponse{} }
+func (m *QueryFeeRevenuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenuesResponse) ProtoMessage()    {}
+func (*QueryFeeRevenuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{17}
+}
+func (m *QueryFeeRevenuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeRevenuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenuesResponse.Merge(m, src)
+}
+func (m *QueryFeeRevenuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeRevenuesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRevenuesResponse) GetRevenues() []FeeRevenue {
+	if m != nil {
+		return m.Revenues
+	}
+	return nil
+}
+
+func (m *QueryFeeRevenuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeTokenStatsRequest is the request type for the Query/FeeTokenStats
+// RPC method
+type QueryFeeTokenStatsRequest struct {
+	// denom is an optional fee token denom to filter the statistics
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeTokenStatsRequest) Reset()         { *m = QueryFeeTokenStatsRequest{} }
+func (m *QueryFeeTokenStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenStatsRequest) ProtoMessage()    {}
+func (*QueryFeeTokenStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{18}
+}
+func (m *QueryFeeTokenStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenStatsRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenStatsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryFeeTokenStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeTokenStatsResponse is the response type for the Query/FeeTokenStats
+// RPC method
+type QueryFeeTokenStatsResponse struct {
+	// stats are the daily usage statistics, ordered by day and denom
+	Stats []FeeTokenStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeTokenStatsResponse) Reset()         { *m = QueryFeeTokenStatsResponse{} }
+func (m *QueryFeeTokenStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenStatsResponse) ProtoMessage()    {}
+func (*QueryFeeTokenStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{19}
+}
+func (m *QueryFeeTokenStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenStatsResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenStatsResponse) GetStats() []FeeTokenStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryFeeTokenStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryPreferredFeeTokenRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryPreferredFeeTokenRequest")
+	proto.RegisterType((*QueryPreferredFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryPreferredFeeTokenResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryEstimateFeeResponse")
+	proto.RegisterType((*QuerySponsorRequest)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorRequest")
+	proto.RegisterType((*QuerySponsorResponse)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorResponse")
+	proto.RegisterType((*QuerySponsorsRequest)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorsRequest")
+	proto.RegisterType((*QuerySponsorsResponse)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorsResponse")
+	proto.RegisterType((*QuerySponsorUsageRequest)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorUsageRequest")
+	proto.RegisterType((*QuerySponsorUsageResponse)(nil), "kiichain.feeabstraction.v1beta1.QuerySponsorUsageResponse")
+	proto.RegisterType((*QueryFeeRevenueRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeRevenueRequest")
+	proto.RegisterType((*QueryFeeRevenueResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeRevenueResponse")
+	proto.RegisterType((*QueryFeeRevenuesRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeRevenuesRequest")
+	proto.RegisterType((*QueryFeeRevenuesResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeRevenuesResponse")
+	proto.RegisterType((*QueryFeeTokenStatsRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokenStatsRequest")
+	proto.RegisterType((*QueryFeeTokenStatsResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokenStatsResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/query.proto", fileDescriptor_88edc16f4ff36bc7)
+}
+
+var fileDescriptor_88edc16f4ff36bc7 = []byte{
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0xf9, 0x61, 0xbf, 0x02, 0x12, 0x8f, 0x94, 0xa4, 0x0b, 0xb8, 0xd5, 0x1e, 0x68,
+	0x4b, 0x9a, 0x5d, 0x12, 0xa7, 0x21, 0x69, 0x51, 0x80, 0x46, 0x35, 0xb4, 0x34, 0x12, 0xb8, 0x70,
+	0xa9, 0x10, 0x66, 0x6c, 0x8f, 0xdd, 0x55, 0xe3, 0x5d, 0x77, 0x67, 0x1c, 0x11, 0x45, 0xb9, 0xf0,
+	0x0f, 0x80, 0xc4, 0xbf, 0xc0, 0xb1, 0x97, 0x22, 0x38, 0x70, 0xe5, 0xd4, 0x63, 0x24, 0x2e, 0x1c,
+	0x10, 0x42, 0x09, 0x77, 0xfe, 0x05, 0xb4, 0xb3, 0x6f, 0xd6, 0xde, 0xd8, 0xcd, 0xee, 0x5a, 0xb9,
+	0x79, 0x77, 0xdf, 0xf7, 0xbe, 0xef, 0x9b, 0x79, 0x33, 0xef, 0x19, 0x16, 0x1f, 0xbb, 0x6e, 0xe3,
+	0x11, 0x73, 0x3d, 0xa7, 0xc5, 0x39, 0xab, 0x0b, 0x19, 0xb0, 0x86, 0x74, 0x7d, 0xcf, 0xd9, 0x5d,
+	0xae, 0x73, 0xc9, 0x96, 0x9d, 0x27, 0x3d, 0x1e, 0xec, 0xd9, 0xdd, 0xc0, 0x97, 0x3e, 0x5e, 0xd2,
+	0xc1, 0x76, 0x32, 0xd8, 0xa6, 0x60, 0x73, 0xae, 0xed, 0xb7, 0x7d, 0x15, 0xeb, 0x84, 0xbf, 0x22,
+	0x98, 0xf9, 0x66, 0xdb, 0xf7, 0xdb, 0x3b, 0xdc, 0x61, 0x5d, 0xd7, 0x61, 0x9e, 0xe7, 0x4b, 0x16,
+	0x82, 0x04, 0x7d, 0x2d, 0x35, 0x7c, 0xd1, 0xf1, 0x85, 0x53, 0x67, 0x82, 0xc7, 0xac, 0x0d, 0xdf,
+	0xf5, 0xe8, 0xfb, 0x3b, 0x83, 0xdf, 0x95, 0x9a, 0x38, 0xaa, 0xcb, 0xda, 0xae, 0xa7, 0x92, 0x51,
+	0xec, 0xf5, 0x34, 0x37, 0x5d, 0x16, 0xb0, 0x8e, 0x66, 0x5e, 0x4a, 0x8b, 0x0e, 0xf8, 0x2e, 0xf7,
+	0x7a, 0x3c, 0x6b, 0xb8, 0xe8, 0xfa, 0x9e, 0xf0, 0x03, 0x0a, 0x4f, 0x5d, 0x59, 0x21, 0x99, 0x24,
+	0x29, 0xd6, 0x1c, 0xe0, 0xe7, 0xa1, 0xb5, 0xcf, 0x94, 0xbe, 0x2a, 0x7f, 0xd2, 0xe3, 0x42, 0x5a,
+	0x5f, 0xc1, 0x6b, 0x89, 0xb7, 0x8a, 0x80, 0xe3, 0x1d, 0x98, 0x89, 0x7c, 0x2c, 0x18, 0x97, 0x8d,
+	0xab, 0xe7, 0x57, 0xae, 0xd8, 0x29, 0xfb, 0x62, 0x47, 0x09, 0x6e, 0x4f, 0x3d, 0xff, 0xfb, 0xd2,
+	0x44, 0x95, 0xc0, 0xd6, 0x3c, 0x5c, 0x50, 0xd9, 0x2b, 0x9c, 0x7f, 0xe1, 0x3f, 0xe6, 0x5e, 0x4c,
+	0x2b, 0xe1, 0xf5, 0x93, 0x1f, 0x88, 0xf9, 0x21, 0x40, 0x8b, 0xf3, 0x9a, 0x54, 0x6f, 0x89, 0xfd,
+	0x56, 0x2a, 0xbb, 0xce, 0xb3, 0xcd, 0x25, 0x6b, 0x32, 0xc9, 0xb6, 0xfc, 0x9d, 0x1d, 0xae, 0x42,
+	0xaa, 0xc5, 0x96, 0xe6, 0xb0, 0x36, 0xe0, 0xad, 0xc8, 0x6c, 0xc0, 0x5b, 0x3c, 0x08, 0x78, 0x53,
+	0xc3, 0x48, 0x16, 0x2e, 0xc0, 0x2c, 0x6b, 0x36, 0x03, 0x2e, 0x22, 0xe6, 0x62, 0x55, 0x3f, 0x5a,
+	0x6b, 0x50, 0x7a, 0x11, 0x94, 0x84, 0xcf, 0xc1, 0x74, 0x93, 0x7b, 0x7e, 0x87, 0x90, 0xd1, 0x83,
+	0x75, 0x1f, 0xe6, 0x15, 0xee, 0x8e, 0x90, 0x6e, 0x87, 0x49, 0x5e, 0xe1, 0x5c, 0x93, 0xbd, 0x01,
+	0xc5, 0x36, 0x13, 0xb5, 0x1d, 0xb7, 0xe3, 0x4a, 0x05, 0x9a, 0xaa, 0x16, 0xda, 0x4c, 0xdc, 0x0f,
+	0x9f, 0xfb, 0xd9, 0x26, 0x07, 0xb3, 0x7d, 0x6f, 0xc0, 0xc2, 0x70, 0x3a, 0x12, 0xb0, 0x09, 0x10,
+	0x56, 0xea, 0x2e, 0xaf, 0xb5, 0x38, 0xa7, 0x95, 0xbb, 0x68, 0x47, 0xa5, 0x6d, 0x87, 0xa5, 0x1d,
+	0xaf, 0xd6, 0x96, 0xef, 0x7a, 0xb4, 0x53, 0xc5, 0x08, 0x52, 0xe1, 0x1c, 0xcb, 0x30, 0xd5, 0xe2,
+	0x5c, 0x2c, 0x4c, 0x5e, 0x3e, 0x97, 0x05, 0xa9, 0x82, 0xad, 0x65, 0xaa, 0x9f, 0x07, 0x51, 0x61,
+	0x6a, 0x6f, 0x26, 0x14, 0x1a, 0xbe, 0xa7, 0xb6, 0x89, 0xd6, 0x23, 0x7e, 0xb6, 0xbe, 0x81, 0xb9,
+	0x24, 0x84, 0xf4, 0x7f, 0x02, 0xb3, 0x54, 0xde, 0x24, 0xfe, 0x6a, 0xea, 0xb6, 0x53, 0x0a, 0x52,
+	0xa4, 0xe1, 0xd6, 0xd7, 0x49, 0x06, 0x5d, 0x75, 0x58, 0x01, 0xe8, 0x9f, 0x67, 0x22, 0x79, 0x3b,
+	0xe1, 0x33, 0xba, 0x8a, 0xfa, 0x35, 0xdd, 0xd6, 0xbb, 0x55, 0x1d, 0x40, 0x5a, 0x4f, 0x0d, 0xb8,
+	0x70, 0x82, 0x80, 0x3c, 0xdc, 0x83, 0x02, 0x89, 0x08, 0x2b, 0xe8, 0xdc, 0x18, 0x26, 0x62, 0x3c,
+	0x7e, 0x9c, 0x50, 0x3b, 0x49, 0xe7, 0x30, 0x4d, 0x6d, 0x24, 0x24, 0x21, 0xf7, 0x1e, 0x15, 0x0d,
+	0x11, 0x7d, 0x29, 0xfa, 0xb6, 0x4e, 0xdb, 0x28, 0x44, 0x98, 0xea, 0x09, 0x1e, 0x50, 0x09, 0xaa,
+	0xdf, 0x56, 0x0b, 0x2e, 0x8e, 0xc8, 0x45, 0xee, 0xef, 0xc2, 0x74, 0x2f, 0x7c, 0x41, 0x4b, 0xbb,
+	0x94, 0xd5, 0xba, 0xca, 0x42, 0xfe, 0xa3, 0x0c, 0x96, 0xdd, 0xbf, 0x20, 0xaa, 0xd1, 0x15, 0xa9,
+	0x15, 0x8f, 0x3e, 0x67, 0x2d, 0x98, 0x1f, 0x8a, 0x27, 0x55, 0x9f, 0xc2, 0x2c, 0xdd, 0xb2, 0xa4,
+	0x6b, 0x31, 0xcb, 0x75, 0x42, 0x59, 0x74, 0x69, 0x51, 0x06, 0x8b, 0x0d, 0xf1, 0x9c, 0x79, 0x75,
+	0xfd, 0xac, 0x0f, 0x79, 0x82, 0x83, 0xcc, 0x6c, 0x43, 0x81, 0xa4, 0xe8, 0x02, 0x1b, 0xc3, 0x4d,
+	0x9c, 0xe2, 0xec, 0x6a, 0x6c, 0x8f, 0xea, 0x42, 0x5f, 0x8b, 0x0f, 0x24, 0x93, 0xe2, 0xd4, 0x2d,
+	0xc3, 0xca, 0x08, 0xee, 0x71, 0xd6, 0xeb, 0x99, 0x01, 0xe6, 0x28, 0xee, 0xf8, 0x48, 0x4e, 0xab,
+	0x36, 0x48, 0xcb, 0x65, 0x67, 0xee, 0x25, 0x2a, 0x8d, 0xae, 0x4a, 0x95, 0xe2, 0xcc, 0x96, 0x6b,
+	0xe5, 0xbf, 0x57, 0x60, 0x5a, 0x69, 0xc6, 0x9f, 0x0c, 0x98, 0x89, 0x7a, 0x27, 0x96, 0x53, 0xa5,
+	0x0d, 0x37, 0x70, 0x73, 0x35, 0x1f, 0x28, 0xd2, 0x62, 0x39, 0xdf, 0xfd, 0xf1, 0xef, 0x8f, 0x93,
+	0xd7, 0xf0, 0x8a, 0x93, 0x6d, 0x9c, 0xc1, 0x67, 0x06, 0x14, 0xe3, 0x66, 0x8d, 0x6b, 0xd9, 0x48,
+	0x4f, 0xb6, 0x7d, 0xf3, 0xbd, 0xdc, 0x38, 0xd2, 0x5b, 0x56, 0x7a, 0x97, 0x70, 0x31, 0x55, 0x6f,
+	0x7f, 0x78, 0xc0, 0xbf, 0x0c, 0x78, 0x75, 0xa8, 0x5f, 0xe3, 0x66, 0xc6, 0x05, 0x7b, 0xc1, 0x8c,
+	0x60, 0x7e, 0x30, 0x36, 0x9e, 0xbc, 0x54, 0x94, 0x97, 0x0f, 0x71, 0x33, 0x7d, 0xed, 0x75, 0x8e,
+	0x5a, 0xec, 0xca, 0xd9, 0xa7, 0x89, 0xe4, 0x00, 0x7f, 0x33, 0xe0, 0xfc, 0xc0, 0x1c, 0x80, 0xeb,
+	0xd9, 0x84, 0x0d, 0x4f, 0x22, 0xe6, 0xc6, 0x18, 0x48, 0x32, 0x73, 0x43, 0x99, 0x71, 0x70, 0x29,
+	0xd5, 0x0c, 0x27, 0x74, 0xe8, 0x05, 0x7f, 0x31, 0x60, 0x96, 0x2e, 0x7f, 0xcc, 0x58, 0xc1, 0xc9,
+	0x09, 0xc3, 0xbc, 0x91, 0x13, 0x45, 0x7a, 0xdf, 0x57, 0x7a, 0xd7, 0x70, 0xd5, 0xc9, 0x38, 0x6a,
+	0x0b, 0x67, 0x5f, 0x37, 0xc4, 0x03, 0x7c, 0x6a, 0x40, 0x81, 0x32, 0x0a, 0xcc, 0xa7, 0x20, 0x3e,
+	0x03, 0x6b, 0x79, 0x61, 0xa4, 0x7c, 0x59, 0x29, 0x5f, 0xc4, 0x6b, 0x99, 0x95, 0xe3, 0xa1, 0x01,
+	0x2f, 0x0d, 0xb6, 0x58, 0xdc, 0xc8, 0xc5, 0x3d, 0x38, 0x28, 0x98, 0x37, 0xc7, 0x81, 0x92, 0xf4,
+	0xbb, 0x4a, 0xfa, 0x16, 0x7e, 0x34, 0xce, 0xa2, 0x3b, 0x6a, 0x20, 0x70, 0xf6, 0xc3, 0xf1, 0xe3,
+	0x00, 0x7f, 0x35, 0x00, 0xfa, 0xfd, 0x0c, 0xb3, 0x5f, 0x28, 0xc9, 0x29, 0xc2, 0x5c, 0xcf, 0x0f,
+	0x24, 0x33, 0xab, 0xca, 0x8c, 0x8d, 0xd7, 0x33, 0x5d, 0x45, 0xd4, 0x69, 0xd5, 0x61, 0xed, 0x27,
+	0x13, 0x98, 0x9b, 0x5f, 0xe4, 0x3c, 0xac, 0x23, 0x86, 0x87, 0x1c, 0x87, 0x75, 0x40, 0xba, 0xc0,
+	0xdf, 0x0d, 0x78, 0x39, 0xd1, 0x14, 0xf1, 0x66, 0xbe, 0x7b, 0x7c, 0x70, 0x18, 0x30, 0x6f, 0x8d,
+	0x85, 0x25, 0x07, 0xeb, 0xca, 0xc1, 0x0a, 0xbe, 0x9b, 0xbd, 0x0f, 0xd4, 0x54, 0xeb, 0xbe, 0xbd,
+	0xfd, 0xfc, 0xa8, 0x64, 0x1c, 0x1e, 0x95, 0x8c, 0x7f, 0x8e, 0x4a, 0xc6, 0x0f, 0xc7, 0xa5, 0x89,
+	0xc3, 0xe3, 0xd2, 0xc4, 0x9f, 0xc7, 0xa5, 0x89, 0x87, 0xe5, 0xb6, 0x2b, 0x1f, 0xf5, 0xea, 0x76,
+	0xc3, 0xef, 0xf4, 0xb3, 0xc6, 0x3f, 0xbe, 0x3d, 0x49, 0x20, 0xf7, 0xba, 0x5c, 0xd4, 0x67, 0xd4,
+	0x9f, 0xea, 0xf2, 0xff, 0x03, 0x00, 0xf1, 0x11, 0x2b, 0x32, 0xdd, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the fee abstraction params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeTokens defines a gRPC query method that returns the fee tokens
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// PreferredFeeToken defines a gRPC query method that returns the fee token
+	// preferred by an account
+	PreferredFeeToken(ctx context.Context, in *QueryPreferredFeeTokenRequest, opts ...grpc.CallOption) (*QueryPreferredFeeTokenResponse, error)
+	// EstimateFee defines a gRPC query method that returns the fee charged for
+	// a gas limit on each enabled fee token
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
+	// Sponsor defines a gRPC query method that returns a fee sponsor
+	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
+	// Sponsors defines a gRPC query method that returns all the fee sponsors
+	Sponsors(ctx context.Context, in *QuerySponsorsRequest, opts ...grpc.CallOption) (*QuerySponsorsResponse, error)
+	// SponsorUsage defines a gRPC query method that returns the usage of a fee
+	// sponsor by a user
+	SponsorUsage(ctx context.Context, in *QuerySponsorUsageRequest, opts ...grpc.CallOption) (*QuerySponsorUsageResponse, error)
+	// FeeRevenue defines a gRPC query method that returns the fees collected and
+	// routed on a fee token
+	FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error)
+	// FeeRevenues defines a gRPC query method that returns the fees collected
+	// and routed on all the fee tokens
+	FeeRevenues(ctx context.Context, in *QueryFeeRevenuesRequest, opts ...grpc.CallOption) (*QueryFeeRevenuesResponse, error)
+	// FeeTokenStats defines a gRPC query method that returns the daily usage
+	// statistics of the fee tokens
+	FeeTokenStats(ctx context.Context, in *QueryFeeTokenStatsRequest, opts ...grpc.CallOption) (*QueryFeeTokenStatsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreferredFeeToken(ctx context.Context, in *QueryPreferredFeeTokenRequest, opts ...grpc.CallOption) (*QueryPreferredFeeTokenResponse, error) {
+	out := new(QueryPreferredFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/PreferredFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
+	out := new(QuerySponsorResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Sponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsors(ctx context.Context, in *QuerySponsorsRequest, opts ...grpc.CallOption) (*QuerySponsorsResponse, error) {
+	out := new(QuerySponsorsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Sponsors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorUsage(ctx context.Context, in *QuerySponsorUsageRequest, opts ...grpc.CallOption) (*QuerySponsorUsageResponse, error) {
+	out := new(QuerySponsorUsageResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/SponsorUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error) {
+	out := new(QueryFeeRevenueResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRevenues(ctx context.Context, in *QueryFeeRevenuesRequest, opts ...grpc.CallOption) (*QueryFeeRevenuesResponse, error) {
+	out := new(QueryFeeRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokenStats(ctx context.Context, in *QueryFeeTokenStatsRequest, opts ...grpc.CallOption) (*QueryFeeTokenStatsResponse, error) {
+	out := new(QueryFeeTokenStatsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokenStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the fee abstraction params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeTokens defines a gRPC query method that returns the fee tokens
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// PreferredFeeToken defines a gRPC query method that returns the fee token
+	// preferred by an account
+	PreferredFeeToken(context.Context, *QueryPreferredFeeTokenRequest) (*QueryPreferredFeeTokenResponse, error)
+	// EstimateFee defines a gRPC query method that returns the fee charged for
+	// a gas limit on each enabled fee token
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
+	// Sponsor defines a gRPC query method that returns a fee sponsor
+	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
+	// Sponsors defines a gRPC query method that returns all the fee sponsors
+	Sponsors(context.Context, *QuerySponsorsRequest) (*QuerySponsorsResponse, error)
+	// SponsorUsage defines a gRPC query method that returns the usage of a fee
+	// sponsor by a user
+	SponsorUsage(context.Context, *QuerySponsorUsageRequest) (*QuerySponsorUsageResponse, error)
+	// FeeRevenue defines a gRPC query method that returns the fees collected and
+	// routed on a fee token
+	FeeRevenue(context.Context, *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error)
+	// FeeRevenues defines a gRPC query method that returns the fees collected
+	// and routed on all the fee tokens
+	FeeRevenues(context.Context, *QueryFeeRevenuesRequest) (*QueryFeeRevenuesResponse, error)
+	// FeeTokenStats defines a gRPC query method that returns the daily usage
+	// statistics of the fee tokens
+	FeeTokenStats(context.Context, *QueryFeeTokenStatsRequest) (*QueryFeeTokenStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) PreferredFeeToken(ctx context.Context, req *QueryPreferredFeeTokenRequest) (*QueryPreferredFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreferredFeeToken not implemented")
+}
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (*UnimplementedQueryServer) Sponsor(ctx context.Context, req *QuerySponsorRequest) (*QuerySponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+}
+func (*UnimplementedQueryServer) Sponsors(ctx context.Context, req *QuerySponsorsRequest) (*QuerySponsorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsors not implemented")
+}
+func (*UnimplementedQueryServer) SponsorUsage(ctx context.Context, req *QuerySponsorUsageRequest) (*QuerySponsorUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorUsage not implemented")
+}
+func (*UnimplementedQueryServer) FeeRevenue(ctx context.Context, req *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRevenue not implemented")
+}
+func (*UnimplementedQueryServer) FeeRevenues(ctx context.Context, req *QueryFeeRevenuesRequest) (*QueryFeeRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRevenues not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokenStats(ctx context.Context, req *QueryFeeTokenStatsRequest) (*QueryFeeTokenStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenStats not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreferredFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreferredFeeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreferredFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/PreferredFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreferredFeeToken(ctx, req.(*QueryPreferredFeeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Sponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Sponsors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsors(ctx, req.(*QuerySponsorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/SponsorUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorUsage(ctx, req.(*QuerySponsorUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRevenue(ctx, req.(*QueryFeeRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRevenues(ctx, req.(*QueryFeeRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokenStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokenStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenStats(ctx, req.(*QueryFeeTokenStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.feeabstraction.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "PreferredFeeToken",
+			Handler:    _Query_PreferredFeeToken_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
+		{
+			MethodName: "Sponsor",
+			Handler:    _Query_Sponsor_Handler,
+		},
+		{
+			MethodName: "Sponsors",
+			Handler:    _Query_Sponsors_Handler,
+		},
+		{
+			MethodName: "SponsorUsage",
+			Handler:    _Query_SponsorUsage_Handler,
+		},
+		{
+			MethodName: "FeeRevenue",
+			Handler:    _Query_FeeRevenue_Handler,
+		},
+		{
+			MethodName: "FeeRevenues",
+			Handler:    _Query_FeeRevenues_Handler,
+		},
+		{
+			MethodName: "FeeTokenStats",
+			Handler:    _Query_FeeTokenStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/feeabstraction/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeTokens != nil {
+		{
+			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreferredFeeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreferredFeeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreferredFeeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreferredFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreferredFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreferredFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.NativeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsors) > 0 {
+		for iNdEx := len(m.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Revenue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FeeTokens != nil {
-		{
-			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeTokens != nil {
+		l = m.FeeTokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreferredFeeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreferredFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySponsorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsors) > 0 {
+		for _, e := range m.Sponsors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Revenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeTokens == nil {
+				m.FeeTokens = &FeeTokenMetadataCollection{}
+			}
+			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreferredFeeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreferredFeeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreferredFeeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPreferredFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPreferredFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPreferredFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsors = append(m.Sponsors, Sponsor{})
+			if err := m.Sponsors[len(m.Sponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeTokens != nil {
-		l = m.FeeTokens.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRevenuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -552,12 +3959,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRevenuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -579,7 +4022,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRevenuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -602,15 +4045,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRevenuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -637,7 +4080,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revenues = append(m.Revenues, FeeRevenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -662,7 +4142,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -685,12 +4165,80 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -712,7 +4260,7 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeTokenStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,15 +4283,15 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeTokenStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeTokenStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -770,10 +4318,44 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeTokens == nil {
-				m.FeeTokens = &FeeTokenMetadataCollection{}
+			m.Stats = append(m.Stats, FeeTokenStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NewChargedFee creates a new ChargedFee instance
+func NewChargedFee(denom string, amount, nativeAmount math.Int) ChargedFee {
+	return ChargedFee{
+		Denom:        denom,
+		Amount:       amount,
+		NativeAmount: nativeAmount,
+	}
+}
+
+// NewNativeChargedFee creates a ChargedFee for a tx that wasn't charged on a fee token
+// The refunds of the tx are kept as is
+func NewNativeChargedFee() ChargedFee {
+	return ChargedFee{
+		Amount:       math.ZeroInt(),
+		NativeAmount: math.ZeroInt(),
+	}
+}
+
+// IsConverted returns true if the fee was charged on a fee token or paid by a sponsor
+// The refunds of converted fees are paid on the charged denom
+func (c ChargedFee) IsConverted() bool {
+	return c.Denom != ""
+}
+
+// GetRefund converts a native refund to the fee token at the charged price
+// The refund is truncated and never exceeds the charged amount
+func (c ChargedFee) GetRefund(nativeRefund math.Int) math.Int {
+	// Nothing is refunded without a native amount
+	if !c.NativeAmount.IsPositive() || !nativeRefund.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	// Apply the charged price, the amount over the native amount
+	refund := c.Amount.Mul(nativeRefund).Quo(c.NativeAmount)
+	return math.MinInt(refund, c.Amount)
+}
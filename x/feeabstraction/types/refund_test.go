@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestChargedFeeGetRefund tests the conversion of the native refunds at the charged price
+func TestChargedFeeGetRefund(t *testing.T) {
+	testCases := []struct {
+		name         string
+		chargedFee   types.ChargedFee
+		nativeRefund math.Int
+		expected     math.Int
+	}{
+		{
+			name:         "valid - refund at the charged price",
+			chargedFee:   types.NewChargedFee("uusdc", math.NewInt(500), math.NewInt(1000)),
+			nativeRefund: math.NewInt(400),
+			expected:     math.NewInt(200),
+		},
+		{
+			name:         "valid - refund is truncated",
+			chargedFee:   types.NewChargedFee("uusdc", math.NewInt(1), math.NewInt(3)),
+			nativeRefund: math.NewInt(2),
+			expected:     math.ZeroInt(),
+		},
+		{
+			name:         "valid - refund is capped to the charged amount",
+			chargedFee:   types.NewChargedFee("uusdc", math.NewInt(500), math.NewInt(1000)),
+			nativeRefund: math.NewInt(5000),
+			expected:     math.NewInt(500),
+		},
+		{
+			name:         "valid - no native amount",
+			chargedFee:   types.NewChargedFee("uusdc", math.NewInt(500), math.ZeroInt()),
+			nativeRefund: math.NewInt(400),
+			expected:     math.ZeroInt(),
+		},
+		{
+			name:         "valid - zero refund",
+			chargedFee:   types.NewChargedFee("uusdc", math.NewInt(500), math.NewInt(1000)),
+			nativeRefund: math.ZeroInt(),
+			expected:     math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.chargedFee.GetRefund(tc.nativeRefund))
+		})
+	}
+}
+
+// TestChargedFeeIsConverted tests the IsConverted method of ChargedFee
+func TestChargedFeeIsConverted(t *testing.T) {
+	require.True(t, types.NewChargedFee("uusdc", math.NewInt(500), math.NewInt(1000)).IsConverted())
+	require.False(t, types.NewNativeChargedFee().IsConverted())
+}
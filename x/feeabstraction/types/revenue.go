@@ -0,0 +1,98 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Revenue accounts governance can withdraw from
+const (
+	RevenueAccountTreasury = "treasury"
+	RevenueAccountReserve  = "reserve"
+)
+
+// GetRevenueAccountName returns the module account name of a revenue account
+func GetRevenueAccountName(account string) (string, bool) {
+	switch account {
+	case RevenueAccountTreasury:
+		return TreasuryName, true
+	case RevenueAccountReserve:
+		return ReserveName, true
+	default:
+		return "", false
+	}
+}
+
+// NewFeeRevenue creates a new empty FeeRevenue instance
+func NewFeeRevenue(denom string) FeeRevenue {
+	return FeeRevenue{
+		Denom:             denom,
+		FeeCollector:      math.ZeroInt(),
+		CommunityPool:     math.ZeroInt(),
+		Burned:            math.ZeroInt(),
+		Treasury:          math.ZeroInt(),
+		PendingSettlement: math.ZeroInt(),
+		Settled:           math.ZeroInt(),
+		SettledNative:     math.ZeroInt(),
+	}
+}
+
+// AddRouted adds an amount routed to the destination
+// Amounts routed to the fee collector are held for the settlement if settle is true
+func (r FeeRevenue) AddRouted(destination RevenueDestination, amount math.Int, settle bool) FeeRevenue {
+	switch destination {
+	case RevenueDestinationCommunityPool:
+		r.CommunityPool = r.CommunityPool.Add(amount)
+	case RevenueDestinationBurn:
+		r.Burned = r.Burned.Add(amount)
+	case RevenueDestinationTreasury:
+		r.Treasury = r.Treasury.Add(amount)
+	default:
+		if settle {
+			r.PendingSettlement = r.PendingSettlement.Add(amount)
+		} else {
+			r.FeeCollector = r.FeeCollector.Add(amount)
+		}
+	}
+
+	return r
+}
+
+// AddSettled moves a pending amount to the settled amount
+func (r FeeRevenue) AddSettled(amount, nativeAmount math.Int) FeeRevenue {
+	r.PendingSettlement = r.PendingSettlement.Sub(amount)
+	r.Settled = r.Settled.Add(amount)
+	r.SettledNative = r.SettledNative.Add(nativeAmount)
+	return r
+}
+
+// Validate validates the fee revenue
+func (r FeeRevenue) Validate() error {
+	// Validate the denom
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeRevenue, "invalid denom %s: %s", r.Denom, err)
+	}
+
+	// Validate the amounts
+	amounts := []struct {
+		name   string
+		amount math.Int
+	}{
+		{"fee collector", r.FeeCollector},
+		{"community pool", r.CommunityPool},
+		{"burned", r.Burned},
+		{"treasury", r.Treasury},
+		{"pending settlement", r.PendingSettlement},
+		{"settled", r.Settled},
+		{"settled native", r.SettledNative},
+	}
+	for _, entry := range amounts {
+		if entry.amount.IsNil() || entry.amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFeeRevenue, "invalid %s amount for %s: %s", entry.name, r.Denom, entry.amount)
+		}
+	}
+
+	return nil
+}
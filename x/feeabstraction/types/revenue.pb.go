@@ -0,0 +1,671 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabstraction/v1beta1/revenue.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeRevenue tracks the fees collected on a fee token and where they were
+// routed
+type FeeRevenue struct {
+	// Denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// FeeCollector is the amount kept on the fee collector for the stakers
+	FeeCollector cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fee_collector,json=feeCollector,proto3,customtype=cosmossdk.io/math.Int" json:"fee_collector"`
+	// CommunityPool is the amount sent to the community pool
+	CommunityPool cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.Int" json:"community_pool"`
+	// Burned is the amount burned
+	Burned cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+	// Treasury is the amount sent to the treasury module account
+	Treasury cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=treasury,proto3,customtype=cosmossdk.io/math.Int" json:"treasury"`
+	// PendingSettlement is the amount held by the module until the next
+	// settlement
+	PendingSettlement cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=pending_settlement,json=pendingSettlement,proto3,customtype=cosmossdk.io/math.Int" json:"pending_settlement"`
+	// Settled is the amount sold to the native reserve
+	Settled cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=settled,proto3,customtype=cosmossdk.io/math.Int" json:"settled"`
+	// SettledNative is the amount of native tokens paid by the reserve to the
+	// fee collector for the settled amount
+	SettledNative cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=settled_native,json=settledNative,proto3,customtype=cosmossdk.io/math.Int" json:"settled_native"`
+}
+
+func (m *FeeRevenue) Reset()         { *m = FeeRevenue{} }
+func (m *FeeRevenue) String() string { return proto.CompactTextString(m) }
+func (*FeeRevenue) ProtoMessage()    {}
+func (*FeeRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5686feee9d489226, []int{0}
+}
+func (m *FeeRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRevenue.Merge(m, src)
+}
+func (m *FeeRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRevenue proto.InternalMessageInfo
+
+func (m *FeeRevenue) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*FeeRevenue)(nil), "kiichain.feeabstraction.v1beta1.FeeRevenue")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/revenue.proto", fileDescriptor_5686feee9d489226)
+}
+
+var fileDescriptor_5686feee9d489226 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x93, 0xaf, 0x5f, 0x7f, 0x1c, 0xac, 0xe0, 0x50, 0x61, 0x10, 0x4c, 0xc5, 0x95, 0x1b,
+	0x13, 0x4a, 0x11, 0x71, 0x5b, 0x45, 0x10, 0x54, 0xa4, 0xee, 0xdc, 0x84, 0xfc, 0x9c, 0xa6, 0x43,
+	0x93, 0x39, 0x21, 0x39, 0x29, 0xf6, 0x2e, 0xbc, 0xac, 0x2e, 0xbb, 0x14, 0x17, 0x45, 0xda, 0xa5,
+	0x37, 0x21, 0x6d, 0xd2, 0x80, 0xae, 0xb2, 0x7b, 0x87, 0x79, 0x9e, 0x77, 0x71, 0x78, 0xd9, 0xc5,
+	0x44, 0x4a, 0x6f, 0xec, 0x48, 0x65, 0x8d, 0x00, 0x1c, 0x37, 0xa5, 0xc4, 0xf1, 0x48, 0xa2, 0xb2,
+	0xa6, 0x3d, 0x17, 0xc8, 0xe9, 0x59, 0x09, 0x4c, 0x41, 0x65, 0x60, 0xc6, 0x09, 0x12, 0xf2, 0xee,
+	0x0e, 0x37, 0x7f, 0xe3, 0x66, 0x81, 0x1f, 0x77, 0x02, 0x0c, 0x70, 0xcb, 0x5a, 0x9b, 0x94, 0x6b,
+	0x67, 0xdf, 0x35, 0xc6, 0xee, 0x00, 0x86, 0x79, 0x17, 0xef, 0xb0, 0xba, 0x0f, 0x0a, 0x23, 0xa1,
+	0x9f, 0xea, 0xe7, 0x7b, 0xc3, 0xfc, 0xc1, 0x07, 0xac, 0x3d, 0x02, 0xb0, 0x3d, 0x0c, 0x43, 0xf0,
+	0x08, 0x13, 0xf1, 0x6f, 0xf3, 0x3b, 0x38, 0x99, 0x2f, 0xbb, 0xda, 0xe7, 0xb2, 0x7b, 0xe4, 0x61,
+	0x1a, 0x61, 0x9a, 0xfa, 0x13, 0x53, 0xa2, 0x15, 0x39, 0x34, 0x36, 0xef, 0x15, 0x0d, 0xf7, 0x47,
+	0x00, 0x37, 0x3b, 0x85, 0xdf, 0xb2, 0x03, 0x0f, 0xa3, 0x28, 0x53, 0x92, 0x66, 0x76, 0x8c, 0x18,
+	0x8a, 0x5a, 0x95, 0x92, 0x76, 0x29, 0x3d, 0x23, 0x86, 0xfc, 0x92, 0x35, 0xdc, 0x2c, 0x51, 0xe0,
+	0x8b, 0xff, 0x55, 0xec, 0x02, 0xe6, 0xd7, 0xac, 0x45, 0x09, 0x38, 0x69, 0x96, 0xcc, 0x44, 0xbd,
+	0x8a, 0x58, 0xe2, 0xfc, 0x81, 0xf1, 0x18, 0x94, 0x2f, 0x55, 0x60, 0xa7, 0x40, 0x14, 0x42, 0x04,
+	0x8a, 0x44, 0xa3, 0x4a, 0xc9, 0x61, 0x21, 0xbe, 0x94, 0x1e, 0xbf, 0x62, 0xcd, 0xbc, 0xc5, 0x17,
+	0xcd, 0x2a, 0x15, 0x3b, 0x7a, 0x73, 0xbe, 0x22, 0xda, 0xca, 0x21, 0x39, 0x05, 0xd1, 0xaa, 0x74,
+	0xbe, 0x42, 0x7a, 0xda, 0x3a, 0x83, 0xc7, 0xf9, 0xca, 0xd0, 0x17, 0x2b, 0x43, 0xff, 0x5a, 0x19,
+	0xfa, 0xfb, 0xda, 0xd0, 0x16, 0x6b, 0x43, 0xfb, 0x58, 0x1b, 0xda, 0x6b, 0x3f, 0x90, 0x34, 0xce,
+	0x5c, 0xd3, 0xc3, 0xc8, 0x2a, 0x87, 0x57, 0x86, 0xb7, 0xbf, 0x1b, 0xa4, 0x59, 0x0c, 0xa9, 0xdb,
+	0xd8, 0x6e, 0xa8, 0xff, 0x33, 0x00, 0x1d, 0xa9, 0xef, 0xe7, 0xab, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SettledNative.Size()
+		i -= size
+		if _, err := m.SettledNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Settled.Size()
+		i -= size
+		if _, err := m.Settled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PendingSettlement.Size()
+		i -= size
+		if _, err := m.PendingSettlement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Treasury.Size()
+		i -= size
+		if _, err := m.Treasury.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeCollector.Size()
+		i -= size
+		if _, err := m.FeeCollector.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevenue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = m.FeeCollector.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.Treasury.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.PendingSettlement.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.Settled.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	l = m.SettledNative.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	return n
+}
+
+func sovRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevenue(x uint64) (n int) {
+	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Treasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSettlement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingSettlement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettledNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevenue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevenue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevenue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevenue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevenue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevenue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevenue = fmt.Errorf("proto: unexpected end of group")
+)
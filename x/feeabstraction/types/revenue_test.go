@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestFeeRevenueAddRouted tests the AddRouted method of FeeRevenue
+func TestFeeRevenueAddRouted(t *testing.T) {
+	// Route an amount to every destination
+	revenue := types.NewFeeRevenue("uatom").
+		AddRouted(types.RevenueDestinationFeeCollector, math.NewInt(1), false).
+		AddRouted(types.RevenueDestinationFeeCollector, math.NewInt(2), true).
+		AddRouted(types.RevenueDestinationCommunityPool, math.NewInt(3), true).
+		AddRouted(types.RevenueDestinationBurn, math.NewInt(4), true).
+		AddRouted(types.RevenueDestinationTreasury, math.NewInt(5), true)
+
+	// Check the tracked amounts
+	require.Equal(t, math.NewInt(1), revenue.FeeCollector)
+	require.Equal(t, math.NewInt(2), revenue.PendingSettlement)
+	require.Equal(t, math.NewInt(3), revenue.CommunityPool)
+	require.Equal(t, math.NewInt(4), revenue.Burned)
+	require.Equal(t, math.NewInt(5), revenue.Treasury)
+
+	// Settle part of the pending amount
+	revenue = revenue.AddSettled(math.NewInt(1), math.NewInt(10))
+	require.Equal(t, math.NewInt(1), revenue.PendingSettlement)
+	require.Equal(t, math.NewInt(1), revenue.Settled)
+	require.Equal(t, math.NewInt(10), revenue.SettledNative)
+}
+
+// TestFeeRevenueValidate tests the Validate method of FeeRevenue
+func TestFeeRevenueValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		revenue     func() types.FeeRevenue
+		errContains string
+	}{
+		{
+			name: "valid - empty revenue",
+			revenue: func() types.FeeRevenue {
+				return types.NewFeeRevenue("uatom")
+			},
+		},
+		{
+			name: "invalid - invalid denom",
+			revenue: func() types.FeeRevenue {
+				return types.NewFeeRevenue("")
+			},
+			errContains: "invalid denom",
+		},
+		{
+			name: "invalid - negative amount",
+			revenue: func() types.FeeRevenue {
+				revenue := types.NewFeeRevenue("uatom")
+				revenue.Burned = math.NewInt(-1)
+				return revenue
+			},
+			errContains: "invalid burned amount for uatom: -1",
+		},
+		{
+			name: "invalid - nil amount",
+			revenue: func() types.FeeRevenue {
+				return types.FeeRevenue{Denom: "uatom"}
+			},
+			errContains: "invalid fee collector amount for uatom",
+		},
+	}
+
+	// Run all the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.revenue().Validate()
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
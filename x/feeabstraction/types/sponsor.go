@@ -0,0 +1,208 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSponsorNonces is the max number of nonces used to derive an EVM contract address
+const MaxSponsorNonces = 20
+
+// SponsoredMsg defines the information of a msg checked against the sponsor policy
+type SponsoredMsg struct {
+	// TypeURL is the msg type URL
+	TypeURL string
+	// Contract is the contract called by the msg, empty if the msg isn't a contract call
+	Contract string
+}
+
+// NewSponsorPolicy creates a new SponsorPolicy instance
+func NewSponsorPolicy(
+	allowedMsgTypes, allowedContracts []string,
+	maxTxsPerUser uint64,
+	maxFeesPerUser, maxFeePerTx math.Int,
+	maxGasPrice math.LegacyDec,
+) SponsorPolicy {
+	return SponsorPolicy{
+		AllowedMsgTypes:  allowedMsgTypes,
+		AllowedContracts: allowedContracts,
+		MaxTxsPerUser:    maxTxsPerUser,
+		MaxFeesPerUser:   maxFeesPerUser,
+		MaxFeePerTx:      maxFeePerTx,
+		MaxGasPrice:      maxGasPrice,
+	}
+}
+
+// Validate validates the sponsor policy
+func (p SponsorPolicy) Validate() error {
+	// Validate the msg types
+	msgTypes := make(map[string]struct{})
+	for _, msgType := range p.AllowedMsgTypes {
+		if msgType == "" {
+			return errorsmod.Wrap(ErrInvalidSponsor, "allowed msg type can't be empty")
+		}
+		if _, exists := msgTypes[msgType]; exists {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "duplicate allowed msg type: %s", msgType)
+		}
+		msgTypes[msgType] = struct{}{}
+	}
+
+	// Validate the contracts
+	contracts := make(map[string]struct{})
+	for _, contract := range p.AllowedContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "invalid allowed contract %s: %s", contract, err)
+		}
+		if _, exists := contracts[contract]; exists {
+			return errorsmod.Wrapf(ErrInvalidSponsor, "duplicate allowed contract: %s", contract)
+		}
+		contracts[contract] = struct{}{}
+	}
+
+	// The policy must allow something, empty policies don't sponsor any tx
+	if len(p.AllowedMsgTypes) == 0 && len(p.AllowedContracts) == 0 {
+		return errorsmod.Wrap(ErrInvalidSponsor, "policy must allow at least one msg type or contract")
+	}
+
+	// Validate the quotas
+	if !p.MaxFeesPerUser.IsNil() && p.MaxFeesPerUser.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "max fees per user can't be negative: %s", p.MaxFeesPerUser)
+	}
+	if !p.MaxFeePerTx.IsNil() && p.MaxFeePerTx.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "max fee per tx can't be negative: %s", p.MaxFeePerTx)
+	}
+	if !p.MaxGasPrice.IsNil() && p.MaxGasPrice.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "max gas price can't be negative: %s", p.MaxGasPrice)
+	}
+
+	return nil
+}
+
+// Allows checks if the policy allows the sponsor to pay for the msgs
+// Every msg must call the sponsor contract or one of the allowed contracts, and have an allowed type if any is set
+// Empty policies allow nothing
+func (p SponsorPolicy) Allows(sponsor string, msgs []SponsoredMsg) error {
+	if len(p.AllowedMsgTypes) == 0 && len(p.AllowedContracts) == 0 {
+		return errorsmod.Wrap(ErrSponsorNotAllowed, "the sponsor policy allows no msgs")
+	}
+
+	for _, msg := range msgs {
+		// Check the msg type
+		if len(p.AllowedMsgTypes) > 0 && !slices.Contains(p.AllowedMsgTypes, msg.TypeURL) {
+			return errorsmod.Wrapf(ErrSponsorNotAllowed, "msg type %s is not allowed", msg.TypeURL)
+		}
+
+		// Check the called contract
+		if msg.Contract == "" || (msg.Contract != sponsor && !slices.Contains(p.AllowedContracts, msg.Contract)) {
+			return errorsmod.Wrapf(ErrSponsorNotAllowed, "msg %s doesn't call the sponsor or an allowed contract", msg.TypeURL)
+		}
+	}
+
+	return nil
+}
+
+// CheckFee checks if the policy allows sponsoring a tx with the given native fee and gas limit
+// The gas price is checked as fee / gas, so inflated priority fees are rejected along with high gas prices
+func (p SponsorPolicy) CheckFee(fee math.Int, gasLimit uint64) error {
+	// Check the fee of the tx
+	if !p.MaxFeePerTx.IsNil() && p.MaxFeePerTx.IsPositive() && fee.GT(p.MaxFeePerTx) {
+		return errorsmod.Wrapf(ErrSponsorNotAllowed, "fee %s is over the max fee per tx %s", fee, p.MaxFeePerTx)
+	}
+
+	// Check the gas price, comparing against the max fee for the gas limit to avoid rounding
+	if !p.MaxGasPrice.IsNil() && p.MaxGasPrice.IsPositive() {
+		maxFee := p.MaxGasPrice.MulInt(math.NewIntFromUint64(gasLimit))
+		if math.LegacyNewDecFromInt(fee).GT(maxFee) {
+			return errorsmod.Wrapf(ErrSponsorNotAllowed, "gas price of fee %s for %d gas is over the max gas price %s", fee, gasLimit, p.MaxGasPrice)
+		}
+	}
+
+	return nil
+}
+
+// HasMaxFeesPerUser returns true if the policy limits the fees sponsored for each user
+func (p SponsorPolicy) HasMaxFeesPerUser() bool {
+	return !p.MaxFeesPerUser.IsNil() && p.MaxFeesPerUser.IsPositive()
+}
+
+// NewSponsor creates a new Sponsor instance
+func NewSponsor(contract, admin string, policy SponsorPolicy) Sponsor {
+	return Sponsor{
+		Contract: contract,
+		Admin:    admin,
+		Policy:   policy,
+		Balance:  sdk.Coins{},
+		Spent:    sdk.Coins{},
+	}
+}
+
+// Validate validates the sponsor
+func (s Sponsor) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid contract address %s: %s", s.Contract, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Admin); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid admin address %s: %s", s.Admin, err)
+	}
+
+	// Validate the balances
+	if err := s.Balance.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid balance: %s", err)
+	}
+	if err := s.Spent.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid spent amount: %s", err)
+	}
+
+	// Validate the policy
+	return s.Policy.Validate()
+}
+
+// NewSponsorUsage creates a new empty SponsorUsage instance
+func NewSponsorUsage() SponsorUsage {
+	return SponsorUsage{
+		Fees: math.ZeroInt(),
+	}
+}
+
+// CheckQuota checks if the user quota allows sponsoring a tx with the given native fee
+func (u SponsorUsage) CheckQuota(policy SponsorPolicy, fee math.Int) error {
+	// Check the tx count
+	if policy.MaxTxsPerUser > 0 && u.TxCount >= policy.MaxTxsPerUser {
+		return errorsmod.Wrapf(ErrSponsorQuotaReached, "max of %d sponsored txs reached", policy.MaxTxsPerUser)
+	}
+
+	// Check the fees
+	if policy.HasMaxFeesPerUser() && u.getFees().Add(fee).GT(policy.MaxFeesPerUser) {
+		return errorsmod.Wrapf(ErrSponsorQuotaReached, "max of %s sponsored fees reached", policy.MaxFeesPerUser)
+	}
+
+	return nil
+}
+
+// Add adds a sponsored tx to the usage
+func (u SponsorUsage) Add(fee math.Int) SponsorUsage {
+	u.TxCount++
+	u.Fees = u.getFees().Add(fee)
+	return u
+}
+
+// Validate validates the sponsor usage
+func (u SponsorUsage) Validate() error {
+	if u.Fees.IsNil() || u.Fees.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidSponsor, "invalid sponsored fees: %s", u.Fees)
+	}
+	return nil
+}
+
+// getFees returns the sponsored fees, a nil value is treated as zero
+func (u SponsorUsage) getFees() math.Int {
+	if u.Fees.IsNil() {
+		return math.ZeroInt()
+	}
+	return u.Fees
+}
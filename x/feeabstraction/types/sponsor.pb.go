@@ -0,0 +1,1241 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabstraction/v1beta1/sponsor.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SponsorPolicy defines which txs a sponsor pays fees for
+type SponsorPolicy struct {
+	// AllowedMsgTypes are the msg type URLs the sponsor pays for
+	// Empty means any msg type
+	AllowedMsgTypes []string `protobuf:"bytes,1,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// AllowedContracts are the contracts the sponsored msgs can call besides
+	// the sponsor contract itself, all the msgs must be contract calls
+	// A policy must set at least one msg type or contract
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// MaxTxsPerUser is the max number of txs sponsored for each user
+	// Zero means no limit
+	MaxTxsPerUser uint64 `protobuf:"varint,3,opt,name=max_txs_per_user,json=maxTxsPerUser,proto3" json:"max_txs_per_user,omitempty"`
+	// MaxFeesPerUser is the max amount of native fees sponsored for each user
+	// Zero means no limit
+	MaxFeesPerUser cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_fees_per_user,json=maxFeesPerUser,proto3,customtype=cosmossdk.io/math.Int" json:"max_fees_per_user"`
+	// MaxFeePerTx is the max amount of native fees sponsored for a single tx
+	// Zero means no limit
+	MaxFeePerTx cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fee_per_tx,json=maxFeePerTx,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee_per_tx"`
+	// MaxGasPrice is the max native gas price sponsored, including priority fees
+	// Zero means no limit
+	MaxGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_gas_price,json=maxGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_gas_price"`
+}
+
+func (m *SponsorPolicy) Reset()         { *m = SponsorPolicy{} }
+func (m *SponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*SponsorPolicy) ProtoMessage()    {}
+func (*SponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48fc85fade71d0b8, []int{0}
+}
+func (m *SponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorPolicy.Merge(m, src)
+}
+func (m *SponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorPolicy proto.InternalMessageInfo
+
+func (m *SponsorPolicy) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *SponsorPolicy) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+func (m *SponsorPolicy) GetMaxTxsPerUser() uint64 {
+	if m != nil {
+		return m.MaxTxsPerUser
+	}
+	return 0
+}
+
+// Sponsor defines a contract that pays the fees of the txs that name it
+type Sponsor struct {
+	// Contract is the sponsor contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Admin is the account allowed to manage the sponsor
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// Policy defines which txs the sponsor pays for
+	Policy SponsorPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+	// Balance is the deposit left to pay fees
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// Spent is the total amount of fees paid by the sponsor
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// TxCount is the total number of txs paid by the sponsor
+	TxCount uint64 `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *Sponsor) Reset()         { *m = Sponsor{} }
+func (m *Sponsor) String() string { return proto.CompactTextString(m) }
+func (*Sponsor) ProtoMessage()    {}
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48fc85fade71d0b8, []int{1}
+}
+func (m *Sponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsor.Merge(m, src)
+}
+func (m *Sponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+
+func (m *Sponsor) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Sponsor) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Sponsor) GetPolicy() SponsorPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorPolicy{}
+}
+
+func (m *Sponsor) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *Sponsor) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *Sponsor) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+// SponsorUsage defines the usage of a sponsor by a single user
+type SponsorUsage struct {
+	// TxCount is the number of sponsored txs
+	TxCount uint64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// Fees is the amount of native fees sponsored
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+}
+
+func (m *SponsorUsage) Reset()         { *m = SponsorUsage{} }
+func (m *SponsorUsage) String() string { return proto.CompactTextString(m) }
+func (*SponsorUsage) ProtoMessage()    {}
+func (*SponsorUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48fc85fade71d0b8, []int{2}
+}
+func (m *SponsorUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorUsage.Merge(m, src)
+}
+func (m *SponsorUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorUsage proto.InternalMessageInfo
+
+func (m *SponsorUsage) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SponsorPolicy)(nil), "kiichain.feeabstraction.v1beta1.SponsorPolicy")
+	proto.RegisterType((*Sponsor)(nil), "kiichain.feeabstraction.v1beta1.Sponsor")
+	proto.RegisterType((*SponsorUsage)(nil), "kiichain.feeabstraction.v1beta1.SponsorUsage")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/sponsor.proto", fileDescriptor_48fc85fade71d0b8)
+}
+
+var fileDescriptor_48fc85fade71d0b8 = []byte{
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0x36, 0xdd, 0x1f, 0xef, 0xb7, 0xfd, 0x36, 0x6b, 0x48, 0xd9, 0x10, 0x69, 0x35,
+	0x0e, 0x54, 0xa0, 0x26, 0x74, 0xe3, 0x0d, 0xd0, 0x22, 0x06, 0xd2, 0x26, 0x55, 0x59, 0x77, 0x41,
+	0x48, 0x91, 0xe3, 0x78, 0xa9, 0xd5, 0xc6, 0x8e, 0x62, 0x17, 0xd2, 0x77, 0xc1, 0x6b, 0xe0, 0xc8,
+	0x99, 0x17, 0xb1, 0xe3, 0xc4, 0x09, 0x71, 0x18, 0xa8, 0xbd, 0xf0, 0x32, 0x50, 0x1c, 0x37, 0xac,
+	0x70, 0xd8, 0x0e, 0x9c, 0x62, 0xfb, 0xf9, 0x3e, 0x1f, 0xfb, 0x79, 0xfc, 0x8d, 0x41, 0x7b, 0x44,
+	0x29, 0x1e, 0x22, 0xca, 0xdc, 0x0b, 0x42, 0x50, 0x20, 0x64, 0x8a, 0xb0, 0xa4, 0x9c, 0xb9, 0xef,
+	0x3a, 0x01, 0x91, 0xa8, 0xe3, 0x8a, 0x84, 0x33, 0xc1, 0x53, 0x27, 0x49, 0xb9, 0xe4, 0xb0, 0xb1,
+	0x90, 0x3b, 0xcb, 0x72, 0x47, 0xcb, 0xf7, 0x77, 0x23, 0x1e, 0x71, 0xa5, 0x75, 0xf3, 0x51, 0x91,
+	0xb6, 0xbf, 0x87, 0xb9, 0x88, 0xb9, 0xf0, 0x8b, 0x40, 0x31, 0xd1, 0x21, 0xbb, 0x98, 0xb9, 0x01,
+	0x12, 0xa4, 0xdc, 0x14, 0x73, 0xca, 0x8a, 0xf8, 0xc1, 0xcf, 0x2a, 0xd8, 0x3c, 0x2b, 0xce, 0xd0,
+	0xe7, 0x63, 0x8a, 0xa7, 0xf0, 0x31, 0xd8, 0x41, 0xe3, 0x31, 0x7f, 0x4f, 0x42, 0x3f, 0x16, 0x91,
+	0x2f, 0xa7, 0x09, 0x11, 0x96, 0xd1, 0xac, 0xb5, 0xd6, 0xbd, 0xff, 0x75, 0xe0, 0x54, 0x44, 0x83,
+	0x7c, 0x19, 0x3e, 0xf9, 0xad, 0xc5, 0x9c, 0xa9, 0xd3, 0x0a, 0xab, 0xaa, 0xb4, 0xdb, 0x3a, 0xd0,
+	0x5b, 0xac, 0xc3, 0x47, 0x60, 0x3b, 0x46, 0x99, 0x2f, 0x33, 0xe1, 0x27, 0x24, 0xf5, 0x27, 0x82,
+	0xa4, 0x56, 0xad, 0x69, 0xb4, 0x4c, 0x6f, 0x33, 0x46, 0xd9, 0x20, 0x13, 0x7d, 0x92, 0x9e, 0x0b,
+	0x92, 0xc2, 0x57, 0x60, 0x27, 0x17, 0x5e, 0x10, 0x72, 0x43, 0x69, 0x36, 0x8d, 0xd6, 0x7a, 0xf7,
+	0xc1, 0xe5, 0x75, 0xa3, 0xf2, 0xed, 0xba, 0x71, 0xaf, 0x28, 0x4b, 0x84, 0x23, 0x87, 0x72, 0x37,
+	0x46, 0x72, 0xe8, 0xbc, 0x66, 0xd2, 0xdb, 0x8a, 0x51, 0xf6, 0x92, 0x90, 0x92, 0xd4, 0x05, 0x5b,
+	0x9a, 0xa4, 0x40, 0x32, 0xb3, 0xea, 0x77, 0xc1, 0x6c, 0x14, 0x98, 0x3e, 0x49, 0x07, 0x19, 0x3c,
+	0x06, 0xf9, 0xf1, 0xfc, 0x08, 0xe5, 0xfd, 0xa5, 0x98, 0x58, 0x2b, 0x0a, 0xf1, 0x50, 0x23, 0xee,
+	0xff, 0x8d, 0x38, 0x21, 0x11, 0xc2, 0xd3, 0x17, 0x04, 0x2b, 0xd0, 0x31, 0x12, 0xfd, 0x3c, 0xef,
+	0xe0, 0x63, 0x0d, 0xac, 0xea, 0x56, 0xc3, 0x67, 0x60, 0x6d, 0xd1, 0x30, 0xcb, 0x50, 0x3c, 0xeb,
+	0xcb, 0xe7, 0xf6, 0xae, 0xbe, 0xba, 0xe7, 0x61, 0x98, 0x12, 0x21, 0xce, 0x64, 0x4a, 0x59, 0xe4,
+	0x95, 0x4a, 0xe8, 0x80, 0x3a, 0x0a, 0x63, 0xca, 0xac, 0xea, 0x2d, 0x29, 0x85, 0x0c, 0x9e, 0x80,
+	0x95, 0x44, 0x5d, 0xaa, 0xea, 0xf3, 0xc6, 0xa1, 0xe3, 0xdc, 0xe2, 0x2f, 0x67, 0xc9, 0x0a, 0x5d,
+	0x33, 0xaf, 0xd1, 0xd3, 0x0c, 0x48, 0xc0, 0x6a, 0x80, 0xc6, 0x88, 0x61, 0x62, 0x99, 0xcd, 0x5a,
+	0x6b, 0xe3, 0x70, 0xcf, 0xd1, 0x9b, 0xe7, 0xe6, 0x2a, 0x11, 0x3d, 0x4e, 0x59, 0xf7, 0x69, 0x9e,
+	0xf9, 0xe9, 0x7b, 0xa3, 0x15, 0x51, 0x39, 0x9c, 0x04, 0x0e, 0xe6, 0xb1, 0xf6, 0xa5, 0xfe, 0xb4,
+	0x45, 0x38, 0x72, 0x95, 0xb7, 0x54, 0x82, 0xf0, 0x16, 0x6c, 0x88, 0x40, 0x5d, 0x24, 0x84, 0x49,
+	0xab, 0xfe, 0xef, 0x37, 0x29, 0xc8, 0x70, 0x0f, 0xac, 0xc9, 0xcc, 0xc7, 0x7c, 0xc2, 0xa4, 0xba,
+	0x4d, 0xd3, 0x5b, 0x95, 0x59, 0x2f, 0x9f, 0x1e, 0xbc, 0x05, 0xff, 0xe9, 0x1e, 0x9c, 0x0b, 0x14,
+	0x91, 0x25, 0xa9, 0xb1, 0x24, 0x85, 0x1d, 0x60, 0xe6, 0x16, 0xb5, 0xaa, 0x77, 0xb1, 0x94, 0x92,
+	0x76, 0x4f, 0x2f, 0x67, 0xb6, 0x71, 0x35, 0xb3, 0x8d, 0x1f, 0x33, 0xdb, 0xf8, 0x30, 0xb7, 0x2b,
+	0x57, 0x73, 0xbb, 0xf2, 0x75, 0x6e, 0x57, 0xde, 0x1c, 0xdd, 0xa8, 0xa1, 0x7c, 0x33, 0xca, 0x41,
+	0xf6, 0xe7, 0xf3, 0xa1, 0x8a, 0x0a, 0x56, 0xd4, 0x3f, 0x7c, 0xf4, 0x6b, 0x00, 0xb2, 0xd9, 0xe2,
+	0xc1, 0x66, 0x04, 0x00, 0x00,
+}
+
+func (m *SponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxGasPrice.Size()
+		i -= size
+		if _, err := m.MaxGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSponsor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxFeePerTx.Size()
+		i -= size
+		if _, err := m.MaxFeePerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSponsor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxFeesPerUser.Size()
+		i -= size
+		if _, err := m.MaxFeesPerUser.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSponsor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxTxsPerUser != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.MaxTxsPerUser))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintSponsor(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintSponsor(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSponsor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSponsor(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSponsor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TxCount != 0 {
+		i = encodeVarintSponsor(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if m.MaxTxsPerUser != 0 {
+		n += 1 + sovSponsor(uint64(m.MaxTxsPerUser))
+	}
+	l = m.MaxFeesPerUser.Size()
+	n += 1 + l + sovSponsor(uint64(l))
+	l = m.MaxFeePerTx.Size()
+	n += 1 + l + sovSponsor(uint64(l))
+	l = m.MaxGasPrice.Size()
+	n += 1 + l + sovSponsor(uint64(l))
+	return n
+}
+
+func (m *Sponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovSponsor(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovSponsor(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovSponsor(uint64(l))
+		}
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovSponsor(uint64(m.TxCount))
+	}
+	return n
+}
+
+func (m *SponsorUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		n += 1 + sovSponsor(uint64(m.TxCount))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovSponsor(uint64(l))
+	return n
+}
+
+func sovSponsor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsor(x uint64) (n int) {
+	return sovSponsor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SponsorPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerUser", wireType)
+			}
+			m.MaxTxsPerUser = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerUser |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeesPerUser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeesPerUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsor = fmt.Errorf("proto: unexpected end of group")
+)
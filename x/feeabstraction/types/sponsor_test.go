@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestSponsorPolicyValidate tests the Validate method of SponsorPolicy
+func TestSponsorPolicyValidate(t *testing.T) {
+	// The contract allowed by the policies
+	contract := authtypes.NewModuleAddress("contract").String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		policy      types.SponsorPolicy
+		errContains string
+	}{
+		{
+			name:   "valid - contract policy",
+			policy: types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+		},
+		{
+			name:   "valid - full policy",
+			policy: types.NewSponsorPolicy([]string{"/cosmos.bank.v1beta1.MsgSend"}, []string{contract}, 10, math.NewInt(1000), math.ZeroInt(), math.LegacyZeroDec()),
+		},
+		{
+			name:        "invalid - empty msg type",
+			policy:      types.NewSponsorPolicy([]string{""}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			errContains: "allowed msg type can't be empty",
+		},
+		{
+			name:        "invalid - duplicate msg type",
+			policy:      types.NewSponsorPolicy([]string{"/a", "/a"}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			errContains: "duplicate allowed msg type",
+		},
+		{
+			name:        "invalid - duplicate contract",
+			policy:      types.NewSponsorPolicy(nil, []string{contract, contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()),
+			errContains: "duplicate allowed contract",
+		},
+		{
+			name:        "invalid - negative max fees",
+			policy:      types.NewSponsorPolicy([]string{"/a"}, nil, 0, math.NewInt(-1), math.ZeroInt(), math.LegacyZeroDec()),
+			errContains: "max fees per user can't be negative",
+		},
+		{
+			name:        "invalid - negative max fee per tx",
+			policy:      types.NewSponsorPolicy([]string{"/a"}, nil, 0, math.ZeroInt(), math.NewInt(-1), math.LegacyZeroDec()),
+			errContains: "max fee per tx can't be negative",
+		},
+		{
+			name:        "invalid - negative max gas price",
+			policy:      types.NewSponsorPolicy([]string{"/a"}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyNewDec(-1)),
+			errContains: "max gas price can't be negative",
+		},
+		{
+			name:        "invalid - empty policy",
+			policy:      types.SponsorPolicy{},
+			errContains: "policy must allow at least one msg type or contract",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestSponsorPolicyAllows tests the Allows method of SponsorPolicy
+func TestSponsorPolicyAllows(t *testing.T) {
+	// The sponsor and the contract allowed by the policies
+	sponsor := authtypes.NewModuleAddress("sponsor").String()
+	contract := authtypes.NewModuleAddress("contract").String()
+	sponsorMsg := types.SponsoredMsg{TypeURL: "/cosmwasm.wasm.v1.MsgExecuteContract", Contract: sponsor}
+	execMsg := types.SponsoredMsg{TypeURL: "/cosmwasm.wasm.v1.MsgExecuteContract", Contract: contract}
+	sendMsg := types.SponsoredMsg{TypeURL: "/cosmos.bank.v1beta1.MsgSend"}
+
+	// Empty policies allow nothing
+	require.ErrorIs(t, types.SponsorPolicy{}.Allows(sponsor, []types.SponsoredMsg{sponsorMsg}), types.ErrSponsorNotAllowed)
+
+	// Msg types are checked for every msg and the msgs must call the sponsor
+	policy := types.NewSponsorPolicy([]string{execMsg.TypeURL, sendMsg.TypeURL}, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+	require.NoError(t, policy.Allows(sponsor, []types.SponsoredMsg{sponsorMsg}))
+	require.ErrorIs(t, policy.Allows(sponsor, []types.SponsoredMsg{sponsorMsg, sendMsg}), types.ErrSponsorNotAllowed)
+	require.ErrorIs(t, policy.Allows(sponsor, []types.SponsoredMsg{execMsg}), types.ErrSponsorNotAllowed)
+
+	// Allowed contracts can be called along the sponsor
+	policy = types.NewSponsorPolicy(nil, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+	require.NoError(t, policy.Allows(sponsor, []types.SponsoredMsg{sponsorMsg, execMsg}))
+	require.ErrorIs(t, policy.Allows(sponsor, []types.SponsoredMsg{sendMsg}), types.ErrSponsorNotAllowed)
+
+	// Allowed types must still call the sponsor or an allowed contract
+	policy = types.NewSponsorPolicy([]string{sendMsg.TypeURL}, []string{contract}, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec())
+	require.ErrorIs(t, policy.Allows(sponsor, []types.SponsoredMsg{sendMsg}), types.ErrSponsorNotAllowed)
+}
+
+// TestSponsorUsageCheckQuota tests the CheckQuota method of SponsorUsage
+func TestSponsorUsageCheckQuota(t *testing.T) {
+	// Usage with a single sponsored tx of 100
+	usage := types.NewSponsorUsage().Add(math.NewInt(100))
+	require.Equal(t, uint64(1), usage.TxCount)
+	require.Equal(t, math.NewInt(100), usage.Fees)
+
+	// No limits
+	require.NoError(t, usage.CheckQuota(types.SponsorPolicy{}, math.NewInt(1000)))
+
+	// Tx count limit
+	require.NoError(t, usage.CheckQuota(types.NewSponsorPolicy(nil, nil, 2, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()), math.NewInt(1)))
+	require.ErrorIs(t, usage.CheckQuota(types.NewSponsorPolicy(nil, nil, 1, math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()), math.NewInt(1)), types.ErrSponsorQuotaReached)
+
+	// Fee limit, the limit itself can be reached
+	require.NoError(t, usage.CheckQuota(types.NewSponsorPolicy(nil, nil, 0, math.NewInt(200), math.ZeroInt(), math.LegacyZeroDec()), math.NewInt(100)))
+	require.ErrorIs(t, usage.CheckQuota(types.NewSponsorPolicy(nil, nil, 0, math.NewInt(200), math.ZeroInt(), math.LegacyZeroDec()), math.NewInt(101)), types.ErrSponsorQuotaReached)
+}
+
+// TestSponsorPolicyCheckFee tests the CheckFee method of SponsorPolicy
+func TestSponsorPolicyCheckFee(t *testing.T) {
+	// No limits
+	require.NoError(t, types.SponsorPolicy{}.CheckFee(math.NewInt(1000), 0))
+
+	// Fee limit, the limit itself can be reached
+	policy := types.NewSponsorPolicy(nil, nil, 0, math.ZeroInt(), math.NewInt(100), math.LegacyZeroDec())
+	require.NoError(t, policy.CheckFee(math.NewInt(100), 10))
+	require.ErrorIs(t, policy.CheckFee(math.NewInt(101), 10), types.ErrSponsorNotAllowed)
+
+	// Gas price limit, the limit itself can be reached
+	policy = types.NewSponsorPolicy(nil, nil, 0, math.ZeroInt(), math.ZeroInt(), math.LegacyMustNewDecFromStr("2.5"))
+	require.NoError(t, policy.CheckFee(math.NewInt(25), 10))
+	require.ErrorIs(t, policy.CheckFee(math.NewInt(26), 10), types.ErrSponsorNotAllowed)
+	require.ErrorIs(t, policy.CheckFee(math.NewInt(1), 0), types.ErrSponsorNotAllowed)
+}
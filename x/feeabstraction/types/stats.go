@@ -0,0 +1,95 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxStatsRetentionDays is the max number of days the fee token statistics can be kept
+const MaxStatsRetentionDays = uint64(366)
+
+// FeePaymentSource defines how the fee token used to pay a fee was obtained
+type FeePaymentSource int
+
+const (
+	// FeePaymentSourceBalance is a fee paid with the bank balance of the token
+	FeePaymentSourceBalance FeePaymentSource = iota
+	// FeePaymentSourceERC20Unwrap is a fee paid by converting the ERC20 representation of the token
+	FeePaymentSourceERC20Unwrap
+	// FeePaymentSourceERC20Transfer is a fee paid by transferring an ERC20 fee token without a token pair
+	FeePaymentSourceERC20Transfer
+	// FeePaymentSourceSponsor is a fee paid by a fee sponsor
+	FeePaymentSourceSponsor
+)
+
+// NewFeeTokenStats creates a new empty FeeTokenStats instance
+func NewFeeTokenStats(denom string, day int64) FeeTokenStats {
+	return FeeTokenStats{
+		Denom:           denom,
+		Day:             day,
+		ConvertedAmount: math.ZeroInt(),
+		NativeValue:     math.ZeroInt(),
+	}
+}
+
+// Add adds a fee payment to the statistics
+func (s FeeTokenStats) Add(amount, nativeValue math.Int, source FeePaymentSource) FeeTokenStats {
+	s.TxCount++
+	s.ConvertedAmount = s.ConvertedAmount.Add(amount)
+	s.NativeValue = s.NativeValue.Add(nativeValue)
+
+	// Count the payment source
+	switch source {
+	case FeePaymentSourceERC20Unwrap:
+		s.ERC20Unwraps++
+	case FeePaymentSourceERC20Transfer:
+		s.ERC20Transfers++
+	case FeePaymentSourceSponsor:
+		s.SponsoredPayments++
+	default:
+		s.BalancePayments++
+	}
+
+	return s
+}
+
+// SubRefund removes a refunded part of a fee payment from the statistics
+// The payment is still counted, and the amounts never go below zero
+func (s FeeTokenStats) SubRefund(amount, nativeValue math.Int) FeeTokenStats {
+	s.ConvertedAmount = math.MaxInt(s.ConvertedAmount.Sub(amount), math.ZeroInt())
+	s.NativeValue = math.MaxInt(s.NativeValue.Sub(nativeValue), math.ZeroInt())
+	return s
+}
+
+// Validate validates the fee token statistics
+func (s FeeTokenStats) Validate() error {
+	// Validate the denom and the day
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "invalid denom %s: %s", s.Denom, err)
+	}
+	if s.Day < 0 {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "invalid day for %s: %d", s.Denom, s.Day)
+	}
+
+	// Validate the amounts
+	if s.ConvertedAmount.IsNil() || s.ConvertedAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "invalid converted amount for %s: %s", s.Denom, s.ConvertedAmount)
+	}
+	if s.NativeValue.IsNil() || s.NativeValue.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "invalid native value for %s: %s", s.Denom, s.NativeValue)
+	}
+
+	// Each tx is counted on a single payment source
+	if s.BalancePayments+s.ERC20Unwraps+s.ERC20Transfers+s.SponsoredPayments != s.TxCount {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenStats, "payment sources don't match the tx count for %s", s.Denom)
+	}
+
+	return nil
+}
+
+// GetStatsCutoffDay returns the first day kept with the retention, days before it are pruned
+func GetStatsCutoffDay(day int64, retentionDays uint64) int64 {
+	return day - int64(retentionDays) + 1
+}
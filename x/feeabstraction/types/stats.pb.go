@@ -0,0 +1,651 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabstraction/v1beta1/stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeTokenStats tracks the fees paid with a fee token during a day
+type FeeTokenStats struct {
+	// Denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Day is the day index, the unix time in seconds divided by a day
+	Day int64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// TxCount is the number of txs that paid fees with the token
+	TxCount uint64 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// ConvertedAmount is the amount of fee tokens charged
+	ConvertedAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=converted_amount,json=convertedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"converted_amount"`
+	// NativeValue is the value of the charged fee tokens on the native denom
+	NativeValue cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=native_value,json=nativeValue,proto3,customtype=cosmossdk.io/math.Int" json:"native_value"`
+	// BalancePayments is the number of txs paid with the bank balance of the
+	// token
+	BalancePayments uint64 `protobuf:"varint,6,opt,name=balance_payments,json=balancePayments,proto3" json:"balance_payments,omitempty"`
+	// ERC20Unwraps is the number of txs paid by converting the ERC20
+	// representation of the token
+	ERC20Unwraps uint64 `protobuf:"varint,7,opt,name=erc20_unwraps,json=erc20Unwraps,proto3" json:"erc20_unwraps,omitempty"`
+	// ERC20Transfers is the number of txs paid by transferring an ERC20 fee
+	// token without a token pair
+	ERC20Transfers uint64 `protobuf:"varint,8,opt,name=erc20_transfers,json=erc20Transfers,proto3" json:"erc20_transfers,omitempty"`
+	// SponsoredPayments is the number of txs paid by a fee sponsor
+	SponsoredPayments uint64 `protobuf:"varint,9,opt,name=sponsored_payments,json=sponsoredPayments,proto3" json:"sponsored_payments,omitempty"`
+}
+
+func (m *FeeTokenStats) Reset()         { *m = FeeTokenStats{} }
+func (m *FeeTokenStats) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenStats) ProtoMessage()    {}
+func (*FeeTokenStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d56d5f593d8ec12, []int{0}
+}
+func (m *FeeTokenStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenStats.Merge(m, src)
+}
+func (m *FeeTokenStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenStats proto.InternalMessageInfo
+
+func (m *FeeTokenStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeTokenStats) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *FeeTokenStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *FeeTokenStats) GetBalancePayments() uint64 {
+	if m != nil {
+		return m.BalancePayments
+	}
+	return 0
+}
+
+func (m *FeeTokenStats) GetERC20Unwraps() uint64 {
+	if m != nil {
+		return m.ERC20Unwraps
+	}
+	return 0
+}
+
+func (m *FeeTokenStats) GetERC20Transfers() uint64 {
+	if m != nil {
+		return m.ERC20Transfers
+	}
+	return 0
+}
+
+func (m *FeeTokenStats) GetSponsoredPayments() uint64 {
+	if m != nil {
+		return m.SponsoredPayments
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeeTokenStats)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenStats")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/stats.proto", fileDescriptor_7d56d5f593d8ec12)
+}
+
+var fileDescriptor_7d56d5f593d8ec12 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbb, 0x8e, 0xd3, 0x40,
+	0x14, 0x86, 0x63, 0xb2, 0xd7, 0x21, 0xbb, 0x09, 0xa3, 0x45, 0x32, 0x48, 0xd8, 0x11, 0x55, 0x10,
+	0xc2, 0xde, 0x8b, 0xa8, 0x68, 0x20, 0x2b, 0x10, 0x14, 0x48, 0xc8, 0x2c, 0x14, 0x34, 0xd6, 0x78,
+	0x7c, 0x36, 0xb1, 0xb2, 0x9e, 0xb1, 0x66, 0x8e, 0x4d, 0xf2, 0x16, 0x3c, 0x08, 0x0f, 0xb2, 0x65,
+	0x4a, 0x44, 0x61, 0x21, 0xe7, 0x45, 0x90, 0xc7, 0x8e, 0x11, 0x54, 0x74, 0xe7, 0xf2, 0x7d, 0x47,
+	0x7f, 0x71, 0xc8, 0xd3, 0x45, 0x92, 0xf0, 0x39, 0x4b, 0x84, 0x7f, 0x0d, 0xc0, 0x22, 0x8d, 0x8a,
+	0x71, 0x4c, 0xa4, 0xf0, 0x8b, 0xb3, 0x08, 0x90, 0x9d, 0xf9, 0x1a, 0x19, 0x6a, 0x2f, 0x53, 0x12,
+	0x25, 0x75, 0xb7, 0xb0, 0xf7, 0x37, 0xec, 0xb5, 0xf0, 0xc3, 0x93, 0x99, 0x9c, 0x49, 0xc3, 0xfa,
+	0x75, 0xd5, 0x68, 0x8f, 0xbf, 0xf7, 0xc9, 0xd1, 0x1b, 0x80, 0x2b, 0xb9, 0x00, 0xf1, 0xb1, 0x3e,
+	0x47, 0x4f, 0xc8, 0x6e, 0x0c, 0x42, 0xa6, 0xb6, 0x35, 0xb6, 0x26, 0x87, 0x41, 0xd3, 0xd0, 0x11,
+	0xe9, 0xc7, 0x6c, 0x65, 0xdf, 0x19, 0x5b, 0x93, 0x7e, 0x50, 0x97, 0xf4, 0x01, 0x39, 0xc0, 0x65,
+	0xc8, 0x65, 0x2e, 0xd0, 0xee, 0x8f, 0xad, 0xc9, 0x4e, 0xb0, 0x8f, 0xcb, 0xcb, 0xba, 0xa5, 0x6f,
+	0xc9, 0x88, 0x4b, 0x51, 0x80, 0x42, 0x88, 0x43, 0x96, 0x1a, 0x64, 0xa7, 0xbe, 0x36, 0x7d, 0x74,
+	0x5b, 0xba, 0xbd, 0x9f, 0xa5, 0x7b, 0x9f, 0x4b, 0x9d, 0x4a, 0xad, 0xe3, 0x85, 0x97, 0x48, 0x3f,
+	0x65, 0x38, 0xf7, 0xde, 0x09, 0x0c, 0x86, 0x9d, 0xf6, 0xca, 0x58, 0xf4, 0x25, 0x19, 0x08, 0x86,
+	0x49, 0x01, 0x61, 0xc1, 0x6e, 0x72, 0xb0, 0x77, 0xff, 0xe7, 0xca, 0xdd, 0x46, 0xf9, 0x5c, 0x1b,
+	0xf4, 0x09, 0x19, 0x45, 0xec, 0x86, 0x09, 0x0e, 0x61, 0xc6, 0x56, 0x29, 0x08, 0xd4, 0xf6, 0x9e,
+	0x89, 0x3b, 0x6c, 0xe7, 0x1f, 0xda, 0x31, 0x7d, 0x4e, 0x8e, 0x40, 0xf1, 0xf3, 0xd3, 0x30, 0x17,
+	0x5f, 0x15, 0xcb, 0xb4, 0xbd, 0x5f, 0x73, 0xd3, 0x51, 0x55, 0xba, 0x83, 0xd7, 0xc1, 0xe5, 0xf9,
+	0xe9, 0xa7, 0x66, 0x1e, 0x0c, 0x0c, 0xd6, 0x76, 0xf4, 0x05, 0x19, 0x36, 0x1a, 0x2a, 0x26, 0xf4,
+	0x35, 0x28, 0x6d, 0x1f, 0x18, 0x91, 0x56, 0xa5, 0x7b, 0x6c, 0xc4, 0xab, 0xed, 0x26, 0x38, 0x36,
+	0x68, 0xd7, 0xd3, 0x67, 0x84, 0xea, 0x4c, 0x0a, 0x2d, 0x15, 0xc4, 0x7f, 0x02, 0x1e, 0x9a, 0x80,
+	0xf7, 0xba, 0xcd, 0x36, 0xe2, 0xf4, 0xfd, 0x6d, 0xe5, 0x58, 0xeb, 0xca, 0xb1, 0x7e, 0x55, 0x8e,
+	0xf5, 0x6d, 0xe3, 0xf4, 0xd6, 0x1b, 0xa7, 0xf7, 0x63, 0xe3, 0xf4, 0xbe, 0x5c, 0xcc, 0x12, 0x9c,
+	0xe7, 0x91, 0xc7, 0x65, 0xea, 0x77, 0x7f, 0xd3, 0x15, 0xcb, 0x7f, 0x5f, 0x08, 0x57, 0x19, 0xe8,
+	0x68, 0xcf, 0x3c, 0xc1, 0xc5, 0xef, 0x01, 0x00, 0x9d, 0xfa, 0xd1, 0x41, 0x6a, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeTokenStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SponsoredPayments != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.SponsoredPayments))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ERC20Transfers != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.ERC20Transfers))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ERC20Unwraps != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.ERC20Unwraps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BalancePayments != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.BalancePayments))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.NativeValue.Size()
+		i -= size
+		if _, err := m.NativeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ConvertedAmount.Size()
+		i -= size
+		if _, err := m.ConvertedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TxCount != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Day != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeTokenStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovStats(uint64(m.Day))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovStats(uint64(m.TxCount))
+	}
+	l = m.ConvertedAmount.Size()
+	n += 1 + l + sovStats(uint64(l))
+	l = m.NativeValue.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if m.BalancePayments != 0 {
+		n += 1 + sovStats(uint64(m.BalancePayments))
+	}
+	if m.ERC20Unwraps != 0 {
+		n += 1 + sovStats(uint64(m.ERC20Unwraps))
+	}
+	if m.ERC20Transfers != 0 {
+		n += 1 + sovStats(uint64(m.ERC20Transfers))
+	}
+	if m.SponsoredPayments != 0 {
+		n += 1 + sovStats(uint64(m.SponsoredPayments))
+	}
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeTokenStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConvertedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalancePayments", wireType)
+			}
+			m.BalancePayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalancePayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20Unwraps", wireType)
+			}
+			m.ERC20Unwraps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ERC20Unwraps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20Transfers", wireType)
+			}
+			m.ERC20Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ERC20Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredPayments", wireType)
+			}
+			m.SponsoredPayments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsoredPayments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestFeeTokenStatsAdd tests the Add method of FeeTokenStats
+func TestFeeTokenStatsAdd(t *testing.T) {
+	// Add a payment from each source
+	stats := types.NewFeeTokenStats("uatom", 1).
+		Add(math.NewInt(100), math.NewInt(1000), types.FeePaymentSourceBalance).
+		Add(math.NewInt(200), math.NewInt(2000), types.FeePaymentSourceERC20Unwrap).
+		Add(math.NewInt(300), math.NewInt(3000), types.FeePaymentSourceERC20Transfer).
+		Add(math.NewInt(400), math.NewInt(4000), types.FeePaymentSourceSponsor)
+
+	// Check the totals
+	require.Equal(t, uint64(4), stats.TxCount)
+	require.Equal(t, math.NewInt(1000), stats.ConvertedAmount)
+	require.Equal(t, math.NewInt(10000), stats.NativeValue)
+
+	// Check each source
+	require.Equal(t, uint64(1), stats.BalancePayments)
+	require.Equal(t, uint64(1), stats.ERC20Unwraps)
+	require.Equal(t, uint64(1), stats.ERC20Transfers)
+	require.Equal(t, uint64(1), stats.SponsoredPayments)
+	require.NoError(t, stats.Validate())
+}
+
+// TestFeeTokenStatsSubRefund tests the SubRefund method of FeeTokenStats
+func TestFeeTokenStatsSubRefund(t *testing.T) {
+	// Refund part of a payment, the payment is still counted
+	stats := types.NewFeeTokenStats("uatom", 1).
+		Add(math.NewInt(100), math.NewInt(1000), types.FeePaymentSourceBalance).
+		SubRefund(math.NewInt(40), math.NewInt(400))
+	require.Equal(t, uint64(1), stats.TxCount)
+	require.Equal(t, math.NewInt(60), stats.ConvertedAmount)
+	require.Equal(t, math.NewInt(600), stats.NativeValue)
+	require.NoError(t, stats.Validate())
+
+	// The amounts never go below zero
+	stats = stats.SubRefund(math.NewInt(100), math.NewInt(1000))
+	require.True(t, stats.ConvertedAmount.IsZero())
+	require.True(t, stats.NativeValue.IsZero())
+	require.NoError(t, stats.Validate())
+}
+
+// TestFeeTokenStatsValidate tests the Validate method of FeeTokenStats
+func TestFeeTokenStatsValidate(t *testing.T) {
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		stats       func() types.FeeTokenStats
+		errContains string
+	}{
+		{
+			name:  "valid - empty stats",
+			stats: func() types.FeeTokenStats { return types.NewFeeTokenStats("uatom", 1) },
+		},
+		{
+			name: "invalid - bad denom",
+			stats: func() types.FeeTokenStats {
+				return types.NewFeeTokenStats("1", 1)
+			},
+			errContains: "invalid denom",
+		},
+		{
+			name: "invalid - negative day",
+			stats: func() types.FeeTokenStats {
+				return types.NewFeeTokenStats("uatom", -1)
+			},
+			errContains: "invalid day",
+		},
+		{
+			name: "invalid - nil native value",
+			stats: func() types.FeeTokenStats {
+				stats := types.NewFeeTokenStats("uatom", 1)
+				stats.NativeValue = math.Int{}
+				return stats
+			},
+			errContains: "invalid native value",
+		},
+		{
+			name: "invalid - sources don't match the tx count",
+			stats: func() types.FeeTokenStats {
+				stats := types.NewFeeTokenStats("uatom", 1).Add(math.OneInt(), math.OneInt(), types.FeePaymentSourceBalance)
+				stats.TxCount++
+				return stats
+			},
+			errContains: "payment sources don't match the tx count",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stats().Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestGetStatsCutoffDay tests the GetStatsCutoffDay function
+func TestGetStatsCutoffDay(t *testing.T) {
+	// A retention of 30 days keeps the current day and the 29 before it
+	require.Equal(t, int64(71), types.GetStatsCutoffDay(100, 30))
+	// A retention of a day keeps only the current day
+	require.Equal(t, int64(100), types.GetStatsCutoffDay(100, 1))
+	// No retention prunes the current day
+	require.Equal(t, int64(101), types.GetStatsCutoffDay(100, 0))
+}
@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -217,364 +220,5297 @@ func (m *MsgUpdateFeeTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateFeeTokensResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgUpdateFeeTokens)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens")
-	proto.RegisterType((*MsgUpdateFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokensResponse")
+// MsgSetPreferredFeeToken is the Msg/SetPreferredFeeToken request type.
+type MsgSetPreferredFeeToken struct {
+	// sender is the account setting the preference.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the preferred fee token, an empty denom clears the preference.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("kiichain/feeabstraction/v1beta1/tx.proto", fileDescriptor_6352be81da2292da)
+func (m *MsgSetPreferredFeeToken) Reset()         { *m = MsgSetPreferredFeeToken{} }
+func (m *MsgSetPreferredFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPreferredFeeToken) ProtoMessage()    {}
+func (*MsgSetPreferredFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{4}
 }
-
-var fileDescriptor_6352be81da2292da = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xde, 0xa9, 0x5a, 0xd8, 0x51, 0x28, 0x86, 0x42, 0xb7, 0xa1, 0xa4, 0xcb, 0x5e, 0x5c, 0x16,
-	0x37, 0xe3, 0x36, 0x20, 0xd2, 0x9e, 0x5c, 0xd1, 0x5b, 0x40, 0x56, 0xbd, 0x78, 0xa9, 0x93, 0xe4,
-	0x25, 0x3b, 0xb4, 0xc9, 0x84, 0xcc, 0x6c, 0x69, 0x3d, 0x89, 0xde, 0x3c, 0x88, 0x3f, 0x65, 0x0f,
-	0xfe, 0x01, 0x6f, 0x3d, 0x16, 0x4f, 0x9e, 0x44, 0x36, 0x87, 0xfd, 0x1b, 0xd2, 0xcc, 0x24, 0xa5,
-	0xa9, 0x10, 0xdd, 0x4b, 0x32, 0xc9, 0xfb, 0xbe, 0xef, 0x7d, 0xdf, 0x1b, 0x1e, 0xee, 0x1f, 0x31,
-	0xe6, 0x4f, 0x29, 0x4b, 0x48, 0x08, 0x40, 0x3d, 0x21, 0x33, 0xea, 0x4b, 0xc6, 0x13, 0x72, 0x32,
-	0xf2, 0x40, 0xd2, 0x11, 0x91, 0xa7, 0x76, 0x9a, 0x71, 0xc9, 0x8d, 0xdd, 0x12, 0x69, 0x5f, 0x47,
-	0xda, 0x1a, 0x69, 0x6e, 0x46, 0x3c, 0xe2, 0x05, 0x96, 0x5c, 0x9e, 0x14, 0xcd, 0xdc, 0xf2, 0xb9,
-	0x88, 0xb9, 0x20, 0xb1, 0x88, 0xc8, 0xc9, 0xe8, 0xf2, 0xa5, 0x0b, 0x0f, 0x9b, 0x3a, 0xa7, 0x34,
-	0xa3, 0xb1, 0xd0, 0xe8, 0xfb, 0x34, 0x66, 0x09, 0x27, 0xc5, 0x53, 0xff, 0xda, 0x56, 0xca, 0x87,
-	0xaa, 0xa5, 0xfa, 0x50, 0xa5, 0xde, 0x77, 0x84, 0x37, 0x5c, 0x11, 0xbd, 0x49, 0x03, 0x2a, 0xe1,
-	0x65, 0xa1, 0x63, 0x3c, 0xc6, 0x6d, 0x3a, 0x93, 0x53, 0x9e, 0x31, 0x79, 0xd6, 0x41, 0x5d, 0xd4,
-	0x6f, 0x8f, 0x3b, 0x3f, 0xbe, 0x0d, 0x37, 0x35, 0xf1, 0x69, 0x10, 0x64, 0x20, 0xc4, 0x2b, 0x99,
-	0xb1, 0x24, 0x9a, 0x5c, 0x41, 0x8d, 0xe7, 0x78, 0x5d, 0x39, 0xe9, 0xac, 0x75, 0x51, 0xff, 0xee,
-	0xde, 0x03, 0xbb, 0x61, 0x10, 0xb6, 0x6a, 0x38, 0xbe, 0x7d, 0xfe, 0x6b, 0xb7, 0x35, 0xd1, 0xe4,
-	0x7d, 0xf2, 0x71, 0x39, 0x1f, 0x5c, 0xc9, 0x7e, 0x5e, 0xce, 0x07, 0x3b, 0xb5, 0xe0, 0xb3, 0xc2,
-	0xee, 0x50, 0x11, 0x7a, 0xdb, 0x78, 0xab, 0x16, 0x61, 0x02, 0x22, 0xe5, 0x89, 0x80, 0x5e, 0x8e,
-	0xb0, 0x51, 0xd5, 0x5e, 0x00, 0xbc, 0xe6, 0x47, 0x90, 0xac, 0x9e, 0xf0, 0x1d, 0xc6, 0x21, 0xc0,
-	0xa1, 0x2c, 0x54, 0x74, 0xca, 0x83, 0xc6, 0x94, 0x65, 0x5f, 0x17, 0x24, 0x0d, 0xa8, 0xa4, 0xcf,
-	0xf8, 0xf1, 0x31, 0x14, 0x10, 0x9d, 0xbc, 0x1d, 0x96, 0xce, 0xf6, 0x9d, 0x9b, 0xe1, 0xbb, 0x7f,
-	0x0f, 0x1f, 0x02, 0x0c, 0x95, 0x91, 0xde, 0x0e, 0x36, 0x6f, 0x86, 0x2c, 0x67, 0xb0, 0xf7, 0x65,
-	0x0d, 0xdf, 0x72, 0x45, 0x64, 0xbc, 0xc7, 0xf7, 0xae, 0x5d, 0xf3, 0xa3, 0x46, 0xe3, 0xb5, 0xa9,
-	0x9a, 0x4f, 0xfe, 0x97, 0x51, 0x7a, 0x30, 0x3e, 0x21, 0xbc, 0x51, 0xbf, 0x04, 0xe7, 0xdf, 0xd5,
-	0x2a, 0x92, 0x79, 0xb0, 0x02, 0xa9, 0x74, 0x61, 0xde, 0xf9, 0xb0, 0x9c, 0x0f, 0xd0, 0xd8, 0x3d,
-	0x5f, 0x58, 0xe8, 0x62, 0x61, 0xa1, 0xdf, 0x0b, 0x0b, 0x7d, 0xcd, 0xad, 0xd6, 0x45, 0x6e, 0xb5,
-	0x7e, 0xe6, 0x56, 0xeb, 0xad, 0x13, 0x31, 0x39, 0x9d, 0x79, 0xb6, 0xcf, 0x63, 0x52, 0x2d, 0x5d,
-	0x75, 0x38, 0xad, 0xef, 0x9f, 0x3c, 0x4b, 0x41, 0x78, 0xeb, 0xc5, 0x26, 0x39, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0xbd, 0xb3, 0xe2, 0x21, 0x04, 0x00, 0x00,
+func (m *MsgSetPreferredFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the
-	// x/feeabstraction params
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// UpdateFeeTokens defines a governance operation for updating the fee tokens
-	UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error)
+func (m *MsgSetPreferredFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPreferredFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetPreferredFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPreferredFeeToken.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetPreferredFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPreferredFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPreferredFeeToken.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPreferredFeeToken proto.InternalMessageInfo
+
+func (m *MsgSetPreferredFeeToken) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error) {
-	out := new(MsgUpdateFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPreferredFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the
-	// x/feeabstraction params
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// UpdateFeeTokens defines a governance operation for updating the fee tokens
-	UpdateFeeTokens(context.Context, *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error)
+// MsgSetPreferredFeeTokenResponse defines the response structure for executing
+// a MsgSetPreferredFeeToken message.
+type MsgSetPreferredFeeTokenResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetPreferredFeeTokenResponse) Reset()         { *m = MsgSetPreferredFeeTokenResponse{} }
+func (m *MsgSetPreferredFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPreferredFeeTokenResponse) ProtoMessage()    {}
+func (*MsgSetPreferredFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{5}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetPreferredFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateFeeTokens(ctx context.Context, req *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokens not implemented")
+func (m *MsgSetPreferredFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPreferredFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPreferredFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPreferredFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgSetPreferredFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPreferredFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPreferredFeeTokenResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetPreferredFeeTokenResponse proto.InternalMessageInfo
+
+// MsgAddFeeToken is the Msg/AddFeeToken request type.
+type MsgAddFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fee_token defines the fee token to add.
+	FeeToken FeeTokenMetadata `protobuf:"bytes,2,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAddFeeToken) Reset()         { *m = MsgAddFeeToken{} }
+func (m *MsgAddFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeToken) ProtoMessage()    {}
+func (*MsgAddFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{6}
+}
+func (m *MsgAddFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeToken.Merge(m, src)
+}
+func (m *MsgAddFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeToken.DiscardUnknown(m)
 }
 
-func _Msg_UpdateFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateFeeTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateFeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens",
+var xxx_messageInfo_MsgAddFeeToken proto.InternalMessageInfo
+
+func (m *MsgAddFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateFeeTokens(ctx, req.(*MsgUpdateFeeTokens))
+	return ""
+}
+
+func (m *MsgAddFeeToken) GetFeeToken() FeeTokenMetadata {
+	if m != nil {
+		return m.FeeToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return FeeTokenMetadata{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.feeabstraction.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "UpdateFeeTokens",
-			Handler:    _Msg_UpdateFeeTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/feeabstraction/v1beta1/tx.proto",
+// MsgAddFeeTokenResponse defines the response structure for executing a
+// MsgAddFeeToken message.
+type MsgAddFeeTokenResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddFeeTokenResponse) Reset()         { *m = MsgAddFeeTokenResponse{} }
+func (m *MsgAddFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeTokenResponse) ProtoMessage()    {}
+func (*MsgAddFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{7}
+}
+func (m *MsgAddFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgAddFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddFeeTokenResponse proto.InternalMessageInfo
+
+// MsgRegisterFeeToken is the Msg/RegisterFeeToken request type.
+type MsgRegisterFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token is the ERC20 contract or the denom of a token pair, or an IBC
+	// denom.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// oracle_denom is the oracle vote target pricing the token.
+	OracleDenom string `protobuf:"bytes,3,opt,name=oracle_denom,json=oracleDenom,proto3" json:"oracle_denom,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterFeeToken) Reset()         { *m = MsgRegisterFeeToken{} }
+func (m *MsgRegisterFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeToken) ProtoMessage()    {}
+func (*MsgRegisterFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{8}
+}
+func (m *MsgRegisterFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeToken.Merge(m, src)
+}
+func (m *MsgRegisterFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeToken.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterFeeToken proto.InternalMessageInfo
+
+func (m *MsgRegisterFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterFeeToken) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterFeeToken) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
+	}
+	return ""
 }
 
-func (m *MsgUpdateFeeTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgRegisterFeeTokenResponse defines the response structure for executing a
+// MsgRegisterFeeToken message.
+type MsgRegisterFeeTokenResponse struct {
+	// fee_token is the registered fee token.
+	FeeToken FeeTokenMetadata `protobuf:"bytes,1,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
 }
 
-func (m *MsgUpdateFeeTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterFeeTokenResponse) Reset()         { *m = MsgRegisterFeeTokenResponse{} }
+func (m *MsgRegisterFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterFeeTokenResponse) ProtoMessage()    {}
+func (*MsgRegisterFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{9}
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgRegisterFeeTokenResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterFeeTokenResponse) GetFeeToken() FeeTokenMetadata {
+	if m != nil {
+		return m.FeeToken
+	}
+	return FeeTokenMetadata{}
+}
+
+// MsgRemoveFeeToken is the Msg/RemoveFeeToken request type.
+type MsgRemoveFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the fee token to remove.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveFeeToken) Reset()         { *m = MsgRemoveFeeToken{} }
+func (m *MsgRemoveFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeToken) ProtoMessage()    {}
+func (*MsgRemoveFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{10}
+}
+func (m *MsgRemoveFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRemoveFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeToken.Merge(m, src)
+}
+func (m *MsgRemoveFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeToken proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveFeeTokenResponse defines the response structure for executing a
+// MsgRemoveFeeToken message.
+type MsgRemoveFeeTokenResponse struct {
 }
 
-func (m *MsgUpdateFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveFeeTokenResponse) Reset()         { *m = MsgRemoveFeeTokenResponse{} }
+func (m *MsgRemoveFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokenResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{11}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgRemoveFeeTokenResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokenEnabled is the Msg/SetFeeTokenEnabled request type.
+type MsgSetFeeTokenEnabled struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the fee token to update.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled indicates if the fee token is enabled.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetFeeTokenEnabled) Reset()         { *m = MsgSetFeeTokenEnabled{} }
+func (m *MsgSetFeeTokenEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenEnabled) ProtoMessage()    {}
+func (*MsgSetFeeTokenEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{12}
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeTokenEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenEnabled.Merge(m, src)
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenEnabled proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetFeeTokenEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetFeeTokenEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetFeeTokenEnabledResponse defines the response structure for executing a
+// MsgSetFeeTokenEnabled message.
+type MsgSetFeeTokenEnabledResponse struct {
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Reset()         { *m = MsgSetFeeTokenEnabledResponse{} }
+func (m *MsgSetFeeTokenEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenEnabledResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{13}
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenEnabledResponse proto.InternalMessageInfo
+
+// MsgRegisterSponsor is the Msg/RegisterSponsor request type.
+type MsgRegisterSponsor struct {
+	// sender is the contract admin, the wasm contract itself or the EVM contract
+	// deployer. The sender becomes the sponsor admin.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the address of the wasm or EVM contract to register.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// nonces are the deployer nonces used to derive the EVM contract address,
+	// the last nonce is the one used to deploy the contract. Ignored for wasm
+	// contracts.
+	Nonces []uint64 `protobuf:"varint,3,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	// deposit is the initial sponsor deposit.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// policy defines which txs the sponsor pays for.
+	Policy SponsorPolicy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgRegisterSponsor) Reset()         { *m = MsgRegisterSponsor{} }
+func (m *MsgRegisterSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSponsor) ProtoMessage()    {}
+func (*MsgRegisterSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{14}
+}
+func (m *MsgRegisterSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSponsor.Merge(m, src)
+}
+func (m *MsgRegisterSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSponsor proto.InternalMessageInfo
+
+func (m *MsgRegisterSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterSponsor) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgRegisterSponsor) GetNonces() []uint64 {
+	if m != nil {
+		return m.Nonces
+	}
+	return nil
+}
+
+func (m *MsgRegisterSponsor) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *MsgRegisterSponsor) GetPolicy() SponsorPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorPolicy{}
+}
+
+// MsgRegisterSponsorResponse defines the response structure for executing a
+// MsgRegisterSponsor message.
+type MsgRegisterSponsorResponse struct {
+}
+
+func (m *MsgRegisterSponsorResponse) Reset()         { *m = MsgRegisterSponsorResponse{} }
+func (m *MsgRegisterSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSponsorResponse) ProtoMessage()    {}
+func (*MsgRegisterSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{15}
+}
+func (m *MsgRegisterSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSponsorResponse.Merge(m, src)
+}
+func (m *MsgRegisterSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSponsorResponse proto.InternalMessageInfo
+
+// MsgUpdateSponsorPolicy is the Msg/UpdateSponsorPolicy request type.
+type MsgUpdateSponsorPolicy struct {
+	// sender is the sponsor admin.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the sponsor contract address.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// policy is the new sponsor policy.
+	Policy SponsorPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgUpdateSponsorPolicy) Reset()         { *m = MsgUpdateSponsorPolicy{} }
+func (m *MsgUpdateSponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsorPolicy) ProtoMessage()    {}
+func (*MsgUpdateSponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{16}
+}
+func (m *MsgUpdateSponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsorPolicy.Merge(m, src)
+}
+func (m *MsgUpdateSponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsorPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateSponsorPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateSponsorPolicy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgUpdateSponsorPolicy) GetPolicy() SponsorPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SponsorPolicy{}
+}
+
+// MsgUpdateSponsorPolicyResponse defines the response structure for executing
+// a MsgUpdateSponsorPolicy message.
+type MsgUpdateSponsorPolicyResponse struct {
+}
+
+func (m *MsgUpdateSponsorPolicyResponse) Reset()         { *m = MsgUpdateSponsorPolicyResponse{} }
+func (m *MsgUpdateSponsorPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSponsorPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateSponsorPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{17}
+}
+func (m *MsgUpdateSponsorPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSponsorPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSponsorPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSponsorPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSponsorPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateSponsorPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSponsorPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSponsorPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSponsorPolicyResponse proto.InternalMessageInfo
+
+// MsgFundSponsor is the Msg/FundSponsor request type.
+type MsgFundSponsor struct {
+	// sender is the account funding the sponsor.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the sponsor contract address.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount is the amount added to the sponsor balance.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundSponsor) Reset()         { *m = MsgFundSponsor{} }
+func (m *MsgFundSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsor) ProtoMessage()    {}
+func (*MsgFundSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{18}
+}
+func (m *MsgFundSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsor.Merge(m, src)
+}
+func (m *MsgFundSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsor proto.InternalMessageInfo
+
+func (m *MsgFundSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFundSponsor) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgFundSponsor) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundSponsorResponse defines the response structure for executing a
+// MsgFundSponsor message.
+type MsgFundSponsorResponse struct {
+}
+
+func (m *MsgFundSponsorResponse) Reset()         { *m = MsgFundSponsorResponse{} }
+func (m *MsgFundSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorResponse) ProtoMessage()    {}
+func (*MsgFundSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{19}
+}
+func (m *MsgFundSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorResponse.Merge(m, src)
+}
+func (m *MsgFundSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorResponse proto.InternalMessageInfo
+
+// MsgWithdrawSponsorFunds is the Msg/WithdrawSponsorFunds request type.
+type MsgWithdrawSponsorFunds struct {
+	// sender is the sponsor admin, the funds are sent to this account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the sponsor contract address.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount is the amount withdrawn from the sponsor balance.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawSponsorFunds) Reset()         { *m = MsgWithdrawSponsorFunds{} }
+func (m *MsgWithdrawSponsorFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorFunds) ProtoMessage()    {}
+func (*MsgWithdrawSponsorFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{20}
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorFunds.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorFunds proto.InternalMessageInfo
+
+func (m *MsgWithdrawSponsorFunds) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorFunds) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorFunds) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawSponsorFundsResponse defines the response structure for executing
+// a MsgWithdrawSponsorFunds message.
+type MsgWithdrawSponsorFundsResponse struct {
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Reset()         { *m = MsgWithdrawSponsorFundsResponse{} }
+func (m *MsgWithdrawSponsorFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorFundsResponse) ProtoMessage()    {}
+func (*MsgWithdrawSponsorFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{21}
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorFundsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorFundsResponse proto.InternalMessageInfo
+
+// MsgSweepERC20Fees is the Msg/SweepERC20Fees request type.
+type MsgSweepERC20Fees struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the ERC20 contract address of the fee token.
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// recipient is the account receiving the fees held by the module address.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgSweepERC20Fees) Reset()         { *m = MsgSweepERC20Fees{} }
+func (m *MsgSweepERC20Fees) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepERC20Fees) ProtoMessage()    {}
+func (*MsgSweepERC20Fees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{22}
+}
+func (m *MsgSweepERC20Fees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepERC20Fees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepERC20Fees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepERC20Fees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepERC20Fees.Merge(m, src)
+}
+func (m *MsgSweepERC20Fees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepERC20Fees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepERC20Fees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepERC20Fees proto.InternalMessageInfo
+
+func (m *MsgSweepERC20Fees) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSweepERC20Fees) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSweepERC20Fees) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgSweepERC20FeesResponse defines the response structure for executing a
+// MsgSweepERC20Fees message.
+type MsgSweepERC20FeesResponse struct {
+	// amount is the amount of the ERC20 fee token sent to the recipient.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgSweepERC20FeesResponse) Reset()         { *m = MsgSweepERC20FeesResponse{} }
+func (m *MsgSweepERC20FeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepERC20FeesResponse) ProtoMessage()    {}
+func (*MsgSweepERC20FeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{23}
+}
+func (m *MsgSweepERC20FeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepERC20FeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepERC20FeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepERC20FeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepERC20FeesResponse.Merge(m, src)
+}
+func (m *MsgSweepERC20FeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepERC20FeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepERC20FeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepERC20FeesResponse proto.InternalMessageInfo
+
+// MsgWithdrawRevenue is the Msg/WithdrawRevenue request type.
+type MsgWithdrawRevenue struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// account is the module account the funds are withdrawn from, treasury or
+	// reserve.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// recipient is the account receiving the funds.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount withdrawn from the module account.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawRevenue) Reset()         { *m = MsgWithdrawRevenue{} }
+func (m *MsgWithdrawRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenue) ProtoMessage()    {}
+func (*MsgWithdrawRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{24}
+}
+func (m *MsgWithdrawRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenue.Merge(m, src)
+}
+func (m *MsgWithdrawRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenue proto.InternalMessageInfo
+
+func (m *MsgWithdrawRevenue) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRevenue) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRevenue) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawRevenueResponse defines the response structure for executing a
+// MsgWithdrawRevenue message.
+type MsgWithdrawRevenueResponse struct {
+}
+
+func (m *MsgWithdrawRevenueResponse) Reset()         { *m = MsgWithdrawRevenueResponse{} }
+func (m *MsgWithdrawRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenueResponse) ProtoMessage()    {}
+func (*MsgWithdrawRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{25}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenueResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateFeeTokens)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens")
+	proto.RegisterType((*MsgUpdateFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokensResponse")
+	proto.RegisterType((*MsgSetPreferredFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgSetPreferredFeeToken")
+	proto.RegisterType((*MsgSetPreferredFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgSetPreferredFeeTokenResponse")
+	proto.RegisterType((*MsgAddFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgAddFeeToken")
+	proto.RegisterType((*MsgAddFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgAddFeeTokenResponse")
+	proto.RegisterType((*MsgRegisterFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgRegisterFeeToken")
+	proto.RegisterType((*MsgRegisterFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgRegisterFeeTokenResponse")
+	proto.RegisterType((*MsgRemoveFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgRemoveFeeToken")
+	proto.RegisterType((*MsgRemoveFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgRemoveFeeTokenResponse")
+	proto.RegisterType((*MsgSetFeeTokenEnabled)(nil), "kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabled")
+	proto.RegisterType((*MsgSetFeeTokenEnabledResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabledResponse")
+	proto.RegisterType((*MsgRegisterSponsor)(nil), "kiichain.feeabstraction.v1beta1.MsgRegisterSponsor")
+	proto.RegisterType((*MsgRegisterSponsorResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgRegisterSponsorResponse")
+	proto.RegisterType((*MsgUpdateSponsorPolicy)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateSponsorPolicy")
+	proto.RegisterType((*MsgUpdateSponsorPolicyResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateSponsorPolicyResponse")
+	proto.RegisterType((*MsgFundSponsor)(nil), "kiichain.feeabstraction.v1beta1.MsgFundSponsor")
+	proto.RegisterType((*MsgFundSponsorResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgFundSponsorResponse")
+	proto.RegisterType((*MsgWithdrawSponsorFunds)(nil), "kiichain.feeabstraction.v1beta1.MsgWithdrawSponsorFunds")
+	proto.RegisterType((*MsgWithdrawSponsorFundsResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgWithdrawSponsorFundsResponse")
+	proto.RegisterType((*MsgSweepERC20Fees)(nil), "kiichain.feeabstraction.v1beta1.MsgSweepERC20Fees")
+	proto.RegisterType((*MsgSweepERC20FeesResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgSweepERC20FeesResponse")
+	proto.RegisterType((*MsgWithdrawRevenue)(nil), "kiichain.feeabstraction.v1beta1.MsgWithdrawRevenue")
+	proto.RegisterType((*MsgWithdrawRevenueResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgWithdrawRevenueResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/tx.proto", fileDescriptor_6352be81da2292da)
+}
+
+var fileDescriptor_6352be81da2292da = []byte{
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xce, 0xda, 0x89, 0x5b, 0x4f, 0xfa, 0x6b, 0x7f, 0xdd, 0xa6, 0xad, 0xb3, 0x6d, 0x6d, 0xd7,
+	0x2a, 0x60, 0x15, 0x6c, 0x27, 0x76, 0x9a, 0x20, 0x07, 0x41, 0x9b, 0x90, 0x48, 0x48, 0x58, 0x8a,
+	0x36, 0x45, 0x48, 0x5c, 0xc2, 0x7a, 0xf7, 0xd9, 0x59, 0x25, 0xde, 0xb1, 0x76, 0xd6, 0x49, 0xc3,
+	0xa9, 0x02, 0xc4, 0xa1, 0x42, 0x02, 0x21, 0x71, 0xe0, 0xc0, 0x3f, 0xc0, 0x29, 0x07, 0x24, 0x38,
+	0x70, 0xe1, 0xd6, 0x1b, 0x15, 0x5c, 0x10, 0x87, 0x82, 0x12, 0x89, 0xfc, 0x0f, 0x9c, 0xd0, 0xce,
+	0xcc, 0x8e, 0xd7, 0xeb, 0xad, 0xbc, 0x5e, 0x42, 0x0f, 0x5c, 0x12, 0x8f, 0xe7, 0x7d, 0x33, 0xdf,
+	0xf7, 0xbd, 0x37, 0xfb, 0x66, 0x8d, 0x8a, 0x3b, 0xa6, 0xa9, 0x6f, 0x6b, 0xa6, 0x55, 0x69, 0x01,
+	0x68, 0x4d, 0xe2, 0xd8, 0x9a, 0xee, 0x98, 0xd8, 0xaa, 0xec, 0xcd, 0x37, 0xc1, 0xd1, 0xe6, 0x2b,
+	0xce, 0x83, 0x72, 0xd7, 0xc6, 0x0e, 0x96, 0x73, 0x5e, 0x64, 0x79, 0x30, 0xb2, 0xcc, 0x23, 0x95,
+	0x99, 0x36, 0x6e, 0x63, 0x1a, 0x5b, 0x71, 0x3f, 0x31, 0x98, 0x72, 0x55, 0xc7, 0xa4, 0x83, 0x49,
+	0xa5, 0x43, 0xda, 0x95, 0xbd, 0x79, 0xf7, 0x1f, 0x9f, 0x78, 0x65, 0xd4, 0xce, 0x5d, 0xcd, 0xd6,
+	0x3a, 0x84, 0x47, 0x5f, 0xd4, 0x3a, 0xa6, 0x85, 0x2b, 0xf4, 0x2f, 0xff, 0x6a, 0x96, 0xad, 0xbc,
+	0xc5, 0xb6, 0x64, 0x03, 0x3e, 0x95, 0xe5, 0x9b, 0x36, 0x35, 0x02, 0x62, 0x3d, 0x1d, 0x9b, 0x16,
+	0x9f, 0x2f, 0x8d, 0xda, 0x9b, 0x74, 0xb1, 0x45, 0xb0, 0xcd, 0xc2, 0x0b, 0x3f, 0x4a, 0xe8, 0x42,
+	0x83, 0xb4, 0xdf, 0xe9, 0x1a, 0x9a, 0x03, 0x1b, 0x94, 0x96, 0xbc, 0x88, 0xd2, 0x5a, 0xcf, 0xd9,
+	0xc6, 0xb6, 0xe9, 0x1c, 0x64, 0xa4, 0xbc, 0x54, 0x4c, 0xaf, 0x64, 0x7e, 0xfe, 0xb6, 0x34, 0xc3,
+	0x79, 0xdc, 0x33, 0x0c, 0x1b, 0x08, 0xd9, 0x74, 0x6c, 0xd3, 0x6a, 0xab, 0xfd, 0x50, 0x79, 0x0d,
+	0xa5, 0x98, 0xb0, 0x4c, 0x22, 0x2f, 0x15, 0xa7, 0xab, 0x2f, 0x95, 0x47, 0xf8, 0x5a, 0x66, 0x1b,
+	0xae, 0x4c, 0x3e, 0x7e, 0x9a, 0x9b, 0x50, 0x39, 0xb8, 0x5e, 0xf9, 0xf0, 0xe4, 0xf0, 0x76, 0x7f,
+	0xd9, 0x47, 0x27, 0x87, 0xb7, 0xaf, 0x07, 0xb4, 0xf4, 0x28, 0xdd, 0x12, 0x03, 0x14, 0x66, 0xd1,
+	0xd5, 0x80, 0x04, 0x15, 0xa8, 0x4a, 0x28, 0x1c, 0x4b, 0x48, 0x16, 0x73, 0xeb, 0x00, 0xf7, 0xf1,
+	0x0e, 0x58, 0xf1, 0x15, 0xbe, 0x8f, 0x50, 0x0b, 0x60, 0xcb, 0xa1, 0xab, 0x70, 0x95, 0xcb, 0x23,
+	0x55, 0x7a, 0xfb, 0x36, 0xc0, 0xd1, 0x0c, 0xcd, 0xd1, 0x56, 0xf1, 0xee, 0x2e, 0xd0, 0x10, 0xae,
+	0x3c, 0xdd, 0xf2, 0x98, 0xd5, 0x6b, 0xc3, 0xe2, 0xf3, 0xe1, 0xe2, 0x5b, 0x00, 0x25, 0x46, 0xa4,
+	0x70, 0x1d, 0x29, 0xc3, 0x22, 0x85, 0x07, 0x5f, 0x49, 0xd4, 0x9f, 0x4d, 0x70, 0x36, 0x6c, 0x68,
+	0x81, 0x6d, 0x83, 0xe1, 0x05, 0xc9, 0x73, 0x28, 0x45, 0xc0, 0x32, 0xc0, 0x1e, 0xe9, 0x02, 0x8f,
+	0x93, 0x67, 0xd0, 0x94, 0x01, 0x16, 0xee, 0x50, 0xf5, 0x69, 0x95, 0x0d, 0xea, 0x8b, 0x2e, 0x6d,
+	0x1e, 0xe2, 0x72, 0x7e, 0x31, 0xc0, 0x99, 0x80, 0x53, 0xea, 0x7a, 0xbb, 0xf7, 0xa9, 0x17, 0x6e,
+	0xa2, 0xdc, 0x33, 0xa8, 0x09, 0xfa, 0x3f, 0x49, 0xe8, 0x7c, 0x83, 0xb4, 0xef, 0x19, 0x7d, 0xd6,
+	0x71, 0xd3, 0x77, 0x1f, 0xa5, 0x45, 0xfa, 0x78, 0xf6, 0xe6, 0xc7, 0xce, 0x1e, 0xcf, 0xd9, 0x59,
+	0x2f, 0x67, 0x51, 0xea, 0x55, 0x33, 0xfc, 0xa2, 0x33, 0xe8, 0xca, 0xa0, 0x20, 0xa1, 0xf5, 0x07,
+	0x09, 0x5d, 0x6a, 0x90, 0xb6, 0x0a, 0x6d, 0x93, 0x38, 0x60, 0xff, 0x63, 0xc1, 0x33, 0x68, 0xaa,
+	0x2f, 0x36, 0xad, 0xb2, 0x81, 0x7c, 0x13, 0x9d, 0xc3, 0xb6, 0xa6, 0xef, 0xc2, 0x16, 0xcb, 0x64,
+	0x92, 0x4e, 0x4e, 0xb3, 0xef, 0xde, 0xa4, 0xf9, 0x5c, 0x18, 0xd6, 0x74, 0x33, 0xa0, 0xc9, 0xe6,
+	0x24, 0x7d, 0xc2, 0x08, 0xba, 0x16, 0xc2, 0xde, 0x53, 0x37, 0x68, 0xbf, 0x74, 0x4a, 0xf6, 0x17,
+	0xbe, 0x94, 0xd0, 0x45, 0xba, 0x6b, 0x07, 0xef, 0xc1, 0x69, 0x38, 0x16, 0x52, 0xde, 0xd5, 0x61,
+	0x3b, 0x72, 0x43, 0x76, 0xb8, 0xfb, 0xfb, 0xcc, 0xb8, 0x86, 0x66, 0x87, 0x68, 0x89, 0x44, 0x7f,
+	0x2f, 0xa1, 0xcb, 0xac, 0xf0, 0xbd, 0xa9, 0x35, 0x4b, 0x6b, 0xee, 0x82, 0x71, 0xba, 0xc4, 0xe5,
+	0x0c, 0x3a, 0x03, 0x6c, 0x61, 0x9a, 0xe5, 0xb3, 0xaa, 0x37, 0xac, 0x2f, 0x0d, 0x4b, 0xba, 0x15,
+	0x72, 0x68, 0x85, 0x9e, 0x12, 0x07, 0x16, 0x72, 0xe8, 0x46, 0x28, 0x73, 0xa1, 0xed, 0xaf, 0x04,
+	0x92, 0x7d, 0x65, 0xb0, 0xc9, 0xfa, 0x4d, 0x8c, 0x47, 0xcd, 0x02, 0x3a, 0xab, 0x63, 0x8b, 0xd2,
+	0xc9, 0x24, 0x46, 0x60, 0x44, 0xa4, 0x7c, 0x05, 0xa5, 0x2c, 0x6c, 0xe9, 0x40, 0x32, 0xc9, 0x7c,
+	0xb2, 0x38, 0xa9, 0xf2, 0x91, 0x0c, 0xe8, 0x8c, 0x01, 0x5d, 0x4c, 0x4c, 0x27, 0x33, 0x99, 0x4f,
+	0x16, 0xa7, 0xab, 0xb3, 0x65, 0xbe, 0x92, 0xdb, 0x4a, 0x45, 0xbd, 0xad, 0x62, 0xd3, 0x5a, 0x99,
+	0x73, 0x6b, 0xec, 0x9b, 0xdf, 0x73, 0xc5, 0xb6, 0xe9, 0x6c, 0xf7, 0x9a, 0x65, 0x1d, 0x77, 0x78,
+	0x17, 0xe6, 0xff, 0x4a, 0xc4, 0xd8, 0xa9, 0x38, 0x07, 0x5d, 0x20, 0x14, 0x40, 0x54, 0x6f, 0x6d,
+	0xf9, 0x6d, 0x94, 0xea, 0xe2, 0x5d, 0x53, 0x3f, 0xc8, 0x4c, 0xd1, 0x0a, 0x2f, 0x8f, 0xac, 0x70,
+	0x6e, 0xd0, 0x06, 0x45, 0x89, 0x5e, 0x48, 0x47, 0xec, 0xd9, 0xe2, 0x7b, 0xae, 0xe6, 0x9e, 0x75,
+	0x08, 0x79, 0x57, 0xe7, 0xad, 0x20, 0xe0, 0xbd, 0x48, 0xcd, 0xc7, 0x09, 0x74, 0x45, 0x74, 0x8a,
+	0x81, 0x7d, 0x9f, 0x5b, 0x7a, 0xfa, 0xfe, 0x24, 0x4f, 0xc1, 0x9f, 0x85, 0x80, 0x3f, 0xb7, 0xc2,
+	0x7b, 0x25, 0x77, 0xa7, 0xc4, 0x50, 0x85, 0x3c, 0xca, 0x86, 0xbb, 0x20, 0x8c, 0x7a, 0x94, 0xa0,
+	0x4d, 0x67, 0xbd, 0x67, 0x19, 0xcf, 0xbb, 0x7e, 0x75, 0x94, 0xd2, 0x3a, 0xb8, 0x67, 0x39, 0x99,
+	0xe4, 0xe9, 0x97, 0x29, 0x5f, 0xba, 0xfe, 0x72, 0xc0, 0xb7, 0x6b, 0x01, 0xdf, 0x5a, 0x3d, 0xcb,
+	0x10, 0x35, 0xc5, 0xfa, 0x95, 0xcf, 0x0b, 0x61, 0xd3, 0xd7, 0x09, 0x7a, 0xb5, 0x78, 0xd7, 0x74,
+	0xb6, 0x0d, 0x5b, 0xdb, 0xe7, 0xd3, 0x6e, 0x24, 0xf9, 0x6f, 0xf9, 0x75, 0x27, 0xe0, 0xd7, 0x0b,
+	0x01, 0xbf, 0xf6, 0xb9, 0x03, 0xa2, 0xd2, 0x5c, 0x03, 0x09, 0xbf, 0xde, 0x84, 0xd9, 0x23, 0x2c,
+	0xfc, 0x85, 0xb5, 0xaf, 0xcd, 0x7d, 0x80, 0xee, 0x9a, 0xba, 0x5a, 0x9d, 0x5b, 0x07, 0x88, 0x7f,
+	0x41, 0x55, 0x82, 0x16, 0xfa, 0x8c, 0x5a, 0x44, 0x69, 0x1b, 0x74, 0xb3, 0x6b, 0x02, 0xf5, 0x6a,
+	0xc4, 0x9a, 0x22, 0x34, 0x4a, 0xf3, 0x23, 0x2e, 0xfb, 0x12, 0xd8, 0x7a, 0x75, 0xce, 0xed, 0x18,
+	0xa4, 0xa0, 0xa2, 0xd9, 0x21, 0x51, 0xe2, 0x1e, 0x70, 0x47, 0x64, 0x8c, 0x29, 0xbb, 0xe1, 0xa6,
+	0xe5, 0xb7, 0xa7, 0xb9, 0xcb, 0x8c, 0x09, 0x31, 0x76, 0xca, 0x26, 0xae, 0x74, 0x34, 0x67, 0xbb,
+	0xfc, 0x96, 0xe5, 0x78, 0x39, 0x28, 0x7c, 0xc7, 0xfa, 0x8a, 0xe7, 0xa6, 0x0a, 0x7b, 0x60, 0xf5,
+	0x20, 0xb6, 0x55, 0x19, 0x74, 0x46, 0xd3, 0x75, 0x4a, 0x83, 0x39, 0xe5, 0x0d, 0xe3, 0x1a, 0xe5,
+	0xab, 0xc4, 0xc9, 0x7f, 0xaf, 0x12, 0x23, 0x64, 0x43, 0x14, 0xa3, 0xcd, 0x2c, 0xe2, 0x4d, 0x21,
+	0x60, 0x9c, 0x97, 0x8e, 0xea, 0x9f, 0xff, 0x43, 0xc9, 0x06, 0x69, 0xcb, 0x1f, 0xa0, 0x73, 0x03,
+	0xaf, 0x81, 0x73, 0x23, 0x9f, 0xcc, 0x81, 0xb7, 0x2e, 0xe5, 0xd5, 0x71, 0x11, 0xa2, 0x24, 0x3e,
+	0x92, 0xd0, 0x85, 0xe0, 0x4b, 0x5a, 0x2d, 0xfa, 0x6a, 0x02, 0xa4, 0x2c, 0xc7, 0x00, 0x09, 0x16,
+	0x5f, 0x48, 0x68, 0x26, 0xf4, 0x35, 0x29, 0x92, 0xb0, 0x30, 0xa4, 0x72, 0x37, 0x2e, 0x52, 0x90,
+	0xda, 0x47, 0xd3, 0xfe, 0x77, 0x9f, 0x4a, 0x94, 0x05, 0x7d, 0x00, 0x65, 0x69, 0x4c, 0x80, 0xd8,
+	0xf8, 0x13, 0x09, 0xfd, 0x7f, 0xe8, 0x4d, 0x64, 0x21, 0xca, 0x6a, 0x41, 0x94, 0xf2, 0x5a, 0x1c,
+	0x94, 0x20, 0xf2, 0x50, 0x42, 0xe7, 0x03, 0xd7, 0xfb, 0x6a, 0xb4, 0x05, 0xfd, 0x18, 0xa5, 0x3e,
+	0x3e, 0x46, 0x50, 0xf8, 0x54, 0x42, 0x72, 0xd8, 0x65, 0x3d, 0x62, 0x76, 0x03, 0x38, 0xe5, 0xf5,
+	0x78, 0xb8, 0x81, 0xe3, 0x12, 0xbc, 0x5f, 0xd7, 0xc6, 0xf1, 0x98, 0x83, 0x94, 0xe5, 0x18, 0x20,
+	0xc1, 0xe2, 0x33, 0x09, 0x5d, 0x0a, 0xbb, 0x4a, 0x2e, 0x45, 0x3f, 0x83, 0x03, 0x40, 0xe5, 0x8d,
+	0x98, 0x40, 0xff, 0x59, 0xf1, 0x5f, 0xd9, 0x22, 0x9d, 0x15, 0x1f, 0x40, 0x59, 0x1a, 0x13, 0x30,
+	0xf0, 0xe4, 0x08, 0xbd, 0x05, 0x45, 0x7a, 0x72, 0x84, 0x21, 0x95, 0xbb, 0x71, 0x91, 0x03, 0xe7,
+	0x26, 0x70, 0xaf, 0x88, 0x74, 0x6e, 0x06, 0x31, 0x4a, 0x7d, 0x7c, 0xcc, 0x40, 0xa1, 0x06, 0x1b,
+	0x76, 0x6d, 0x1c, 0x61, 0x1c, 0xa4, 0x2c, 0xc7, 0x00, 0x79, 0x2c, 0x94, 0xa9, 0x87, 0x27, 0x87,
+	0xb7, 0xa5, 0x95, 0xc6, 0xe3, 0xa3, 0xac, 0xf4, 0xe4, 0x28, 0x2b, 0xfd, 0x71, 0x94, 0x95, 0x3e,
+	0x3f, 0xce, 0x4e, 0x3c, 0x39, 0xce, 0x4e, 0xfc, 0x7a, 0x9c, 0x9d, 0x78, 0xaf, 0xe6, 0x6b, 0xc3,
+	0xe2, 0xf7, 0x53, 0xf1, 0xe1, 0x41, 0xf0, 0xa7, 0x54, 0xda, 0x97, 0x9b, 0x29, 0xfa, 0x0b, 0x6a,
+	0xed, 0xef, 0x01, 0x00, 0xb6, 0x50, 0x02, 0xf3, 0x68, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/feeabstraction params
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeTokens defines a governance operation for updating the fee tokens
+	UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error)
+	// SetPreferredFeeToken defines an operation for setting the fee token
+	// preferred by the sender
+	SetPreferredFeeToken(ctx context.Context, in *MsgSetPreferredFeeToken, opts ...grpc.CallOption) (*MsgSetPreferredFeeTokenResponse, error)
+	// AddFeeToken defines a governance operation for adding a single fee token
+	AddFeeToken(ctx context.Context, in *MsgAddFeeToken, opts ...grpc.CallOption) (*MsgAddFeeTokenResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a fee
+	// token from an ERC20 token pair or an IBC denom, deriving its metadata
+	RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a single fee
+	// token
+	RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error)
+	// SetFeeTokenEnabled defines a governance operation for enabling or
+	// disabling a single fee token
+	SetFeeTokenEnabled(ctx context.Context, in *MsgSetFeeTokenEnabled, opts ...grpc.CallOption) (*MsgSetFeeTokenEnabledResponse, error)
+	// RegisterSponsor defines an operation for registering a contract as a fee
+	// sponsor
+	RegisterSponsor(ctx context.Context, in *MsgRegisterSponsor, opts ...grpc.CallOption) (*MsgRegisterSponsorResponse, error)
+	// UpdateSponsorPolicy defines an operation for updating the policy of a fee
+	// sponsor
+	UpdateSponsorPolicy(ctx context.Context, in *MsgUpdateSponsorPolicy, opts ...grpc.CallOption) (*MsgUpdateSponsorPolicyResponse, error)
+	// FundSponsor defines an operation for adding funds to a fee sponsor
+	FundSponsor(ctx context.Context, in *MsgFundSponsor, opts ...grpc.CallOption) (*MsgFundSponsorResponse, error)
+	// WithdrawSponsorFunds defines an operation for withdrawing funds from a fee
+	// sponsor
+	WithdrawSponsorFunds(ctx context.Context, in *MsgWithdrawSponsorFunds, opts ...grpc.CallOption) (*MsgWithdrawSponsorFundsResponse, error)
+	// SweepERC20Fees defines a governance operation for moving the fees
+	// collected on an ERC20 fee token out of the module address
+	SweepERC20Fees(ctx context.Context, in *MsgSweepERC20Fees, opts ...grpc.CallOption) (*MsgSweepERC20FeesResponse, error)
+	// WithdrawRevenue defines a governance operation for moving funds out of
+	// the treasury or the reserve module accounts
+	WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error) {
+	out := new(MsgUpdateFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPreferredFeeToken(ctx context.Context, in *MsgSetPreferredFeeToken, opts ...grpc.CallOption) (*MsgSetPreferredFeeTokenResponse, error) {
+	out := new(MsgSetPreferredFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/SetPreferredFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFeeToken(ctx context.Context, in *MsgAddFeeToken, opts ...grpc.CallOption) (*MsgAddFeeTokenResponse, error) {
+	out := new(MsgAddFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/AddFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error) {
+	out := new(MsgRegisterFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/RegisterFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error) {
+	out := new(MsgRemoveFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/RemoveFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTokenEnabled(ctx context.Context, in *MsgSetFeeTokenEnabled, opts ...grpc.CallOption) (*MsgSetFeeTokenEnabledResponse, error) {
+	out := new(MsgSetFeeTokenEnabledResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/SetFeeTokenEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSponsor(ctx context.Context, in *MsgRegisterSponsor, opts ...grpc.CallOption) (*MsgRegisterSponsorResponse, error) {
+	out := new(MsgRegisterSponsorResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/RegisterSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSponsorPolicy(ctx context.Context, in *MsgUpdateSponsorPolicy, opts ...grpc.CallOption) (*MsgUpdateSponsorPolicyResponse, error) {
+	out := new(MsgUpdateSponsorPolicyResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateSponsorPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSponsor(ctx context.Context, in *MsgFundSponsor, opts ...grpc.CallOption) (*MsgFundSponsorResponse, error) {
+	out := new(MsgFundSponsorResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/FundSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSponsorFunds(ctx context.Context, in *MsgWithdrawSponsorFunds, opts ...grpc.CallOption) (*MsgWithdrawSponsorFundsResponse, error) {
+	out := new(MsgWithdrawSponsorFundsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/WithdrawSponsorFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepERC20Fees(ctx context.Context, in *MsgSweepERC20Fees, opts ...grpc.CallOption) (*MsgSweepERC20FeesResponse, error) {
+	out := new(MsgSweepERC20FeesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/SweepERC20Fees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error) {
+	out := new(MsgWithdrawRevenueResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/WithdrawRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/feeabstraction params
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeTokens defines a governance operation for updating the fee tokens
+	UpdateFeeTokens(context.Context, *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error)
+	// SetPreferredFeeToken defines an operation for setting the fee token
+	// preferred by the sender
+	SetPreferredFeeToken(context.Context, *MsgSetPreferredFeeToken) (*MsgSetPreferredFeeTokenResponse, error)
+	// AddFeeToken defines a governance operation for adding a single fee token
+	AddFeeToken(context.Context, *MsgAddFeeToken) (*MsgAddFeeTokenResponse, error)
+	// RegisterFeeToken defines a governance operation for registering a fee
+	// token from an ERC20 token pair or an IBC denom, deriving its metadata
+	RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a single fee
+	// token
+	RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error)
+	// SetFeeTokenEnabled defines a governance operation for enabling or
+	// disabling a single fee token
+	SetFeeTokenEnabled(context.Context, *MsgSetFeeTokenEnabled) (*MsgSetFeeTokenEnabledResponse, error)
+	// RegisterSponsor defines an operation for registering a contract as a fee
+	// sponsor
+	RegisterSponsor(context.Context, *MsgRegisterSponsor) (*MsgRegisterSponsorResponse, error)
+	// UpdateSponsorPolicy defines an operation for updating the policy of a fee
+	// sponsor
+	UpdateSponsorPolicy(context.Context, *MsgUpdateSponsorPolicy) (*MsgUpdateSponsorPolicyResponse, error)
+	// FundSponsor defines an operation for adding funds to a fee sponsor
+	FundSponsor(context.Context, *MsgFundSponsor) (*MsgFundSponsorResponse, error)
+	// WithdrawSponsorFunds defines an operation for withdrawing funds from a fee
+	// sponsor
+	WithdrawSponsorFunds(context.Context, *MsgWithdrawSponsorFunds) (*MsgWithdrawSponsorFundsResponse, error)
+	// SweepERC20Fees defines a governance operation for moving the fees
+	// collected on an ERC20 fee token out of the module address
+	SweepERC20Fees(context.Context, *MsgSweepERC20Fees) (*MsgSweepERC20FeesResponse, error)
+	// WithdrawRevenue defines a governance operation for moving funds out of
+	// the treasury or the reserve module accounts
+	WithdrawRevenue(context.Context, *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeTokens(ctx context.Context, req *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokens not implemented")
+}
+func (*UnimplementedMsgServer) SetPreferredFeeToken(ctx context.Context, req *MsgSetPreferredFeeToken) (*MsgSetPreferredFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreferredFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) AddFeeToken(ctx context.Context, req *MsgAddFeeToken) (*MsgAddFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) RegisterFeeToken(ctx context.Context, req *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeToken(ctx context.Context, req *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokenEnabled(ctx context.Context, req *MsgSetFeeTokenEnabled) (*MsgSetFeeTokenEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenEnabled not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSponsor(ctx context.Context, req *MsgRegisterSponsor) (*MsgRegisterSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSponsor not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSponsorPolicy(ctx context.Context, req *MsgUpdateSponsorPolicy) (*MsgUpdateSponsorPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSponsorPolicy not implemented")
+}
+func (*UnimplementedMsgServer) FundSponsor(ctx context.Context, req *MsgFundSponsor) (*MsgFundSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSponsor not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSponsorFunds(ctx context.Context, req *MsgWithdrawSponsorFunds) (*MsgWithdrawSponsorFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSponsorFunds not implemented")
+}
+func (*UnimplementedMsgServer) SweepERC20Fees(ctx context.Context, req *MsgSweepERC20Fees) (*MsgSweepERC20FeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepERC20Fees not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRevenue(ctx context.Context, req *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRevenue not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, req.(*MsgUpdateFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPreferredFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPreferredFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPreferredFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/SetPreferredFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPreferredFeeToken(ctx, req.(*MsgSetPreferredFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/AddFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeeToken(ctx, req.(*MsgAddFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/RegisterFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeToken(ctx, req.(*MsgRegisterFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/RemoveFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeToken(ctx, req.(*MsgRemoveFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokenEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/SetFeeTokenEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenEnabled(ctx, req.(*MsgSetFeeTokenEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/RegisterSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSponsor(ctx, req.(*MsgRegisterSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSponsorPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSponsorPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSponsorPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateSponsorPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSponsorPolicy(ctx, req.(*MsgUpdateSponsorPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/FundSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSponsor(ctx, req.(*MsgFundSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSponsorFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSponsorFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSponsorFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/WithdrawSponsorFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSponsorFunds(ctx, req.(*MsgWithdrawSponsorFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepERC20Fees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepERC20Fees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepERC20Fees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/SweepERC20Fees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepERC20Fees(ctx, req.(*MsgSweepERC20Fees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/WithdrawRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRevenue(ctx, req.(*MsgWithdrawRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.feeabstraction.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokens",
+			Handler:    _Msg_UpdateFeeTokens_Handler,
+		},
+		{
+			MethodName: "SetPreferredFeeToken",
+			Handler:    _Msg_SetPreferredFeeToken_Handler,
+		},
+		{
+			MethodName: "AddFeeToken",
+			Handler:    _Msg_AddFeeToken_Handler,
+		},
+		{
+			MethodName: "RegisterFeeToken",
+			Handler:    _Msg_RegisterFeeToken_Handler,
+		},
+		{
+			MethodName: "RemoveFeeToken",
+			Handler:    _Msg_RemoveFeeToken_Handler,
+		},
+		{
+			MethodName: "SetFeeTokenEnabled",
+			Handler:    _Msg_SetFeeTokenEnabled_Handler,
+		},
+		{
+			MethodName: "RegisterSponsor",
+			Handler:    _Msg_RegisterSponsor_Handler,
+		},
+		{
+			MethodName: "UpdateSponsorPolicy",
+			Handler:    _Msg_UpdateSponsorPolicy_Handler,
+		},
+		{
+			MethodName: "FundSponsor",
+			Handler:    _Msg_FundSponsor_Handler,
+		},
+		{
+			MethodName: "WithdrawSponsorFunds",
+			Handler:    _Msg_WithdrawSponsorFunds_Handler,
+		},
+		{
+			MethodName: "SweepERC20Fees",
+			Handler:    _Msg_SweepERC20Fees_Handler,
+		},
+		{
+			MethodName: "WithdrawRevenue",
+			Handler:    _Msg_WithdrawRevenue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/feeabstraction/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPreferredFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPreferredFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPreferredFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPreferredFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPreferredFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPreferredFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Nonces) > 0 {
+		dAtA7 := make([]byte, len(m.Nonces)*10)
+		var j6 int
+		for _, num := range m.Nonces {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSponsorPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSponsorPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSponsorPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepERC20Fees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepERC20Fees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepERC20Fees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepERC20FeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepERC20FeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepERC20FeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeTokens.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPreferredFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPreferredFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokenEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSponsorPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSponsorFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawSponsorFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepERC20Fees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepERC20FeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPreferredFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPreferredFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPreferredFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPreferredFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPreferredFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPreferredFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSponsorPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSponsorPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSponsorPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSponsorPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSponsorPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundSponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundSponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSponsorFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateFeeTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawSponsorFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSponsorFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.FeeTokens.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepERC20Fees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,10 +5533,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepERC20Fees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepERC20Fees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -637,9 +5573,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -649,24 +5585,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -689,7 +5656,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepERC20FeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,12 +5679,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepERC20FeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepERC20FeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -739,7 +5740,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRevenue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,10 +5763,10 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRevenue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -802,7 +5803,71 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -829,7 +5894,8 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -854,7 +5920,7 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRevenueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,10 +5943,10 @@ func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
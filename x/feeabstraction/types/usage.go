@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// SecondsPerDay is the number of seconds in a day, used to track the daily volume
+const SecondsPerDay = int64(24 * 60 * 60)
+
+// NewFeeTokenUsage returns a new empty fee token usage
+func NewFeeTokenUsage(blockHeight, day int64) FeeTokenUsage {
+	return FeeTokenUsage{
+		BlockHeight: blockHeight,
+		BlockVolume: math.ZeroInt(),
+		Day:         day,
+		DailyVolume: math.ZeroInt(),
+	}
+}
+
+// DayFromUnix returns the day index for a unix timestamp in seconds
+func DayFromUnix(unix int64) int64 {
+	return unix / SecondsPerDay
+}
+
+// Rollover returns the usage for the given block and day
+// The block and daily volumes are reset when their period is over
+func (u FeeTokenUsage) Rollover(blockHeight, day int64) FeeTokenUsage {
+	// Reset the block volume on a new block
+	if u.BlockHeight != blockHeight || u.BlockVolume.IsNil() {
+		u.BlockHeight = blockHeight
+		u.BlockVolume = math.ZeroInt()
+	}
+
+	// Reset the daily volume on a new day
+	if u.Day != day || u.DailyVolume.IsNil() {
+		u.Day = day
+		u.DailyVolume = math.ZeroInt()
+	}
+
+	return u
+}
+
+// CheckCaps checks if the amount can be charged without going over the token volume caps
+// The usage must already be rolled over to the current block and day
+func (u FeeTokenUsage) CheckCaps(feeToken FeeTokenMetadata, amount math.Int) error {
+	// Check the per block cap
+	if feeToken.HasBlockVolumeCap() && u.BlockVolume.Add(amount).GT(feeToken.MaxBlockVolume) {
+		return errorsmod.Wrapf(
+			ErrFeeTokenVolumeCap,
+			"fee token %s block volume cap of %s reached",
+			feeToken.Denom, feeToken.MaxBlockVolume,
+		)
+	}
+
+	// Check the per day cap
+	if feeToken.HasDailyVolumeCap() && u.DailyVolume.Add(amount).GT(feeToken.MaxDailyVolume) {
+		return errorsmod.Wrapf(
+			ErrFeeTokenVolumeCap,
+			"fee token %s daily volume cap of %s reached",
+			feeToken.Denom, feeToken.MaxDailyVolume,
+		)
+	}
+
+	return nil
+}
+
+// Add returns the usage with the amount added to the block and daily volumes
+func (u FeeTokenUsage) Add(amount math.Int) FeeTokenUsage {
+	u.BlockVolume = u.BlockVolume.Add(amount)
+	u.DailyVolume = u.DailyVolume.Add(amount)
+	return u
+}
+
+// Sub returns the usage with a refunded amount removed from the block and daily volumes
+// The volumes never go below zero
+func (u FeeTokenUsage) Sub(amount math.Int) FeeTokenUsage {
+	u.BlockVolume = math.MaxInt(u.BlockVolume.Sub(amount), math.ZeroInt())
+	u.DailyVolume = math.MaxInt(u.DailyVolume.Sub(amount), math.ZeroInt())
+	return u
+}
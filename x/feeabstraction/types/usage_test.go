@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v5/x/feeabstraction/types"
+)
+
+// TestFeeTokenUsageRollover tests the Rollover method of FeeTokenUsage
+func TestFeeTokenUsageRollover(t *testing.T) {
+	// The usage on block 10 of day 1
+	usage := types.NewFeeTokenUsage(10, 1).Add(math.NewInt(100))
+
+	// Same block and day keeps both volumes
+	rolled := usage.Rollover(10, 1)
+	require.Equal(t, math.NewInt(100), rolled.BlockVolume)
+	require.Equal(t, math.NewInt(100), rolled.DailyVolume)
+
+	// A new block resets only the block volume
+	rolled = usage.Rollover(11, 1)
+	require.Equal(t, int64(11), rolled.BlockHeight)
+	require.True(t, rolled.BlockVolume.IsZero())
+	require.Equal(t, math.NewInt(100), rolled.DailyVolume)
+
+	// A new day resets both volumes
+	rolled = usage.Rollover(11, 2)
+	require.Equal(t, int64(2), rolled.Day)
+	require.True(t, rolled.BlockVolume.IsZero())
+	require.True(t, rolled.DailyVolume.IsZero())
+}
+
+// TestFeeTokenUsageCheckCaps tests the CheckCaps method of FeeTokenUsage
+func TestFeeTokenUsageCheckCaps(t *testing.T) {
+	// Build a fee token with caps
+	cappedToken := func(blockCap, dailyCap int64) types.FeeTokenMetadata {
+		token := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyOneDec())
+		token.MaxBlockVolume = math.NewInt(blockCap)
+		token.MaxDailyVolume = math.NewInt(dailyCap)
+		return token
+	}
+
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		usage       types.FeeTokenUsage
+		feeToken    types.FeeTokenMetadata
+		amount      math.Int
+		errContains string
+	}{
+		{
+			name:     "valid - no caps",
+			usage:    types.NewFeeTokenUsage(1, 1).Add(math.NewInt(1_000_000)),
+			feeToken: cappedToken(0, 0),
+			amount:   math.NewInt(1_000_000),
+		},
+		{
+			name:     "valid - exactly on the caps",
+			usage:    types.NewFeeTokenUsage(1, 1).Add(math.NewInt(50)),
+			feeToken: cappedToken(100, 100),
+			amount:   math.NewInt(50),
+		},
+		{
+			name:        "invalid - block cap reached",
+			usage:       types.NewFeeTokenUsage(1, 1).Add(math.NewInt(50)),
+			feeToken:    cappedToken(100, 0),
+			amount:      math.NewInt(51),
+			errContains: "fee token coin block volume cap of 100 reached",
+		},
+		{
+			name:        "invalid - daily cap reached",
+			usage:       types.NewFeeTokenUsage(1, 1).Add(math.NewInt(50)),
+			feeToken:    cappedToken(0, 100),
+			amount:      math.NewInt(51),
+			errContains: "fee token coin daily volume cap of 100 reached",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usage.CheckCaps(tc.feeToken, tc.amount)
+
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrFeeTokenVolumeCap)
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestFeeTokenUsageSub tests the Sub method of FeeTokenUsage
+func TestFeeTokenUsageSub(t *testing.T) {
+	// Remove a refund from the volumes
+	usage := types.NewFeeTokenUsage(10, 1).Add(math.NewInt(100)).Sub(math.NewInt(40))
+	require.Equal(t, math.NewInt(60), usage.BlockVolume)
+	require.Equal(t, math.NewInt(60), usage.DailyVolume)
+
+	// The volumes never go below zero
+	usage = usage.Sub(math.NewInt(100))
+	require.True(t, usage.BlockVolume.IsZero())
+	require.True(t, usage.DailyVolume.IsZero())
+}
+
+// TestDayFromUnix tests the DayFromUnix function
+func TestDayFromUnix(t *testing.T) {
+	require.Equal(t, int64(0), types.DayFromUnix(0))
+	require.Equal(t, int64(0), types.DayFromUnix(types.SecondsPerDay-1))
+	require.Equal(t, int64(1), types.DayFromUnix(types.SecondsPerDay))
+}
@@ -2,16 +2,28 @@ package cli
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v5/x/rewards/types"
 )
 
+const (
+	FlagFormat = "format"
+	FlagDenom  = "denom"
+
+	// exportPageLimit is the number of records fetched on each page of the history export
+	exportPageLimit = 1000
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +38,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryReleaseSchedule(),
 		GetCmdQueryRewardPool(),
+		GetCmdQuerySchedules(),
+		GetCmdQueryProjectedRelease(),
+		GetCmdQueryEstimatedStakingAPR(),
+		GetCmdQueryReleaseHistory(),
+		GetCmdExportReleaseHistory(),
+		GetCmdQueryRevenueContract(),
+		GetCmdQueryClaimableRevenue(),
 	)
 
 	return cmd
@@ -111,3 +130,287 @@ func GetCmdQueryRewardPool() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQuerySchedules implements the schedules query command.
+func GetCmdQuerySchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "Query the named release schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Schedules(context.Background(), &types.QuerySchedulesRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+	cmd.Flags().String(FlagDenom, "", "Only query the schedules releasing the denom")
+	return cmd
+}
+
+// GetCmdQueryProjectedRelease implements the projected release query command.
+func GetCmdQueryProjectedRelease() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-release [from-time] [to-time]",
+		Short: "Query the rewards projected to be released between two times",
+		Long: `Query the rewards projected to be released by the active schedules between two times.
+The times are in the RFC3339 format, times before the current block are projected from the current block.
+The --denom flag projects only the schedules releasing the denom.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid from time: %w", err)
+			}
+			toTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid to time: %w", err)
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProjectedRelease(context.Background(), &types.QueryProjectedReleaseRequest{
+				FromTime: fromTime,
+				ToTime:   toTime,
+				Denom:    denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Only project the schedules releasing the denom")
+	return cmd
+}
+
+// GetCmdQueryEstimatedStakingAPR implements the estimated staking APR query command.
+func GetCmdQueryEstimatedStakingAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimated-staking-apr",
+		Short: "Query the staking APR estimated from the rewards projected for the next year",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimatedStakingAPR(context.Background(), &types.QueryEstimatedStakingAPRRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReleaseHistory implements the release history query command.
+func GetCmdQueryReleaseHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-history",
+		Short: "Query the records of the release history",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReleaseHistory(context.Background(), &types.QueryReleaseHistoryRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "release history")
+	return cmd
+}
+
+// GetCmdExportReleaseHistory implements the release history export command.
+func GetCmdExportReleaseHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-release-history",
+		Short: "Export the whole release history as CSV or JSON",
+		Long: `Export all the records of the release history as CSV or JSON, for treasury reporting.
+The history is fetched page by page and written to the standard output. Example:
+$ kiichaind query rewards export-release-history --format csv > release-history.csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("invalid format %s, expected csv or json", format)
+			}
+
+			// Fetch all the pages of the history
+			queryClient := types.NewQueryClient(clientCtx)
+			var records []types.ReleaseRecord
+			pageReq := &query.PageRequest{Limit: exportPageLimit}
+			for {
+				res, err := queryClient.ReleaseHistory(cmd.Context(), &types.QueryReleaseHistoryRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+				records = append(records, res.Records...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: exportPageLimit}
+			}
+
+			if format == "json" {
+				return clientCtx.PrintProto(&types.QueryReleaseHistoryResponse{Records: records})
+			}
+			return writeReleaseHistoryCSV(cmd, records)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "json", "Export format, csv or json")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// writeReleaseHistoryCSV writes the release history records as CSV to the command output
+func writeReleaseHistoryCSV(cmd *cobra.Command, records []types.ReleaseRecord) error {
+	writer := csv.NewWriter(cmd.OutOrStdout())
+	header := []string{"id", "height", "time", "schedule", "destination_type", "destination", "amount", "denom"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		row := []string{
+			strconv.FormatUint(record.Id, 10),
+			strconv.FormatInt(record.Height, 10),
+			record.Time.UTC().Format(time.RFC3339),
+			record.Schedule,
+			record.DestinationType.String(),
+			record.Destination,
+			record.Amount.Amount.String(),
+			record.Amount.Denom,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// GetCmdQueryRevenueContract implements the revenue-contract query command.
+func GetCmdQueryRevenueContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revenue-contract [contract]",
+		Short: "Query a contract registered for the developer revenue",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RevenueContract(context.Background(), &types.QueryRevenueContractRequest{Contract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClaimableRevenue implements the claimable-revenue query command.
+func GetCmdQueryClaimableRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-revenue [address]",
+		Short: "Query the developer revenue accrued to a withdraw address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableRevenue(context.Background(), &types.QueryClaimableRevenueRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +16,29 @@ import (
 	"github.com/kiichain/kiichain/v5/x/rewards/types"
 )
 
+// Flags used to set the curve of a schedule
+const (
+	FlagCurve        = "curve"
+	FlagHalfLife     = "half-life"
+	FlagStepInterval = "step-interval"
+	FlagCliffTime    = "cliff-time"
+	FlagDestination  = "destination"
+)
+
+// Flags used to register a contract for the developer revenue
+const (
+	FlagWithdrawAddress = "withdraw-address"
+	FlagNonces          = "nonces"
+)
+
+// curveTypes maps the curve names used on the CLI to the curve types
+var curveTypes = map[string]types.CurveType{
+	"linear":       types.CurveTypeLinear,
+	"exponential":  types.CurveTypeExponential,
+	"step":         types.CurveTypeStep,
+	"cliff-linear": types.CurveTypeCliffLinear,
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +53,12 @@ func GetTxCmd() *cobra.Command {
 		NewFundPoolCmd(),
 		NewUpdateParamsCmd(),
 		NewChangeScheduleCmd(),
+		NewCreateScheduleCmd(),
+		NewPauseScheduleCmd(),
+		NewCancelScheduleCmd(),
+		NewWithdrawFromPoolCmd(),
+		NewRegisterRevenueCmd(),
+		NewClaimRevenueCmd(),
 	)
 
 	return cmd
@@ -118,3 +150,287 @@ $ %s tx gov submit-proposal change-schedule <path/to/schedule.json> --from mykey
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCreateScheduleCmd implements the create-schedule tx command.
+func NewCreateScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule [name] [amount] [start-time] [end-time]",
+		Short: "Create a named release schedule (gov proposal)",
+		Long: `Create a named release schedule through a governance proposal. The times use
+the RFC3339 format and the rewards go to the fee collector if no destination is given.
+Destinations are given as [fee-collector|community-pool|module/<name>|<address>]:<weight>.
+The release is linear unless another curve is set. Example:
+$ %s tx rewards create-schedule ecosystem 1000000akii 2025-01-01T00:00:00Z 2026-01-01T00:00:00Z --from mykey
+$ %s tx rewards create-schedule ecosystem 1000000akii 2025-01-01T00:00:00Z 2026-01-01T00:00:00Z --curve exponential --half-life 2160h --from mykey
+$ %s tx rewards create-schedule ecosystem 1000000akii 2025-01-01T00:00:00Z 2026-01-01T00:00:00Z --destination community-pool:1 --destination module/oracle:3 --from mykey
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+			startTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+			endTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+			destinations, err := parseDestinationFlags(cmd)
+			if err != nil {
+				return err
+			}
+			curve, err := parseCurveFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSchedule(clientCtx.GetFromAddress().String(), args[0], amount, startTime, endTime, destinations, curve)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCurve, "linear", "Release curve: linear, exponential, step or cliff-linear")
+	cmd.Flags().Duration(FlagHalfLife, 0, "Half-life of the exponential curve")
+	cmd.Flags().Duration(FlagStepInterval, 0, "Interval between the halvings of the step curve")
+	cmd.Flags().String(FlagCliffTime, "", "Cliff time of the cliff-linear curve, in the RFC3339 format")
+	cmd.Flags().StringArray(FlagDestination, nil, "Weighted destination of the rewards, can be repeated")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseCurveFlags builds a schedule curve from the command flags
+func parseCurveFlags(cmd *cobra.Command) (types.EmissionCurve, error) {
+	curveName, err := cmd.Flags().GetString(FlagCurve)
+	if err != nil {
+		return types.EmissionCurve{}, err
+	}
+	curveType, ok := curveTypes[curveName]
+	if !ok {
+		return types.EmissionCurve{}, fmt.Errorf("invalid curve %s", curveName)
+	}
+	curve := types.EmissionCurve{Type: curveType}
+
+	// Parse the curve parameters
+	if curve.HalfLife, err = cmd.Flags().GetDuration(FlagHalfLife); err != nil {
+		return types.EmissionCurve{}, err
+	}
+	if curve.StepInterval, err = cmd.Flags().GetDuration(FlagStepInterval); err != nil {
+		return types.EmissionCurve{}, err
+	}
+	cliffTime, err := cmd.Flags().GetString(FlagCliffTime)
+	if err != nil {
+		return types.EmissionCurve{}, err
+	}
+	if cliffTime != "" {
+		if curve.CliffTime, err = time.Parse(time.RFC3339, cliffTime); err != nil {
+			return types.EmissionCurve{}, fmt.Errorf("invalid cliff time: %w", err)
+		}
+	}
+
+	return curve, nil
+}
+
+// parseDestinationFlags builds the weighted schedule destinations from the command flags
+func parseDestinationFlags(cmd *cobra.Command) ([]types.ReleaseDestination, error) {
+	values, err := cmd.Flags().GetStringArray(FlagDestination)
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := make([]types.ReleaseDestination, 0, len(values))
+	for _, value := range values {
+		// The weight goes after the last colon
+		separator := strings.LastIndex(value, ":")
+		if separator < 0 {
+			return nil, fmt.Errorf("destination %s has no weight", value)
+		}
+		weight, err := strconv.ParseUint(value[separator+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight on destination %s: %w", value, err)
+		}
+
+		// Parse the destination target
+		target := value[:separator]
+		switch {
+		case target == "fee-collector":
+			destinations = append(destinations, types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", weight))
+		case target == "community-pool":
+			destinations = append(destinations, types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", weight))
+		case strings.HasPrefix(target, "module/"):
+			destinations = append(destinations, types.NewReleaseDestination(types.DestinationTypeModuleAccount, strings.TrimPrefix(target, "module/"), weight))
+		default:
+			destinations = append(destinations, types.NewReleaseDestination(types.DestinationTypeAddress, target, weight))
+		}
+	}
+
+	return destinations, nil
+}
+
+// NewPauseScheduleCmd implements the pause-schedule tx command.
+func NewPauseScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-schedule [name] [paused]",
+		Short: "Pause or resume a named release schedule (gov proposal)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid paused value: %w", err)
+			}
+
+			msg := types.NewMsgPauseSchedule(clientCtx.GetFromAddress().String(), args[0], paused)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelScheduleCmd implements the cancel-schedule tx command.
+func NewCancelScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [name]",
+		Short: "Cancel a named release schedule (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSchedule(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewWithdrawFromPoolCmd implements the withdraw-from-pool tx command.
+func NewWithdrawFromPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-from-pool [recipient] [amount]",
+		Short: "Withdraw funds not committed to any schedule from the reward pool (gov proposal)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid recipient: %w", err)
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawFromPool(clientCtx.GetFromAddress().String(), recipient, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterRevenueCmd implements the register-revenue tx command.
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-revenue [contract]",
+		Short: "Register a wasm or EVM contract for the developer revenue",
+		Long: strings.TrimSpace(`
+Register a wasm or EVM contract for the developer revenue, or change the withdraw address of a registered contract.
+The sender must be the wasm contract admin or the EVM contract deployer, proved by the deployment nonces.
+The revenue accrues to the withdraw address, the sender if not set.
+
+Example:
+$ kiichaind tx rewards register-revenue kii1... --withdraw-address kii1... --from mykey
+$ kiichaind tx rewards register-revenue kii1... --nonces 4 --from mykey`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid contract: %w", err)
+			}
+
+			// Parse the withdraw address and the nonces
+			withdrawAddressStr, err := cmd.Flags().GetString(FlagWithdrawAddress)
+			if err != nil {
+				return err
+			}
+			var withdrawAddress sdk.AccAddress
+			if withdrawAddressStr != "" {
+				withdrawAddress, err = sdk.AccAddressFromBech32(withdrawAddressStr)
+				if err != nil {
+					return fmt.Errorf("invalid withdraw address: %w", err)
+				}
+			}
+			nonces, err := cmd.Flags().GetUintSlice(FlagNonces)
+			if err != nil {
+				return err
+			}
+			nonces64 := make([]uint64, len(nonces))
+			for i, nonce := range nonces {
+				nonces64[i] = uint64(nonce)
+			}
+
+			msg := types.NewMsgRegisterRevenue(clientCtx.GetFromAddress(), contract, withdrawAddress, nonces64)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagWithdrawAddress, "", "Address the revenue accrues to, the sender if not set")
+	cmd.Flags().UintSlice(FlagNonces, nil, "Deployer nonces used to derive the EVM contract address")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimRevenueCmd implements the claim-revenue tx command.
+func NewClaimRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-revenue",
+		Short: "Claim the developer revenue accrued to the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimRevenue(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
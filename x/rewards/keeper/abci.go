@@ -1,8 +1,16 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/kiichain/kiichain/v5/x/rewards/types"
 )
@@ -12,47 +20,187 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	// Apply telemetry metrics
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
+	// Get the params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check if the chain was halted since the last block
+	halt, err := k.detectHalt(ctx, params)
+	if err != nil {
+		return err
+	}
+
 	// Get release schedule
 	schedule, err := k.ReleaseSchedule.Get(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Early exit if inactive or nothing to release
-	if !schedule.Active || schedule.TotalAmount.IsZero() {
-		return nil
+	// The amount released by all the schedules on the block, capped per denom
+	released := sdk.NewCoins()
+
+	// Release the main schedule if active and there is something to release
+	if schedule.Active && !schedule.TotalAmount.IsZero() {
+		schedule, released, err = k.releaseSchedule(ctx, params, halt, "", schedule, released)
+		if err != nil {
+			return err
+		}
+		if err := k.ReleaseSchedule.Set(ctx, schedule); err != nil {
+			return err
+		}
+	}
+
+	// Release the named schedules
+	if err := k.releaseSchedules(ctx, params, halt, released); err != nil {
+		return err
+	}
+
+	// Reset the fees paid for the contracts, the current block is recorded for the next releases
+	if err := k.RevenueFees.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// Prune the release history over the max history
+	return k.PruneReleaseHistory(ctx, params)
+}
+
+// detectHalt returns how long the chain was halted since the last block and stores the block time
+// Gaps up to the halt threshold are not halts, a zero threshold disables the detection
+func (k Keeper) detectHalt(ctx sdk.Context, params types.Params) (time.Duration, error) {
+	// Get the time of the last block, the first block has no previous time
+	lastBlockTime, err := k.LastBlockTime.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	hasLastBlock := err == nil
+
+	// Store the current block time
+	if err := k.LastBlockTime.Set(ctx, ctx.BlockTime().UnixNano()); err != nil {
+		return 0, err
+	}
+
+	// Check the gap with the threshold
+	if !hasLastBlock || params.HaltThreshold <= 0 {
+		return 0, nil
+	}
+	halt := ctx.BlockTime().Sub(time.Unix(0, lastBlockTime))
+	if halt <= params.HaltThreshold {
+		return 0, nil
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainHalt,
+			sdk.NewAttribute(types.AttributeKeyHaltDuration, halt.String()),
+		),
+	)
+
+	return halt, nil
+}
+
+// releaseSchedules releases the named schedules that are running on the block time
+// Finished schedules are removed, the released amount is shared by the release cap of the block
+func (k Keeper) releaseSchedules(ctx sdk.Context, params types.Params, halt time.Duration, released sdk.Coins) error {
+	// Collect the schedules before changing them
+	schedules, err := k.GetAllSchedules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, schedule := range schedules {
+		// Skip paused schedules and the ones not started yet
+		if !schedule.IsReleasing(ctx.BlockTime()) {
+			continue
+		}
+
+		schedule.Release, released, err = k.releaseSchedule(ctx, params, halt, schedule.Name, schedule.Release, released)
+		if err != nil {
+			return err
+		}
+
+		// Remove the schedule once everything is released
+		if !schedule.Release.Active {
+			if err := k.Schedules.Remove(ctx, schedule.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.Schedules.Set(ctx, schedule.Name, schedule); err != nil {
+			return err
+		}
 	}
 
-	// If active and there is no previous time stamp, set it as current block's and skip this time
+	return nil
+}
+
+// releaseSchedule releases the rewards of an active schedule up to the block time
+// The main schedule has no name, the released amount is the amount already released on the block and is returned updated
+func (k Keeper) releaseSchedule(ctx sdk.Context, params types.Params, halt time.Duration, name string, schedule types.ReleaseSchedule, released sdk.Coins) (types.ReleaseSchedule, sdk.Coins, error) {
+	// If there is no previous time stamp, set it as current block's and skip this time
 	if schedule.LastReleaseTime.IsZero() {
 		schedule.LastReleaseTime = ctx.BlockTime()
-		return k.ReleaseSchedule.Set(ctx, schedule)
+		return schedule, released, nil
+	}
+
+	// Shift the schedule by the halt, so the halted period releases nothing
+	if halt > 0 && params.ShiftOnHalt {
+		schedule = shiftScheduleOnHalt(ctx, name, schedule, halt)
 	}
 
 	// Calculate the amount to distribute this block
 	amountToDistribute, err := types.CalculateReward(ctx.BlockTime(), schedule)
 	if err != nil {
-		return err
+		return schedule, released, err
+	}
+
+	// Cap the amount of the denom released on a single block, the cap is shared by all the schedules
+	// The last release time is kept on capped releases, so the rest is caught up on the next blocks
+	capped := false
+	if releaseCap, enabled := params.GetReleaseCap(amountToDistribute.Denom); enabled {
+		available := math.MaxInt(releaseCap.Sub(released.AmountOf(amountToDistribute.Denom)), math.ZeroInt())
+		if amountToDistribute.Amount.GT(available) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeReleaseCapped,
+					sdk.NewAttribute(types.AttributeKeySchedule, name),
+					sdk.NewAttribute(types.AttributeKeyAmount, amountToDistribute.String()),
+					sdk.NewAttribute(types.AttributeKeyReleaseCap, sdk.NewCoin(amountToDistribute.Denom, releaseCap).String()),
+				),
+			)
+			amountToDistribute.Amount = available
+			capped = true
+		}
 	}
 
-	// If nothing to distribute, sets up as inactive for early exit next time
+	// If nothing to distribute, sets up as inactive for early exit next time once everything is released
+	// Non-linear curves may release nothing on a block before the end
 	if amountToDistribute.IsZero() {
-		schedule.Active = false
-		return k.ReleaseSchedule.Set(ctx, schedule)
+		if schedule.ReleasedAmount.Amount.GTE(schedule.TotalAmount.Amount) {
+			schedule.Active = false
+		}
+		return schedule, released, nil
 	}
 
 	// Get the current RewardPool from state
 	rewardPool, err := k.RewardPool.Get(ctx)
 	if err != nil {
-		return err
+		return schedule, released, err
 	}
 
 	// Set up coins
 	coinsToDistribute := sdk.NewCoins(amountToDistribute)
 
-	// Send to distribution pool
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coinsToDistribute); err != nil {
-		return err
+	// Accrue the share of the contract developers
+	remaining, err := k.takeDeveloperRevenue(ctx, params, name, amountToDistribute)
+	if err != nil {
+		return schedule, released, err
+	}
+
+	// Send the rest to the destinations
+	if err := k.distributeReward(ctx, params, name, remaining, schedule.GetReleaseDestinations()); err != nil {
+		return schedule, released, err
 	}
 
 	// Deduct from RewardPool
@@ -60,11 +208,102 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 
 	// Save change
 	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
-		return err
+		return schedule, released, err
 	}
 
 	// Update release schedule
-	schedule.LastReleaseTime = ctx.BlockTime()
+	if !capped {
+		schedule.LastReleaseTime = ctx.BlockTime()
+	}
 	schedule.ReleasedAmount = schedule.ReleasedAmount.Add(amountToDistribute)
-	return k.ReleaseSchedule.Set(ctx, schedule)
+	return schedule, released.Add(amountToDistribute), nil
+}
+
+// shiftScheduleOnHalt moves the end of a schedule by the part of the halt after its last release
+// The shift is accumulated on the schedule halt adjustment
+func shiftScheduleOnHalt(ctx sdk.Context, name string, schedule types.ReleaseSchedule, halt time.Duration) types.ReleaseSchedule {
+	// Only the time the schedule was releasing is shifted
+	shift := halt
+	if sinceRelease := ctx.BlockTime().Sub(schedule.LastReleaseTime); sinceRelease < shift {
+		shift = sinceRelease
+	}
+	if shift <= 0 {
+		return schedule
+	}
+
+	schedule = schedule.Shift(shift)
+	schedule.HaltAdjustment += shift
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHaltAdjustment,
+			sdk.NewAttribute(types.AttributeKeySchedule, name),
+			sdk.NewAttribute(types.AttributeKeyHaltDuration, shift.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, schedule.EndTime.String()),
+		),
+	)
+
+	return schedule
+}
+
+// distributeReward splits the released amount between the weighted destinations of a schedule
+// Each destination receiving rewards is recorded on the release history and emits an event
+func (k Keeper) distributeReward(ctx sdk.Context, params types.Params, name string, amount sdk.Coin, destinations []types.ReleaseDestination) error {
+	shares := types.SplitRelease(amount, destinations)
+	for i, destination := range destinations {
+		if shares[i].IsZero() {
+			continue
+		}
+
+		if err := k.sendReward(ctx, sdk.NewCoins(shares[i]), destination); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseRewards,
+				sdk.NewAttribute(types.AttributeKeySchedule, name),
+				sdk.NewAttribute(types.AttributeKeyDestinationType, destination.Type.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, destination.Target),
+				sdk.NewAttribute(types.AttributeKeyAmount, shares[i].String()),
+			),
+		)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventRelease{
+			Schedule:        name,
+			DestinationType: destination.Type,
+			Destination:     destination.Target,
+			Amount:          shares[i],
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := k.RecordRelease(ctx, params, name, destination, shares[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendReward sends released coins from the module to a destination
+func (k Keeper) sendReward(ctx sdk.Context, coins sdk.Coins, destination types.ReleaseDestination) error {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	switch destination.Type {
+	case types.DestinationTypeFeeCollector:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
+	case types.DestinationTypeCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr)
+	case types.DestinationTypeModuleAccount:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.Target, coins)
+	case types.DestinationTypeAddress:
+		destinationAddr, err := sdk.AccAddressFromBech32(destination.Target)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(ctx, moduleAddr, destinationAddr, coins)
+	default:
+		return fmt.Errorf("invalid destination type %d", destination.Type)
+	}
 }
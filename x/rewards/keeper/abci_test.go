@@ -19,12 +19,12 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 	// Fund the reward pool first
 	err = suite.App.RewardsKeeper.FundCommunityPool(
 		suite.Ctx,
-		sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(100000)),
+		sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(100000)),
 		suite.TestAccs[0])
 	suite.Require().NoError(err)
 
 	now := time.Now()
-	denom := defaultParams.TokenDenom
+	denom := defaultParams.AllowedDenoms[0]
 
 	testCases := []struct {
 		name                 string
@@ -189,3 +189,115 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestBeginBlockerReleaseCap() {
+	// Set up params with a release cap and fund the pool
+	params := types.DefaultParams()
+	denom := params.AllowedDenoms[0]
+	params.MaxReleasePerBlock = sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule releasing over four hours
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "capped", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Half of the schedule is due, but only the cap is released
+	ctx := suite.Ctx.WithBlockTime(now.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "capped")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(100)), schedule.Release.ReleasedAmount)
+	suite.Require().True(now.Equal(schedule.Release.LastReleaseTime))
+	suite.Require().True(hasEvent(ctx, types.EventTypeReleaseCapped))
+
+	// The rest is caught up on the next blocks
+	ctx = ctx.WithBlockTime(now.Add(2*time.Hour + 5*time.Second))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "capped")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(200)), schedule.Release.ReleasedAmount)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerReleaseCapShared() {
+	// Set up params with a release cap and fund the pool
+	params := types.DefaultParams()
+	denom := params.AllowedDenoms[0]
+	params.MaxReleasePerBlock = sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100)))
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create two schedules releasing over four hours
+	now := suite.Ctx.BlockTime()
+	for _, name := range []string{"first", "second"} {
+		err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, name, sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+		suite.Require().NoError(err)
+	}
+
+	// Both schedules are due, but the cap is shared by the block
+	ctx := suite.Ctx.WithBlockTime(now.Add(2 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	first, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "first")
+	suite.Require().NoError(err)
+	second, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "second")
+	suite.Require().NoError(err)
+	released := first.Release.ReleasedAmount.Add(second.Release.ReleasedAmount)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(100)), released)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerHaltShift() {
+	// Set up params shifting the schedules on halts and fund the pool
+	params := types.DefaultParams()
+	params.HaltThreshold = time.Minute
+	params.ShiftOnHalt = true
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	denom := params.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule releasing over four hours
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "shifted", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// A regular block gap is not a halt
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.LastBlockTime.Set(ctx, now.Add(time.Hour-5*time.Second).UnixNano())
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(hasEvent(ctx, types.EventTypeChainHalt))
+	schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "shifted")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(250)), schedule.Release.ReleasedAmount)
+
+	// A two hours halt shifts the end of the schedule
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(hasEvent(ctx, types.EventTypeChainHalt))
+	suite.Require().True(hasEvent(ctx, types.EventTypeHaltAdjustment))
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "shifted")
+	suite.Require().NoError(err)
+	suite.Require().True(now.Add(6 * time.Hour).Equal(schedule.Release.EndTime))
+	suite.Require().Equal(2*time.Hour, schedule.Release.HaltAdjustment)
+}
+
+// hasEvent checks if an event type was emitted on the context
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	if err := k.ReleaseSchedule.Set(ctx, data.ReleaseSchedule); err != nil {
 		panic(err)
 	}
+
+	for _, schedule := range data.Schedules {
+		if err := k.Schedules.Set(ctx, schedule.Name, schedule); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set the release history, new records continue after the last id
+	nextID := uint64(0)
+	for _, record := range data.ReleaseHistory {
+		if err := k.ReleaseHistory.Set(ctx, record.Id, record); err != nil {
+			panic(err)
+		}
+		if record.Id >= nextID {
+			nextID = record.Id + 1
+		}
+	}
+	if err := k.ReleaseRecordID.Set(ctx, nextID); err != nil {
+		panic(err)
+	}
+
+	for _, contract := range data.RevenueContracts {
+		if err := k.RevenueContracts.Set(ctx, sdk.MustAccAddressFromBech32(contract.Contract), contract); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, revenue := range data.ClaimableRevenues {
+		if err := k.ClaimableRevenues.Set(ctx, sdk.MustAccAddressFromBech32(revenue.Address), revenue); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,5 +70,25 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, rewardPool, releaseSchedule)
+	schedules, err := k.GetAllSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	releaseHistory, err := k.GetReleaseHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	revenueContracts, err := k.GetAllRevenueContracts(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	claimableRevenues, err := k.GetAllClaimableRevenues(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, rewardPool, releaseSchedule, schedules, releaseHistory, revenueContracts, claimableRevenues)
 }
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v5/x/rewards/types"
 )
@@ -32,7 +36,18 @@ func (k Querier) RewardPool(ctx context.Context, _ *types.QueryRewardPoolRequest
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryRewardPoolResponse{RewardPool: pool}, nil
+
+	// Split the pool between the committed and the free funds
+	committed, err := k.Keeper.GetCommittedFunds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRewardPoolResponse{
+		RewardPool:     pool,
+		CommittedFunds: committed,
+		FreeFunds:      pool.GetFreeFunds(committed),
+	}, nil
 }
 
 // ReleaseSchedule queries the schedule information
@@ -43,3 +58,96 @@ func (k Querier) ReleaseSchedule(ctx context.Context, _ *types.QueryReleaseSched
 	}
 	return &types.QueryReleaseScheduleResponse{ReleaseSchedule: schedule}, nil
 }
+
+// Schedules queries the named release schedules
+func (k Querier) Schedules(ctx context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	schedules, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.Keeper.Schedules,
+		req.Pagination,
+		func(_ string, schedule types.Schedule) (bool, error) {
+			return req.Denom == "" || schedule.Release.TotalAmount.Denom == req.Denom, nil
+		},
+		func(_ string, schedule types.Schedule) (types.Schedule, error) {
+			return schedule, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// ReleaseHistory queries the records of the release history
+func (k Querier) ReleaseHistory(ctx context.Context, req *types.QueryReleaseHistoryRequest) (*types.QueryReleaseHistoryResponse, error) {
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.ReleaseHistory,
+		req.Pagination,
+		func(_ uint64, record types.ReleaseRecord) (types.ReleaseRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryReleaseHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// ProjectedRelease queries the rewards projected to be released between two times
+func (k Querier) ProjectedRelease(ctx context.Context, req *types.QueryProjectedReleaseRequest) (*types.QueryProjectedReleaseResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	amount, projections, err := k.Keeper.ProjectRelease(sdk.UnwrapSDKContext(ctx), req.FromTime, req.ToTime, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryProjectedReleaseResponse{Amount: amount, Schedules: projections}, nil
+}
+
+// EstimatedStakingAPR queries the staking APR estimated from the rewards projected for the next year
+func (k Querier) EstimatedStakingAPR(ctx context.Context, _ *types.QueryEstimatedStakingAPRRequest) (*types.QueryEstimatedStakingAPRResponse, error) {
+	return k.Keeper.EstimateStakingAPR(sdk.UnwrapSDKContext(ctx))
+}
+
+// RevenueContract queries a contract registered for the developer revenue
+func (k Querier) RevenueContract(ctx context.Context, req *types.QueryRevenueContractRequest) (*types.QueryRevenueContractResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address: %w", err)
+	}
+
+	revenueContract, err := k.Keeper.GetRevenueContract(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRevenueContractResponse{RevenueContract: revenueContract}, nil
+}
+
+// ClaimableRevenue queries the developer revenue accrued to a withdraw address
+func (k Querier) ClaimableRevenue(ctx context.Context, req *types.QueryClaimableRevenueRequest) (*types.QueryClaimableRevenueResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	amount, err := k.Keeper.GetClaimableRevenue(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryClaimableRevenueResponse{Amount: amount}, nil
+}
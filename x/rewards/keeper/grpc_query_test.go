@@ -33,7 +33,7 @@ func (suite *KeeperTestSuite) TestQuerierParams() {
 			name: "success - with modified params",
 			setup: func() {
 				modifiedParams := types.Params{
-					TokenDenom: "modified",
+					AllowedDenoms: []string{"modified"},
 				}
 				err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, modifiedParams)
 				suite.Require().NoError(err)
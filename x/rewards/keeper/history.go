@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// GetReleaseHistory returns all the records of the release history
+func (k Keeper) GetReleaseHistory(ctx context.Context) ([]types.ReleaseRecord, error) {
+	iterator, err := k.ReleaseHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// RecordRelease adds the rewards released to a destination to the release history
+// Nothing is recorded if the history is disabled
+func (k Keeper) RecordRelease(ctx sdk.Context, params types.Params, name string, destination types.ReleaseDestination, amount sdk.Coin) error {
+	if params.MaxReleaseHistory == 0 {
+		return nil
+	}
+
+	id, err := k.ReleaseRecordID.Next(ctx)
+	if err != nil {
+		return err
+	}
+	record := types.NewReleaseRecord(id, ctx.BlockHeight(), ctx.BlockTime(), name, destination, amount)
+	return k.ReleaseHistory.Set(ctx, id, record)
+}
+
+// PruneReleaseHistory removes the oldest records over the max history
+// The records are keyed by increasing ids, so only the pruned entries are iterated
+func (k Keeper) PruneReleaseHistory(ctx sdk.Context, params types.Params) error {
+	// Get the first id kept, a disabled history is all pruned
+	nextID, err := k.ReleaseRecordID.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	cutoff := types.GetReleaseHistoryCutoff(nextID, params.MaxReleaseHistory)
+	if cutoff == 0 {
+		return nil
+	}
+
+	// Collect the pruned ids
+	var pruned []uint64
+	ranger := new(collections.Range[uint64]).EndExclusive(cutoff)
+	err = k.ReleaseHistory.Walk(ctx, ranger, func(id uint64, _ types.ReleaseRecord) (bool, error) {
+		pruned = append(pruned, id)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Remove the pruned records
+	for _, id := range pruned {
+		if err := k.ReleaseHistory.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestReleaseHistory() {
+	// Set up params keeping two records and fund the pool
+	params := types.DefaultParams()
+	params.MaxReleaseHistory = 2
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	denom := params.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule split between the fee collector and the community pool
+	now := suite.Ctx.BlockTime()
+	destinations := []types.ReleaseDestination{
+		types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 1),
+	}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "split", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), destinations, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Each destination is recorded
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour)).WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	history, err := suite.App.RewardsKeeper.GetReleaseHistory(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+	for i, record := range history {
+		suite.Require().Equal(uint64(i), record.Id)
+		suite.Require().Equal(int64(10), record.Height)
+		suite.Require().True(now.Add(time.Hour).Equal(record.Time))
+		suite.Require().Equal("split", record.Schedule)
+		suite.Require().Equal(destinations[i].Type, record.DestinationType)
+		suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(125)), record.Amount)
+	}
+	suite.Require().True(hasEvent(ctx, "kiichain.rewards.v1beta1.EventRelease"))
+
+	// The oldest records are pruned over the max history
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour)).WithBlockHeight(11)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	history, err = suite.App.RewardsKeeper.GetReleaseHistory(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(uint64(2), history[0].Id)
+	suite.Require().Equal(int64(11), history[0].Height)
+
+	// The history is paginated
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	res, err := querier.ReleaseHistory(ctx, &types.QueryReleaseHistoryRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ReleaseRecord{history[0]}, res.Records)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	// A disabled history is all pruned
+	params.MaxReleaseHistory = 0
+	err = suite.App.RewardsKeeper.Params.Set(ctx, params)
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockTime(now.Add(3 * time.Hour)).WithBlockHeight(12)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	history, err = suite.App.RewardsKeeper.GetReleaseHistory(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(history)
+}
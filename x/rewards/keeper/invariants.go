@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// RegisterInvariants registers the rewards module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "released-amount", ReleasedAmountInvariant(k))
+}
+
+// AllInvariants runs all the rewards module invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ReleasedAmountInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks the module account balance covers the reward pool and the claimable developer revenue
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		rewardPool, err := k.RewardPool.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module balance", fmt.Sprintf("\tfailed to get the reward pool: %s\n", err)), true
+		}
+		revenues, err := k.GetAllClaimableRevenues(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module balance", fmt.Sprintf("\tfailed to get the claimable revenues: %s\n", err)), true
+		}
+
+		// The pool and the revenue not claimed yet are kept on the module account
+		claimable := sdk.NewCoins()
+		for _, revenue := range revenues {
+			claimable = claimable.Add(revenue.Amount...)
+		}
+		expected := rewardPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(claimable...)...)
+
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+		_, broken := sdk.NewDecCoinsFromCoins(balance...).SafeSub(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "module balance", fmt.Sprintf(
+			"\tmodule account balance: %s\n\treward pool: %s\n\tclaimable revenue: %s\n",
+			balance, rewardPool.CommunityPool, claimable,
+		)), broken
+	}
+}
+
+// ReleasedAmountInvariant checks the schedules never release more than their total amt
+func ReleasedAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		// Check the main schedule
+		schedule, err := k.ReleaseSchedule.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "released amount", fmt.Sprintf("\tfailed to get the release schedule: %s\n", err)), true
+		}
+		if schedule.IsOverReleased() {
+			count++
+			msg += fmt.Sprintf("\tmain schedule released %s of %s\n", schedule.ReleasedAmount, schedule.TotalAmount)
+		}
+
+		// Check the named schedules
+		err = k.Schedules.Walk(ctx, nil, func(name string, schedule types.Schedule) (bool, error) {
+			if schedule.Release.IsOverReleased() {
+				count++
+				msg += fmt.Sprintf("\tschedule %s released %s of %s\n", name, schedule.Release.ReleasedAmount, schedule.Release.TotalAmount)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "released amount", fmt.Sprintf("\tfailed to get the schedules: %s\n", err)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "released amount", fmt.Sprintf(
+			"%d schedules released more than their total amount\n%s", count, msg,
+		)), count != 0
+	}
+}
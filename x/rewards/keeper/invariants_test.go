@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	rewardsKeeper := suite.App.RewardsKeeper
+	denom := types.DefaultParams().AllowedDenoms[0]
+
+	// Fund the pool and release part of a schedule
+	_, err := suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(10000))))
+	suite.Require().NoError(err)
+	now := suite.Ctx.BlockTime()
+	err = rewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(4000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockTime(now.Add(2 * time.Hour))
+	suite.Require().NoError(rewardsKeeper.BeginBlocker(ctx))
+
+	// The accounting is consistent
+	msg, broken := keeper.AllInvariants(rewardsKeeper)(ctx)
+	suite.Require().False(broken, msg)
+
+	// The pool recording more than the module balance breaks the invariant
+	rewardPool, err := rewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	balance := suite.App.BankKeeper.GetBalance(ctx, suite.App.AccountKeeper.GetModuleAddress(types.ModuleName), denom)
+	suite.Require().NoError(rewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, balance.Amount.AddRaw(1))),
+	}))
+	_, broken = keeper.ModuleBalanceInvariant(rewardsKeeper)(ctx)
+	suite.Require().True(broken)
+
+	// Unclaimed developer revenue must be covered too
+	suite.Require().NoError(rewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, balance.Amount)),
+	}))
+	_, broken = keeper.ModuleBalanceInvariant(rewardsKeeper)(ctx)
+	suite.Require().False(broken)
+	err = rewardsKeeper.ClaimableRevenues.Set(ctx, suite.TestAccs[1], types.NewClaimableRevenue(suite.TestAccs[1], sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1)))))
+	suite.Require().NoError(err)
+	_, broken = keeper.ModuleBalanceInvariant(rewardsKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().NoError(rewardsKeeper.ClaimableRevenues.Remove(ctx, suite.TestAccs[1]))
+	suite.Require().NoError(rewardsKeeper.RewardPool.Set(ctx, rewardPool))
+
+	// A schedule releasing more than its total breaks the invariant
+	schedule, err := rewardsKeeper.Schedules.Get(ctx, "ecosystem")
+	suite.Require().NoError(err)
+	schedule.Release.ReleasedAmount = sdk.NewCoin(denom, math.NewInt(4001))
+	suite.Require().NoError(rewardsKeeper.Schedules.Set(ctx, "ecosystem", schedule))
+	_, broken = keeper.ReleasedAmountInvariant(rewardsKeeper)(ctx)
+	suite.Require().True(broken)
+}
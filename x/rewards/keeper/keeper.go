@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,7 +19,12 @@ type (
 	Keeper struct {
 		cdc codec.BinaryCodec
 
-		bankKeeper types.BankKeeper
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		stakingKeeper types.StakingKeeper
+		distrKeeper   types.DistributionKeeper
+
+		contractAdminKeeper types.ContractAdminKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -29,6 +35,14 @@ type (
 		Params          collections.Item[types.Params]
 		RewardPool      collections.Item[types.RewardPool]
 		ReleaseSchedule collections.Item[types.ReleaseSchedule]
+		Schedules       collections.Map[string, types.Schedule]
+		LastBlockTime   collections.Item[int64]
+		ReleaseHistory  collections.Map[uint64, types.ReleaseRecord]
+		ReleaseRecordID collections.Sequence
+
+		RevenueContracts  collections.Map[sdk.AccAddress, types.RevenueContract]
+		RevenueFees       collections.Map[sdk.AccAddress, math.Int]
+		ClaimableRevenues collections.Map[sdk.AccAddress, types.ClaimableRevenue]
 	}
 )
 
@@ -36,14 +50,23 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
+	contractAdminKeeper types.ContractAdminKeeper,
 	authority, feeCollectorName string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc: cdc,
 
-		bankKeeper: bankKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+
+		contractAdminKeeper: contractAdminKeeper,
 
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
@@ -51,6 +74,14 @@ func NewKeeper(
 		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		RewardPool:      collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
 		ReleaseSchedule: collections.NewItem(sb, types.ReleaseScheduleKey, "release_schedule", codec.CollValue[types.ReleaseSchedule](cdc)),
+		Schedules:       collections.NewMap(sb, types.SchedulesKey, "schedules", collections.StringKey, codec.CollValue[types.Schedule](cdc)),
+		LastBlockTime:   collections.NewItem(sb, types.LastBlockTimeKey, "last_block_time", collections.Int64Value),
+		ReleaseHistory:  collections.NewMap(sb, types.ReleaseHistoryKey, "release_history", collections.Uint64Key, codec.CollValue[types.ReleaseRecord](cdc)),
+		ReleaseRecordID: collections.NewSequence(sb, types.ReleaseRecordIDKey, "release_record_id"),
+
+		RevenueContracts:  collections.NewMap(sb, types.RevenueContractsKey, "revenue_contracts", sdk.AccAddressKey, codec.CollValue[types.RevenueContract](cdc)),
+		RevenueFees:       collections.NewMap(sb, types.RevenueFeesKey, "revenue_fees", sdk.AccAddressKey, sdk.IntValue),
+		ClaimableRevenues: collections.NewMap(sb, types.ClaimableRevenuesKey, "claimable_revenues", sdk.AccAddressKey, codec.CollValue[types.ClaimableRevenue](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -88,5 +119,12 @@ func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coin, sender s
 	}
 
 	rewardPool.CommunityPool = rewardPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
-	return k.RewardPool.Set(ctx, rewardPool)
+	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFundPool{
+		Sender: sender.String(),
+		Amount: amount,
+	})
 }
@@ -36,7 +36,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	if !ok {
 		suite.Error(fmt.Errorf("Could not create int to fund accs "))
 	}
-	fundAccsAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().TokenDenom, amount))
+	fundAccsAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().AllowedDenoms[0], amount))
 	for _, acc := range suite.TestAccs {
 		suite.FundAcc(acc, fundAccsAmount)
 	}
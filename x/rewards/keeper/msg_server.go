@@ -32,6 +32,10 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := k.validateAllowedDenoms(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
 	if err := k.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -54,8 +58,8 @@ func (k msgServer) FundPool(ctx context.Context, msg *types.MsgFundPool) (*types
 	if err != nil {
 		return nil, err
 	}
-	if params.TokenDenom != msg.Amount.Denom {
-		return nil, fmt.Errorf("denom %s does not match expected denom: %s", msg.Amount.Denom, params.TokenDenom)
+	if !params.IsAllowedDenom(msg.Amount.Denom) {
+		return nil, fmt.Errorf("denom %s is not allowed, allowed denoms: %v", msg.Amount.Denom, params.AllowedDenoms)
 	}
 
 	if err := k.Keeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
@@ -78,8 +82,12 @@ func (k msgServer) ChangeSchedule(ctx context.Context, msg *types.MsgChangeSched
 		return nil, fmt.Errorf("invalid schedule: %w", err)
 	}
 
-	// Check available funds
-	if err := k.fundsAvailable(ctx, schedule.TotalAmount); err != nil {
+	// Check available funds, the funds committed to the replaced schedule are released
+	currentSchedule, err := k.Keeper.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.fundsAvailable(ctx, schedule.TotalAmount, currentSchedule.GetCommittedFunds()); err != nil {
 		return nil, fmt.Errorf("insufficient funds: %w", err)
 	}
 
@@ -87,6 +95,118 @@ func (k msgServer) ChangeSchedule(ctx context.Context, msg *types.MsgChangeSched
 	if err := k.Keeper.ReleaseSchedule.Set(ctx, schedule); err != nil {
 		return nil, fmt.Errorf("failed to set release schedule: %w", err)
 	}
+	if err := emitScheduleChange(sdk.UnwrapSDKContext(ctx), "", types.ScheduleActionChange, schedule); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgChangeScheduleResponse{}, nil
 }
+
+// CreateSchedule validates and creates a new named release schedule
+func (k msgServer) CreateSchedule(ctx context.Context, msg *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.CreateSchedule(sdkCtx, msg.Name, msg.TotalAmount, msg.StartTime, msg.EndTime, msg.Destinations, msg.Curve); err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	return &types.MsgCreateScheduleResponse{}, nil
+}
+
+// PauseSchedule pauses or resumes a named release schedule
+func (k msgServer) PauseSchedule(ctx context.Context, msg *types.MsgPauseSchedule) (*types.MsgPauseScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.PauseSchedule(sdkCtx, msg.Name, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseScheduleResponse{}, nil
+}
+
+// CancelSchedule cancels a named release schedule
+func (k msgServer) CancelSchedule(ctx context.Context, msg *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.CancelSchedule(sdkCtx, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduleResponse{}, nil
+}
+
+// WithdrawFromPool withdraws funds not committed to any schedule from the reward pool
+func (k msgServer) WithdrawFromPool(ctx context.Context, msg *types.MsgWithdrawFromPool) (*types.MsgWithdrawFromPoolResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.WithdrawFromPool(sdkCtx, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFromPoolResponse{}, nil
+}
+
+// RegisterRevenue registers a contract for the developer revenue, or changes its withdraw address
+func (k msgServer) RegisterRevenue(ctx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address: %s", err)
+	}
+
+	// The withdraw address defaults to the sender
+	var withdrawAddress sdk.AccAddress
+	if msg.WithdrawAddress != "" {
+		withdrawAddress, err = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.RegisterRevenue(sdkCtx, sender, contract, withdrawAddress, msg.Nonces); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// ClaimRevenue sends the developer revenue accrued to the sender
+func (k msgServer) ClaimRevenue(ctx context.Context, msg *types.MsgClaimRevenue) (*types.MsgClaimRevenueResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	amount, err := k.Keeper.ClaimRevenue(sdkCtx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRevenueResponse{Amount: amount}, nil
+}
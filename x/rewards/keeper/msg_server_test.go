@@ -37,7 +37,7 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			name: "invalid params - empty denom",
 			msg: types.NewMsgUpdateParams(
 				suite.App.RewardsKeeper.GetAuthority(),
-				types.Params{TokenDenom: ""},
+				types.Params{AllowedDenoms: []string{""}},
 			),
 			expectedPass: false,
 		},
@@ -76,14 +76,14 @@ func (suite *KeeperTestSuite) TestFundPool() {
 			name: "valid funding",
 			msg: types.NewMsgFundPool(
 				suite.TestAccs[0],
-				sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(1000))),
+				sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(1000))),
 			expectedPass: true,
 		},
 		{
 			name: "invalid sender",
 			msg: types.NewMsgFundPool(
 				sdk.AccAddress{},
-				sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(1000))),
+				sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(1000))),
 			expectedPass: false,
 		},
 		{
@@ -104,7 +104,7 @@ func (suite *KeeperTestSuite) TestFundPool() {
 				// Verify funds were added to the pool
 				pool, err := suite.App.RewardsKeeper.RewardPool.Get(suite.Ctx)
 				suite.Require().NoError(err)
-				suite.Require().True(pool.CommunityPool.AmountOf(defaultParams.TokenDenom).Equal((math.LegacyNewDecFromBigInt(tc.msg.Amount.Amount.BigInt()))))
+				suite.Require().True(pool.CommunityPool.AmountOf(defaultParams.AllowedDenoms[0]).Equal((math.LegacyNewDecFromBigInt(tc.msg.Amount.Amount.BigInt()))))
 			} else {
 				suite.Require().Error(err)
 			}
@@ -122,7 +122,7 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 	// Fund the pool first
 	fundMsg := types.NewMsgFundPool(
 		suite.TestAccs[0],
-		sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(100000)))
+		sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(100000)))
 	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
 	suite.Require().NoError(err)
 
@@ -130,10 +130,10 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 	authority := suite.App.RewardsKeeper.GetAuthority()
 
 	// Valid base schedule
-	validEndTime := time.Now().Add(time.Hour * 24)
+	validEndTime := suite.Ctx.BlockTime().Add(time.Hour * 24)
 	validSchedule := types.ReleaseSchedule{
-		TotalAmount:     sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(50000)),
-		ReleasedAmount:  sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(0)),
+		TotalAmount:     sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(50000)),
+		ReleasedAmount:  sdk.NewCoin(defaultParams.AllowedDenoms[0], math.NewInt(0)),
 		EndTime:         validEndTime,
 		LastReleaseTime: time.Time{},
 		Active:          true,
@@ -211,7 +211,7 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			name:      "end time in past",
 			authority: authority,
 			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
-				s.EndTime = time.Now().Add(-time.Hour)
+				s.EndTime = suite.Ctx.BlockTime().Add(-time.Hour)
 				return s
 			},
 			expectedPass: false,
@@ -220,7 +220,7 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			name:      "last release in future",
 			authority: authority,
 			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
-				s.LastReleaseTime = time.Now().Add(time.Hour)
+				s.LastReleaseTime = suite.Ctx.BlockTime().Add(time.Hour)
 				return s
 			},
 			expectedPass: false,
@@ -283,4 +283,14 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			}
 		})
 	}
+	// The times are checked against the block time, not the clock
+	ctx := suite.Ctx.WithBlockTime(validEndTime.Add(time.Hour))
+	_, err = suite.msgServer.ChangeSchedule(ctx, types.NewMsgChangeSchedule(authority, validSchedule))
+	suite.Require().ErrorContains(err, "is not in the future")
+
+	pastSchedule := validSchedule
+	pastSchedule.EndTime = time.Now().Add(-time.Hour)
+	ctx = suite.Ctx.WithBlockTime(pastSchedule.EndTime.Add(-time.Hour))
+	_, err = suite.msgServer.ChangeSchedule(ctx, types.NewMsgChangeSchedule(authority, pastSchedule))
+	suite.Require().NoError(err)
 }
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// GetCommittedFunds returns the pool funds still to be released by the active schedules
+func (k Keeper) GetCommittedFunds(ctx context.Context) (sdk.Coins, error) {
+	// Add the main schedule
+	schedule, err := k.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	committed := schedule.GetCommittedFunds()
+
+	// Add the named schedules, paused schedules are still committed
+	err = k.Schedules.Walk(ctx, nil, func(_ string, schedule types.Schedule) (bool, error) {
+		committed = committed.Add(schedule.Release.GetCommittedFunds()...)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return committed, nil
+}
+
+// GetFreeFunds returns the pool funds not committed to any schedule
+func (k Keeper) GetFreeFunds(ctx context.Context) (sdk.DecCoins, error) {
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	committed, err := k.GetCommittedFunds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardPool.GetFreeFunds(committed), nil
+}
+
+// WithdrawFromPool sends funds not committed to any schedule from the pool to a recipient
+func (k Keeper) WithdrawFromPool(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
+	// Validate the amount
+	if err := validateAmount(amount); err != nil {
+		return err
+	}
+	if amount.IsZero() {
+		return fmt.Errorf("withdraw amount cannot be zero")
+	}
+
+	// Check the funds are not committed
+	if err := k.fundsAvailable(ctx, amount, nil); err != nil {
+		return fmt.Errorf("insufficient funds: %w", err)
+	}
+
+	// Send the funds and deduct them from the pool
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	rewardPool.CommunityPool = rewardPool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(coins...))
+	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawFromPool,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
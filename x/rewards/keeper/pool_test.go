@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawFromPool() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Commit part of the pool to a schedule
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(60000)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	recipient := suite.TestAccs[1]
+
+	testCases := []struct {
+		name         string
+		msg          *types.MsgWithdrawFromPool
+		expectedPass bool
+	}{
+		{
+			name:         "valid withdraw of free funds",
+			msg:          types.NewMsgWithdrawFromPool(authority, recipient, sdk.NewCoin(denom, math.NewInt(40000))),
+			expectedPass: true,
+		},
+		{
+			name:         "invalid authority",
+			msg:          types.NewMsgWithdrawFromPool(suite.TestAccs[0].String(), recipient, sdk.NewCoin(denom, math.NewInt(40000))),
+			expectedPass: false,
+		},
+		{
+			name: "invalid recipient",
+			msg: &types.MsgWithdrawFromPool{
+				Authority: authority,
+				Recipient: "invalid",
+				Amount:    sdk.NewCoin(denom, math.NewInt(40000)),
+			},
+			expectedPass: false,
+		},
+		{
+			name:         "zero amount",
+			msg:          types.NewMsgWithdrawFromPool(authority, recipient, sdk.NewCoin(denom, math.ZeroInt())),
+			expectedPass: false,
+		},
+		{
+			name:         "committed funds",
+			msg:          types.NewMsgWithdrawFromPool(authority, recipient, sdk.NewCoin(denom, math.NewInt(40001))),
+			expectedPass: false,
+		},
+		{
+			name:         "denom not in the pool",
+			msg:          types.NewMsgWithdrawFromPool(authority, recipient, sdk.NewCoin("other", math.NewInt(1))),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			initialBalance := suite.App.BankKeeper.GetBalance(ctx, recipient, denom)
+
+			_, err := suite.msgServer.WithdrawFromPool(ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify the recipient balance and the pool
+				balance := suite.App.BankKeeper.GetBalance(ctx, recipient, denom)
+				suite.Require().Equal(initialBalance.Add(tc.msg.Amount), balance)
+				rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(math.LegacyNewDec(60000), rewardPool.CommunityPool.AmountOf(denom))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCommittedFunds() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Commit funds on the main schedule
+	now := suite.Ctx.BlockTime()
+	_, err = suite.msgServer.ChangeSchedule(suite.Ctx, types.NewMsgChangeSchedule(suite.App.RewardsKeeper.GetAuthority(), types.ReleaseSchedule{
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(70000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndTime:        time.Now().Add(time.Hour),
+		Active:         true,
+	}))
+	suite.Require().NoError(err)
+
+	// Named schedules can't use the committed funds
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "over", sdk.NewCoin(denom, math.NewInt(30001)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+	suite.Require().Error(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(30000)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// The main schedule can be replaced using its own committed funds
+	_, err = suite.msgServer.ChangeSchedule(suite.Ctx, types.NewMsgChangeSchedule(suite.App.RewardsKeeper.GetAuthority(), types.ReleaseSchedule{
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(70000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndTime:        time.Now().Add(2 * time.Hour),
+		Active:         true,
+	}))
+	suite.Require().NoError(err)
+
+	// The query splits the pool
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	res, err := querier.RewardPool(suite.Ctx, &types.QueryRewardPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100000))), res.CommittedFunds)
+	suite.Require().True(res.FreeFunds.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMultiDenomPool() {
+	// Allow a second denom and fund the pool with both
+	params := types.DefaultParams()
+	denom, otherDenom := params.AllowedDenoms[0], "uatom"
+	params.AllowedDenoms = append(params.AllowedDenoms, otherDenom)
+	_, err := suite.msgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(suite.App.RewardsKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin(otherDenom, math.NewInt(10000))))
+	for _, amount := range []sdk.Coin{sdk.NewCoin(denom, math.NewInt(10000)), sdk.NewCoin(otherDenom, math.NewInt(10000))} {
+		_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], amount))
+		suite.Require().NoError(err)
+	}
+
+	// Denoms out of the allow-list are rejected
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uosmo", math.NewInt(10000))))
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin("uosmo", math.NewInt(10000))))
+	suite.Require().Error(err)
+
+	// Each denom is committed separately
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "native", sdk.NewCoin(denom, math.NewInt(4000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "partner", sdk.NewCoin(otherDenom, math.NewInt(10000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "over", sdk.NewCoin(otherDenom, math.NewInt(1)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().Error(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "unknown", sdk.NewCoin("uosmo", math.NewInt(1)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().Error(err)
+
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	pool, err := querier.RewardPool(suite.Ctx, &types.QueryRewardPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(4000)), sdk.NewCoin(otherDenom, math.NewInt(10000))), pool.CommittedFunds)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(6000))), pool.FreeFunds)
+
+	// The schedules are filtered by denom
+	schedules, err := querier.Schedules(suite.Ctx, &types.QuerySchedulesRequest{Denom: otherDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(schedules.Schedules, 1)
+	suite.Require().Equal("partner", schedules.Schedules[0].Name)
+
+	// Both denoms are released
+	ctx := suite.Ctx.WithBlockTime(now.Add(2 * time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(8000), rewardPool.CommunityPool.AmountOf(denom))
+	suite.Require().Equal(math.LegacyNewDec(5000), rewardPool.CommunityPool.AmountOf(otherDenom))
+
+	// A denom committed to schedules can't be removed from the allow-list
+	params.AllowedDenoms = []string{denom}
+	_, err = suite.msgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(suite.App.RewardsKeeper.GetAuthority(), params))
+	suite.Require().Error(err)
+}
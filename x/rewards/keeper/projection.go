@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// aprPeriod is the period projected to estimate the staking APR
+const aprPeriod = 365 * 24 * time.Hour
+
+// ProjectRelease projects the rewards released by the active schedules between two times
+// An empty denom projects the schedules of all denoms, otherwise only the schedules releasing the denom
+// Paused schedules release nothing, the release cap and chain halts are not projected
+func (k Keeper) ProjectRelease(ctx sdk.Context, from, to time.Time, denom string) (sdk.Coins, []types.ScheduleProjection, error) {
+	if !to.After(from) {
+		return nil, nil, fmt.Errorf("projection end %s must be after the start %s", to, from)
+	}
+	total := sdk.NewCoins()
+	var projections []types.ScheduleProjection
+
+	// Project the main schedule
+	schedule, err := k.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if amount := schedule.ProjectRelease(ctx.BlockTime(), from, to); matchesDenom(amount, denom) && amount.IsPositive() {
+		total = total.Add(amount)
+		projections = append(projections, types.ScheduleProjection{Name: "", Amount: amount})
+	}
+
+	// Project the named schedules
+	schedules, err := k.GetAllSchedules(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, schedule := range schedules {
+		if schedule.Paused {
+			continue
+		}
+		if amount := schedule.Release.ProjectRelease(ctx.BlockTime(), from, to); matchesDenom(amount, denom) && amount.IsPositive() {
+			total = total.Add(amount)
+			projections = append(projections, types.ScheduleProjection{Name: schedule.Name, Amount: amount})
+		}
+	}
+
+	return total, projections, nil
+}
+
+// matchesDenom returns if the amount is on the denom, an empty denom matches all
+func matchesDenom(amount sdk.Coin, denom string) bool {
+	return denom == "" || amount.Denom == denom
+}
+
+// EstimateStakingAPR estimates the staking APR from the rewards projected for the next year
+// Only the rewards on the bond denom sent to the fee collector reach the stakers, after the developer share and the community tax
+func (k Keeper) EstimateStakingAPR(ctx sdk.Context) (*types.QueryEstimatedStakingAPRResponse, error) {
+	from := ctx.BlockTime()
+	to := from.Add(aprPeriod)
+
+	// The developer share is projected as taken from every release
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	developerShare, _ := params.GetDeveloperShare()
+
+	// Rewards on other denoms can't be compared with the bonded tokens
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stakingRewards := math.LegacyZeroDec()
+
+	// Add the share of the main schedule sent to the stakers
+	schedule, err := k.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if amount := schedule.ProjectRelease(from, from, to); amount.Denom == bondDenom {
+		stakingRewards = stakingRewards.Add(schedule.GetStakingShare(developerShare).MulInt(amount.Amount))
+	}
+
+	// Add the share of the named schedules sent to the stakers
+	schedules, err := k.GetAllSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, schedule := range schedules {
+		if schedule.Paused {
+			continue
+		}
+		if amount := schedule.Release.ProjectRelease(from, from, to); amount.Denom == bondDenom {
+			stakingRewards = stakingRewards.Add(schedule.Release.GetStakingShare(developerShare).MulInt(amount.Amount))
+		}
+	}
+
+	// Deduct the community tax
+	communityTax, err := k.distrKeeper.GetCommunityTax(ctx)
+	if err != nil {
+		return nil, err
+	}
+	annualRewards := stakingRewards.Mul(math.LegacyOneDec().Sub(communityTax)).TruncateInt()
+
+	// Get the bonded tokens
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimatedStakingAPRResponse{
+		Apr:                  types.CalculateStakingAPR(annualRewards, totalBonded),
+		AnnualStakingRewards: sdk.NewCoin(bondDenom, annualRewards),
+		TotalBonded:          totalBonded,
+		CommunityTax:         communityTax,
+	}, nil
+}
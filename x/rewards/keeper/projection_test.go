@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestQuerierProjectedRelease() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a running schedule and a paused one
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "running", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "paused", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.PauseSchedule(suite.Ctx, "paused", true)
+	suite.Require().NoError(err)
+
+	// Only the running schedule is projected
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	res, err := querier.ProjectedRelease(suite.Ctx, &types.QueryProjectedReleaseRequest{
+		FromTime: now,
+		ToTime:   now.Add(2 * time.Hour),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(500))), res.Amount)
+	suite.Require().Equal([]types.ScheduleProjection{{Name: "running", Amount: sdk.NewCoin(denom, math.NewInt(500))}}, res.Schedules)
+
+	// Other denoms project nothing
+	res, err = querier.ProjectedRelease(suite.Ctx, &types.QueryProjectedReleaseRequest{
+		FromTime: now,
+		ToTime:   now.Add(2 * time.Hour),
+		Denom:    "other",
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Amount.IsZero())
+	suite.Require().Empty(res.Schedules)
+
+	// The end must be after the start
+	_, err = querier.ProjectedRelease(suite.Ctx, &types.QueryProjectedReleaseRequest{FromTime: now, ToTime: now})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerierEstimatedStakingAPR() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule split between the fee collector and the community pool
+	now := suite.Ctx.BlockTime()
+	destinations := []types.ReleaseDestination{
+		types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 1),
+	}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "split", sdk.NewCoin(denom, math.NewInt(10000)), now, now.Add(4*time.Hour), destinations, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	res, err := querier.EstimatedStakingAPR(suite.Ctx, &types.QueryEstimatedStakingAPRRequest{})
+	suite.Require().NoError(err)
+
+	// Half of the schedule reaches the stakers after the community tax
+	communityTax, err := suite.App.DistrKeeper.GetCommunityTax(suite.Ctx)
+	suite.Require().NoError(err)
+	totalBonded, err := suite.App.StakingKeeper.TotalBondedTokens(suite.Ctx)
+	suite.Require().NoError(err)
+	annualRewards := math.LegacyOneDec().Sub(communityTax).MulInt64(5000).TruncateInt()
+
+	suite.Require().Equal(sdk.NewCoin(denom, annualRewards), res.AnnualStakingRewards)
+	suite.Require().Equal(totalBonded, res.TotalBonded)
+	suite.Require().Equal(communityTax, res.CommunityTax)
+	suite.Require().Equal(types.CalculateStakingAPR(annualRewards, totalBonded), res.Apr)
+}
+
+func (suite *KeeperTestSuite) TestQuerierEstimatedStakingAPRDeveloperShare() {
+	// Set up params with a developer share and fund the pool
+	params := types.DefaultParams()
+	params.DeveloperShare = math.LegacyMustNewDecFromStr("0.2")
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	denom := params.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule sent to the fee collector
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "developers", sdk.NewCoin(denom, math.NewInt(10000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	res, err := querier.EstimatedStakingAPR(suite.Ctx, &types.QueryEstimatedStakingAPRRequest{})
+	suite.Require().NoError(err)
+
+	// The developer share is deducted before the community tax
+	communityTax, err := suite.App.DistrKeeper.GetCommunityTax(suite.Ctx)
+	suite.Require().NoError(err)
+	annualRewards := math.LegacyOneDec().Sub(communityTax).MulInt64(8000).TruncateInt()
+
+	suite.Require().Equal(sdk.NewCoin(denom, annualRewards), res.AnnualStakingRewards)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// RegisterRevenue registers a wasm or EVM contract for the developer revenue, or changes its withdraw address
+// The sender must be the contract admin, proved on each registration, and the withdraw address defaults to the sender
+func (k Keeper) RegisterRevenue(ctx sdk.Context, sender, contract, withdrawAddress sdk.AccAddress, nonces []uint64) error {
+	// Check if the sender can manage the contract
+	if err := k.contractAdminKeeper.ValidateContractAdmin(ctx, sender, contract, nonces); err != nil {
+		return err
+	}
+
+	if withdrawAddress.Empty() {
+		withdrawAddress = sender
+	}
+	return k.RevenueContracts.Set(ctx, contract, types.NewRevenueContract(contract, sender, withdrawAddress))
+}
+
+// GetRevenueContract returns a contract registered for the developer revenue
+func (k Keeper) GetRevenueContract(ctx context.Context, contract sdk.AccAddress) (types.RevenueContract, error) {
+	revenueContract, err := k.RevenueContracts.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RevenueContract{}, fmt.Errorf("contract %s is not registered for the developer revenue", contract)
+	}
+	return revenueContract, err
+}
+
+// GetAllRevenueContracts returns all the contracts registered for the developer revenue
+func (k Keeper) GetAllRevenueContracts(ctx context.Context) ([]types.RevenueContract, error) {
+	var contracts []types.RevenueContract
+	err := k.RevenueContracts.Walk(ctx, nil, func(_ sdk.AccAddress, contract types.RevenueContract) (bool, error) {
+		contracts = append(contracts, contract)
+		return false, nil
+	})
+	return contracts, err
+}
+
+// RecordRevenueFees adds the fees paid for the executions of a contract on the current block
+// Only the registered contracts are recorded, the fees weight the developer share of the next releases
+func (k Keeper) RecordRevenueFees(ctx sdk.Context, contract sdk.AccAddress, fees math.Int) error {
+	if !fees.IsPositive() {
+		return nil
+	}
+
+	// Nothing is recorded while the developer revenue is disabled
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if _, enabled := params.GetDeveloperShare(); !enabled {
+		return nil
+	}
+
+	found, err := k.RevenueContracts.Has(ctx, contract)
+	if err != nil || !found {
+		return err
+	}
+
+	// Add the fees to the block usage of the contract
+	current, err := k.RevenueFees.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		current = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	return k.RevenueFees.Set(ctx, contract, current.Add(fees))
+}
+
+// takeDeveloperRevenue accrues the developer share of a release to the contracts executed on the previous block
+// The share is split by the fees paid for the contracts and the rest of the release is returned
+func (k Keeper) takeDeveloperRevenue(ctx sdk.Context, params types.Params, name string, amount sdk.Coin) (sdk.Coin, error) {
+	share, enabled := params.GetDeveloperShare()
+	if !enabled {
+		return amount, nil
+	}
+
+	// Collect the fees paid for the contracts on the previous block
+	var contracts []sdk.AccAddress
+	var feesPaid []math.Int
+	err := k.RevenueFees.Walk(ctx, nil, func(contract sdk.AccAddress, fees math.Int) (bool, error) {
+		if fees.IsPositive() {
+			contracts = append(contracts, contract)
+			feesPaid = append(feesPaid, fees)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return amount, err
+	}
+
+	// The whole release goes to the destinations when no fees were paid for the contracts
+	revenue := sdk.NewCoin(amount.Denom, share.MulInt(amount.Amount).TruncateInt())
+	if len(contracts) == 0 || revenue.IsZero() {
+		return amount, nil
+	}
+
+	// Accrue the revenue to the withdraw addresses, the funds are kept on the module account
+	shares := types.SplitRevenue(revenue, feesPaid)
+	for i, contract := range contracts {
+		if shares[i].IsZero() {
+			continue
+		}
+
+		revenueContract, err := k.GetRevenueContract(ctx, contract)
+		if err != nil {
+			return amount, err
+		}
+		withdrawAddress, err := sdk.AccAddressFromBech32(revenueContract.WithdrawAddress)
+		if err != nil {
+			return amount, err
+		}
+
+		claimable, err := k.GetClaimableRevenue(ctx, withdrawAddress)
+		if err != nil {
+			return amount, err
+		}
+		err = k.ClaimableRevenues.Set(ctx, withdrawAddress, types.NewClaimableRevenue(withdrawAddress, claimable.Add(shares[i])))
+		if err != nil {
+			return amount, err
+		}
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventDeveloperRevenue{
+			Schedule:        name,
+			Contract:        revenueContract.Contract,
+			WithdrawAddress: revenueContract.WithdrawAddress,
+			FeesPaid:        feesPaid[i],
+			Amount:          shares[i],
+		})
+		if err != nil {
+			return amount, err
+		}
+	}
+
+	return amount.Sub(revenue), nil
+}
+
+// GetClaimableRevenue returns the developer revenue accrued to a withdraw address
+func (k Keeper) GetClaimableRevenue(ctx context.Context, address sdk.AccAddress) (sdk.Coins, error) {
+	revenue, err := k.ClaimableRevenues.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.NewCoins(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return revenue.Amount, nil
+}
+
+// GetAllClaimableRevenues returns the developer revenues not claimed yet
+func (k Keeper) GetAllClaimableRevenues(ctx context.Context) ([]types.ClaimableRevenue, error) {
+	var revenues []types.ClaimableRevenue
+	err := k.ClaimableRevenues.Walk(ctx, nil, func(_ sdk.AccAddress, revenue types.ClaimableRevenue) (bool, error) {
+		revenues = append(revenues, revenue)
+		return false, nil
+	})
+	return revenues, err
+}
+
+// ClaimRevenue sends the developer revenue accrued to a withdraw address
+func (k Keeper) ClaimRevenue(ctx sdk.Context, address sdk.AccAddress) (sdk.Coins, error) {
+	amount, err := k.GetClaimableRevenue(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsZero() {
+		return nil, fmt.Errorf("no revenue to claim for %s", address)
+	}
+
+	// Send the revenue from the module account
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, amount); err != nil {
+		return nil, err
+	}
+	if err := k.ClaimableRevenues.Remove(ctx, address); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimRevenue{
+		WithdrawAddress: address.String(),
+		Amount:          amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterRevenue() {
+	// Only contracts can be registered
+	_, err := suite.msgServer.RegisterRevenue(suite.Ctx, types.NewMsgRegisterRevenue(suite.TestAccs[0], suite.TestAccs[1], nil, nil))
+	suite.Require().Error(err)
+
+	// Invalid addresses are rejected
+	_, err = suite.msgServer.RegisterRevenue(suite.Ctx, &types.MsgRegisterRevenue{Sender: suite.TestAccs[0].String(), Contract: "invalid"})
+	suite.Require().Error(err)
+
+	// Unregistered contracts aren't found
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	_, err = querier.RevenueContract(suite.Ctx, &types.QueryRevenueContractRequest{Contract: suite.TestAccs[1].String()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDeveloperRevenue() {
+	rewardsKeeper := suite.App.RewardsKeeper
+	denom := types.DefaultParams().AllowedDenoms[0]
+	admin, withdrawAddress := suite.TestAccs[0], suite.TestAccs[1]
+	contractA := sdk.AccAddress([]byte("contract_a__________"))
+	contractB := sdk.AccAddress([]byte("contract_b__________"))
+	unregistered := sdk.AccAddress([]byte("unregistered________"))
+
+	// Register the contracts, one of them paying to another withdraw address
+	err := rewardsKeeper.RevenueContracts.Set(suite.Ctx, contractA, types.NewRevenueContract(contractA, admin, admin))
+	suite.Require().NoError(err)
+	err = rewardsKeeper.RevenueContracts.Set(suite.Ctx, contractB, types.NewRevenueContract(contractB, admin, withdrawAddress))
+	suite.Require().NoError(err)
+
+	// Nothing is recorded while the developer share is disabled
+	err = rewardsKeeper.RecordRevenueFees(suite.Ctx, contractA, math.NewInt(10_000))
+	suite.Require().NoError(err)
+	found, err := rewardsKeeper.RevenueFees.Has(suite.Ctx, contractA)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// Enable a developer share of 10%
+	params := types.DefaultParams()
+	params.DeveloperShare = math.LegacyNewDecWithPrec(1, 1)
+	_, err = suite.msgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(rewardsKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	// Fund the pool and release 2000 on the next block
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(10000))))
+	suite.Require().NoError(err)
+	now := suite.Ctx.BlockTime()
+	err = rewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(4000)), now, now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Record the fees paid, the fees of the same contract add up
+	for _, contract := range []sdk.AccAddress{contractA, unregistered, contractA, contractB, contractA} {
+		err = rewardsKeeper.RecordRevenueFees(suite.Ctx, contract, math.NewInt(10_000))
+		suite.Require().NoError(err)
+	}
+	found, err = rewardsKeeper.RevenueFees.Has(suite.Ctx, unregistered)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// The release pays 200 to the contracts by fees paid, 3 to 1
+	ctx := suite.Ctx.WithBlockTime(now.Add(2 * time.Hour))
+	err = rewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	querier := keeper.NewQuerier(rewardsKeeper)
+	claimable, err := querier.ClaimableRevenue(ctx, &types.QueryClaimableRevenueRequest{Address: admin.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(150))), claimable.Amount)
+	claimable, err = querier.ClaimableRevenue(ctx, &types.QueryClaimableRevenueRequest{Address: withdrawAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(50))), claimable.Amount)
+
+	// The fee tallies are cleared after the release
+	found, err = rewardsKeeper.RevenueFees.Has(ctx, contractA)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// The revenue is claimed from the module account
+	balanceBefore := suite.App.BankKeeper.GetBalance(ctx, withdrawAddress, denom)
+	res, err := suite.msgServer.ClaimRevenue(ctx, types.NewMsgClaimRevenue(withdrawAddress))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(50))), res.Amount)
+	balanceAfter := suite.App.BankKeeper.GetBalance(ctx, withdrawAddress, denom)
+	suite.Require().Equal(math.NewInt(50), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	// Nothing is left to claim
+	_, err = suite.msgServer.ClaimRevenue(ctx, types.NewMsgClaimRevenue(withdrawAddress))
+	suite.Require().Error(err)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// GetAllSchedules returns all the named schedules
+func (k Keeper) GetAllSchedules(ctx sdk.Context) ([]types.Schedule, error) {
+	iterator, err := k.Schedules.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return iterator.Values()
+}
+
+// CreateSchedule validates and stores a new named schedule
+// The release starts on the start time, or on the current block if the start time already passed
+// Non-linear curves are calculated from the start time
+func (k Keeper) CreateSchedule(
+	ctx sdk.Context, name string, totalAmount sdk.Coin, startTime, endTime time.Time,
+	destinations []types.ReleaseDestination, curve types.EmissionCurve,
+) error {
+	// Validate the name, it must be unique
+	if err := types.ValidateScheduleName(name); err != nil {
+		return err
+	}
+	found, err := k.Schedules.Has(ctx, name)
+	if err != nil {
+		return err
+	}
+	if found {
+		return fmt.Errorf("schedule %s already exists", name)
+	}
+
+	// Validate the amount against the module params
+	if err := validateAmount(totalAmount); err != nil {
+		return fmt.Errorf("invalid total amount: %w", err)
+	}
+	if totalAmount.IsZero() {
+		return fmt.Errorf("total amount cannot be zero")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get module params: %w", err)
+	}
+	if !params.IsAllowedDenom(totalAmount.Denom) {
+		return fmt.Errorf("denom %s is not allowed, allowed denoms: %v", totalAmount.Denom, params.AllowedDenoms)
+	}
+
+	// Time validations
+	if !startTime.Before(endTime) {
+		return fmt.Errorf("start time %s must be before the end time %s", startTime, endTime)
+	}
+	if !endTime.After(ctx.BlockTime()) {
+		return fmt.Errorf("end time %s is not in the future", endTime)
+	}
+
+	// Validate the destinations, empty sends to the fee collector
+	if err := k.validateDestinations(ctx, destinations); err != nil {
+		return fmt.Errorf("invalid destinations: %w", err)
+	}
+
+	// Validate the curve, starting it on the start time
+	if curve.Type != types.CurveTypeLinear {
+		curve.StartTime = startTime
+	}
+	if err := curve.Validate(endTime); err != nil {
+		return fmt.Errorf("invalid curve: %w", err)
+	}
+
+	// Check available funds
+	if err := k.fundsAvailable(ctx, totalAmount, nil); err != nil {
+		return fmt.Errorf("insufficient funds: %w", err)
+	}
+
+	// The release is calculated from the start time
+	schedule := types.NewSchedule(name, totalAmount, startTime, endTime, destinations)
+	schedule.Release.Curve = curve
+	schedule.Release.LastReleaseTime = startTime
+	if startTime.Before(ctx.BlockTime()) {
+		schedule.Release.LastReleaseTime = ctx.BlockTime()
+	}
+
+	if err := k.Schedules.Set(ctx, name, schedule); err != nil {
+		return err
+	}
+	return emitScheduleChange(ctx, name, types.ScheduleActionCreate, schedule.Release)
+}
+
+// PauseSchedule pauses or resumes a named schedule
+// The paused time isn't released, the remaining amount is released until the end time once resumed
+// and the non-linear curves are delayed by the paused time
+func (k Keeper) PauseSchedule(ctx sdk.Context, name string, paused bool) error {
+	schedule, err := k.Schedules.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("schedule %s not found: %w", name, err)
+	}
+	if schedule.Paused == paused {
+		return fmt.Errorf("schedule %s already has paused set to %t", name, paused)
+	}
+
+	// Skip the paused period when resuming, unless the release already ended
+	blockTime := ctx.BlockTime()
+	if !paused && schedule.Release.LastReleaseTime.Before(blockTime) && blockTime.Before(schedule.Release.EndTime) {
+		// Non-linear curves are shifted by the paused period to keep their shape
+		if schedule.Release.Curve.Type != types.CurveTypeLinear {
+			schedule.Release = schedule.Release.Shift(blockTime.Sub(schedule.Release.LastReleaseTime))
+		}
+		schedule.Release.LastReleaseTime = blockTime
+	}
+
+	schedule.Paused = paused
+	if err := k.Schedules.Set(ctx, name, schedule); err != nil {
+		return err
+	}
+
+	action := types.ScheduleActionResume
+	if paused {
+		action = types.ScheduleActionPause
+	}
+	return emitScheduleChange(ctx, name, action, schedule.Release)
+}
+
+// CancelSchedule removes a named schedule
+// The amount not released yet stays in the reward pool
+func (k Keeper) CancelSchedule(ctx sdk.Context, name string) error {
+	schedule, err := k.Schedules.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("schedule %s not found: %w", name, err)
+	}
+
+	if err := k.Schedules.Remove(ctx, name); err != nil {
+		return err
+	}
+	return emitScheduleChange(ctx, name, types.ScheduleActionCancel, schedule.Release)
+}
+
+// emitScheduleChange emits the typed event of a schedule changed by governance
+func emitScheduleChange(ctx sdk.Context, name string, action types.ScheduleAction, release types.ReleaseSchedule) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventScheduleChange{
+		Schedule: name,
+		Action:   action,
+		Release:  release,
+	})
+}
@@ -0,0 +1,411 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateSchedule() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+
+	// Fund the pool first
+	fundMsg := types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(100000)))
+	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
+	suite.Require().NoError(err)
+
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	now := suite.Ctx.BlockTime()
+
+	// Create a schedule that already exists
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "existing", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		msg          *types.MsgCreateSchedule
+		expectedPass bool
+	}{
+		{
+			name:         "valid schedule",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.NewInt(50000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: true,
+		},
+		{
+			name: "valid schedule with destination",
+			msg: types.NewMsgCreateSchedule(authority, "grants", sdk.NewCoin(denom, math.NewInt(50000)), now.Add(time.Hour), now.Add(2*time.Hour), []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeAddress, suite.TestAccs[1].String(), 1),
+			}, types.EmissionCurve{}),
+			expectedPass: true,
+		},
+		{
+			name:         "invalid authority",
+			msg:          types.NewMsgCreateSchedule(suite.TestAccs[0].String(), "ecosystem", sdk.NewCoin(denom, math.NewInt(50000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "duplicated name",
+			msg:          types.NewMsgCreateSchedule(authority, "existing", sdk.NewCoin(denom, math.NewInt(50000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "invalid name",
+			msg:          types.NewMsgCreateSchedule(authority, "invalid name", sdk.NewCoin(denom, math.NewInt(50000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "invalid denom",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin("invalid", math.NewInt(50000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "zero total amount",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.ZeroInt()), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "start after end",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.NewInt(50000)), now.Add(time.Hour), now, nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "end in the past",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.NewInt(50000)), now.Add(-2*time.Hour), now.Add(-time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name: "invalid destination",
+			msg: types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.NewInt(50000)), now, now.Add(time.Hour), []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeAddress, "invalid", 1),
+			}, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+		{
+			name:         "insufficient funds",
+			msg:          types.NewMsgCreateSchedule(authority, "ecosystem", sdk.NewCoin(denom, math.NewInt(200000)), now, now.Add(time.Hour), nil, types.EmissionCurve{}),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+
+			_, err := suite.msgServer.CreateSchedule(ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify the stored schedule
+				schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, tc.msg.Name)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.TotalAmount, schedule.Release.TotalAmount)
+				suite.Require().True(schedule.Release.ReleasedAmount.IsZero())
+				suite.Require().True(schedule.Release.Active)
+				suite.Require().True(tc.msg.StartTime.Equal(schedule.StartTime))
+				suite.Require().True(tc.msg.StartTime.Equal(schedule.Release.LastReleaseTime))
+				suite.Require().True(tc.msg.EndTime.Equal(schedule.Release.EndTime))
+				suite.Require().Equal(tc.msg.Destinations, schedule.Release.Destinations)
+				suite.Require().False(schedule.Paused)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPauseSchedule() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(2*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Only the authority can pause
+	_, err = suite.msgServer.PauseSchedule(suite.Ctx, types.NewMsgPauseSchedule(suite.TestAccs[0].String(), "ecosystem", true))
+	suite.Require().Error(err)
+
+	// Unknown schedules can't be paused
+	_, err = suite.msgServer.PauseSchedule(suite.Ctx, types.NewMsgPauseSchedule(authority, "unknown", true))
+	suite.Require().Error(err)
+
+	// Pause the schedule, pausing twice fails
+	_, err = suite.msgServer.PauseSchedule(suite.Ctx, types.NewMsgPauseSchedule(authority, "ecosystem", true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.PauseSchedule(suite.Ctx, types.NewMsgPauseSchedule(authority, "ecosystem", true))
+	suite.Require().Error(err)
+
+	// Nothing is released while paused
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "ecosystem")
+	suite.Require().NoError(err)
+	suite.Require().True(schedule.Release.ReleasedAmount.IsZero())
+
+	// Resuming skips the paused time
+	_, err = suite.msgServer.PauseSchedule(ctx, types.NewMsgPauseSchedule(authority, "ecosystem", false))
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "ecosystem")
+	suite.Require().NoError(err)
+	suite.Require().False(schedule.Paused)
+	suite.Require().True(ctx.BlockTime().Equal(schedule.Release.LastReleaseTime))
+
+	// The remaining amount is released until the end time
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "ecosystem")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(500)), schedule.Release.ReleasedAmount)
+}
+
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	now := suite.Ctx.BlockTime()
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "ecosystem", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Only the authority can cancel
+	_, err = suite.msgServer.CancelSchedule(suite.Ctx, types.NewMsgCancelSchedule(suite.TestAccs[0].String(), "ecosystem"))
+	suite.Require().Error(err)
+
+	// Unknown schedules can't be cancelled
+	_, err = suite.msgServer.CancelSchedule(suite.Ctx, types.NewMsgCancelSchedule(authority, "unknown"))
+	suite.Require().Error(err)
+
+	// Cancel the schedule
+	_, err = suite.msgServer.CancelSchedule(suite.Ctx, types.NewMsgCancelSchedule(authority, "ecosystem"))
+	suite.Require().NoError(err)
+	found, err := suite.App.RewardsKeeper.Schedules.Has(suite.Ctx, "ecosystem")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// The pool keeps the funds
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(100000), rewardPool.CommunityPool.AmountOf(denom))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerSchedules() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create concurrent schedules, one of them starting later
+	now := suite.Ctx.BlockTime()
+	destination := suite.TestAccs[1]
+	destinations := []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeAddress, destination.String(), 1)}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "fees", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(2*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "grants", sdk.NewCoin(denom, math.NewInt(2000)), now, now.Add(time.Hour), destinations, types.EmissionCurve{})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "later", sdk.NewCoin(denom, math.NewInt(3000)), now.Add(3*time.Hour), now.Add(4*time.Hour), nil, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Get the initial balances
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress("fee_collector")
+	initialFeeCollectorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollectorAddr, denom)
+	initialDestinationBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, destination, denom)
+
+	// Release half of the first schedule and all of the second
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	// Check the balances
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom)
+	suite.Require().Equal(initialFeeCollectorBalance.AddAmount(math.NewInt(500)), feeCollectorBalance)
+	destinationBalance := suite.App.BankKeeper.GetBalance(ctx, destination, denom)
+	suite.Require().Equal(initialDestinationBalance.AddAmount(math.NewInt(2000)), destinationBalance)
+
+	// Check the pool
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(97500), rewardPool.CommunityPool.AmountOf(denom))
+
+	// Check the schedules
+	schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "fees")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(500)), schedule.Release.ReleasedAmount)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "grants")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(2000)), schedule.Release.ReleasedAmount)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "later")
+	suite.Require().NoError(err)
+	suite.Require().True(schedule.Release.ReleasedAmount.IsZero())
+
+	// Finished schedules are removed on the next block
+	ctx = ctx.WithBlockTime(now.Add(61 * time.Minute))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	found, err := suite.App.RewardsKeeper.Schedules.Has(ctx, "grants")
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestQuerierSchedules() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create the schedules
+	now := suite.Ctx.BlockTime()
+	for _, name := range []string{"a", "b", "c"} {
+		err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, name, sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(time.Hour), nil, types.EmissionCurve{})
+		suite.Require().NoError(err)
+	}
+
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+
+	// Query all the schedules
+	res, err := querier.Schedules(suite.Ctx, &types.QuerySchedulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedules, 3)
+
+	// Query a page
+	res, err = querier.Schedules(suite.Ctx, &types.QuerySchedulesRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedules, 2)
+	suite.Require().Equal("a", res.Schedules[0].Name)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerScheduleCurve() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Create a schedule with a cliff after one hour
+	now := suite.Ctx.BlockTime()
+	curve := types.EmissionCurve{Type: types.CurveTypeCliffLinear, CliffTime: now.Add(time.Hour)}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "cliff", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(4*time.Hour), nil, curve)
+	suite.Require().NoError(err)
+
+	// Nothing is released before the cliff, but the schedule stays active
+	ctx := suite.Ctx.WithBlockTime(now.Add(30 * time.Minute))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err := suite.App.RewardsKeeper.Schedules.Get(ctx, "cliff")
+	suite.Require().NoError(err)
+	suite.Require().True(schedule.Release.Active)
+	suite.Require().True(schedule.Release.ReleasedAmount.IsZero())
+	suite.Require().True(now.Equal(schedule.Release.Curve.StartTime))
+
+	// The amount accrued until the cliff is released on the cliff
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "cliff")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(250)), schedule.Release.ReleasedAmount)
+
+	// Everything is released after the end time
+	ctx = ctx.WithBlockTime(now.Add(5 * time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.Schedules.Get(ctx, "cliff")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(1000)), schedule.Release.ReleasedAmount)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockerScheduleDestinations() {
+	// Set up default params and fund the pool
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+	denom := defaultParams.AllowedDenoms[0]
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(100000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Unknown module accounts can't receive rewards
+	now := suite.Ctx.BlockTime()
+	unknownModule := []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeModuleAccount, "unknown", 1)}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "unknown", sdk.NewCoin(denom, math.NewInt(1000)), now, now.Add(time.Hour), unknownModule, types.EmissionCurve{})
+	suite.Require().Error(err)
+
+	// Create a schedule split between all the destination types
+	destination := suite.TestAccs[1]
+	destinations := []types.ReleaseDestination{
+		types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeModuleAccount, "oracle", 1),
+		types.NewReleaseDestination(types.DestinationTypeAddress, destination.String(), 2),
+	}
+	err = suite.App.RewardsKeeper.CreateSchedule(suite.Ctx, "split", sdk.NewCoin(denom, math.NewInt(1001)), now, now.Add(time.Hour), destinations, types.EmissionCurve{})
+	suite.Require().NoError(err)
+
+	// Get the initial balances
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress("fee_collector")
+	oracleAddr := suite.App.AccountKeeper.GetModuleAddress("oracle")
+	initialFeeCollectorBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollectorAddr, denom)
+	initialOracleBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, oracleAddr, denom)
+	initialDestinationBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, destination, denom)
+	initialCommunityPool, err := suite.App.DistrKeeper.FeePool.Get(suite.Ctx)
+	suite.Require().NoError(err)
+
+	// Release everything
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	// The remainder goes to the first destination
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom)
+	suite.Require().Equal(initialFeeCollectorBalance.AddAmount(math.NewInt(201)), feeCollectorBalance)
+	oracleBalance := suite.App.BankKeeper.GetBalance(ctx, oracleAddr, denom)
+	suite.Require().Equal(initialOracleBalance.AddAmount(math.NewInt(200)), oracleBalance)
+	destinationBalance := suite.App.BankKeeper.GetBalance(ctx, destination, denom)
+	suite.Require().Equal(initialDestinationBalance.AddAmount(math.NewInt(400)), destinationBalance)
+	communityPool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		initialCommunityPool.CommunityPool.AmountOf(denom).Add(math.LegacyNewDec(200)),
+		communityPool.CommunityPool.AmountOf(denom),
+	)
+
+	// An event is emitted for each destination
+	var releaseEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeReleaseRewards {
+			releaseEvents = append(releaseEvents, event)
+		}
+	}
+	suite.Require().Len(releaseEvents, len(destinations))
+}
@@ -36,27 +36,44 @@ func validateAmount(amount sdk.Coin) error {
 	return nil
 }
 
-// validateEndTime checks if time is in the past
-func validateEndTime(endTime time.Time) error {
-	if endTime.Before(time.Now()) {
+// validateEndTime checks if time is in the past of the block time
+func validateEndTime(endTime, blockTime time.Time) error {
+	if endTime.Before(blockTime) {
 		return fmt.Errorf("end time %s is not in the future", endTime)
 	}
 
 	return nil
 }
 
-// fundsAvailable checks if the asked funds are available in the pool
-func (k Keeper) fundsAvailable(ctx context.Context, amount sdk.Coin) error {
-	// Get reward pool
-	rewardPool, err := k.RewardPool.Get(ctx)
+// fundsAvailable checks if the asked funds are available in the pool and not committed to the schedules
+// The released funds are committed funds freed by the operation, such as the replaced main schedule
+func (k Keeper) fundsAvailable(ctx context.Context, amount sdk.Coin, released sdk.Coins) error {
+	// Get the free funds of the reward pool
+	freeFunds, err := k.GetFreeFunds(ctx)
 	if err != nil {
 		return err
 	}
+	freeFunds = freeFunds.Add(sdk.NewDecCoinsFromCoins(released...)...)
 
 	// Check if it is trying to use more funds than available
-	poolAmount := rewardPool.CommunityPool.AmountOf(amount.Denom)
-	if sdk.NewDecCoinFromCoin(amount).Amount.GT(poolAmount) {
-		return fmt.Errorf("reward pool (%s) has less funds than requested (%s)", poolAmount, amount)
+	freeAmount := freeFunds.AmountOf(amount.Denom)
+	if sdk.NewDecCoinFromCoin(amount).Amount.GT(freeAmount) {
+		return fmt.Errorf("reward pool (%s) has less free funds than requested (%s)", freeAmount, amount)
+	}
+
+	return nil
+}
+
+// validateAllowedDenoms checks the denoms committed to the schedules are still allowed
+func (k Keeper) validateAllowedDenoms(ctx context.Context, params types.Params) error {
+	committed, err := k.GetCommittedFunds(ctx)
+	if err != nil {
+		return err
+	}
+	for _, coin := range committed {
+		if !params.IsAllowedDenom(coin.Denom) {
+			return fmt.Errorf("denom %s is committed to active schedules and must be allowed", coin.Denom)
+		}
 	}
 
 	return nil
@@ -74,9 +91,9 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 	if err != nil {
 		return fmt.Errorf("failed to get module params: %w", err)
 	}
-	if params.TokenDenom != schedule.TotalAmount.Denom {
-		return fmt.Errorf("denom %s does not match expected denom: %s",
-			schedule.TotalAmount.Denom, params.TokenDenom)
+	if !params.IsAllowedDenom(schedule.TotalAmount.Denom) {
+		return fmt.Errorf("denom %s is not allowed, allowed denoms: %v",
+			schedule.TotalAmount.Denom, params.AllowedDenoms)
 	}
 
 	// Validate ReleasedAmount
@@ -94,12 +111,12 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 		}
 	}
 
-	// Time validations
-	currentTime := time.Now()
+	// Time validations, against the block time to be deterministic
+	currentTime := sdk.UnwrapSDKContext(ctx).BlockTime()
 	if schedule.EndTime.IsZero() {
 		return fmt.Errorf("end time cannot be zero")
 	}
-	if err = validateEndTime(schedule.EndTime); err != nil {
+	if err = validateEndTime(schedule.EndTime, currentTime); err != nil {
 		return err
 	}
 
@@ -114,6 +131,16 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 		}
 	}
 
+	// Validate the curve
+	if err := schedule.Curve.Validate(schedule.EndTime); err != nil {
+		return fmt.Errorf("invalid curve: %w", err)
+	}
+
+	// Validate the destinations
+	if err := k.validateDestinations(ctx, schedule.Destinations); err != nil {
+		return fmt.Errorf("invalid destinations: %w", err)
+	}
+
 	// 6. Active state consistency
 	if schedule.Active {
 		if schedule.TotalAmount.IsZero() {
@@ -125,3 +152,19 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 	}
 	return nil
 }
+
+// validateDestinations checks if the destinations are valid and the module accounts exist
+func (k Keeper) validateDestinations(ctx context.Context, destinations []types.ReleaseDestination) error {
+	if err := types.ValidateReleaseDestinations(destinations); err != nil {
+		return err
+	}
+
+	// Module accounts must be registered to receive coins
+	for _, destination := range destinations {
+		if destination.Type == types.DestinationTypeModuleAccount && k.accountKeeper.GetModuleAddress(destination.Target) == nil {
+			return fmt.Errorf("module account %s does not exist", destination.Target)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package post
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// RevenueKeeper defines the keeper recording the fees paid for the contracts for the developer revenue
+type RevenueKeeper interface {
+	RecordRevenueFees(ctx sdk.Context, contract sdk.AccAddress, fees math.Int) error
+}
+
+// RevenueDecorator records the fees paid by the successful txs executing wasm or EVM contracts
+// The fees are attributed to the registered contracts, to split the developer share of the next releases
+type RevenueDecorator struct {
+	revenueKeeper RevenueKeeper
+	feeDenom      string
+}
+
+// NewRevenueDecorator creates a new RevenueDecorator instance
+func NewRevenueDecorator(rk RevenueKeeper, feeDenom string) RevenueDecorator {
+	return RevenueDecorator{
+		revenueKeeper: rk,
+		feeDenom:      feeDenom,
+	}
+}
+
+// PostHandle records the fees paid by the tx for the contracts it executed
+func (rd RevenueDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// Only the delivered successful txs are recorded
+	if simulate || !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// Feeless txs are not recorded, the attribution must be paid for
+	msgs := getExecutedMsgs(tx.GetMsgs())
+	fees := getPaidFees(ctx, feeTx, msgs, rd.feeDenom)
+	if !fees.IsPositive() || len(msgs) == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// The fees are split evenly between the msgs and each contract gets the share of the msgs executing it
+	share := fees.QuoRaw(int64(len(msgs)))
+	recordCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	for _, msg := range msgs {
+		contract, found := getExecutedContract(msg)
+		if !found {
+			continue
+		}
+
+		// The record isn't charged to the tx
+		if err := rd.revenueKeeper.RecordRevenueFees(recordCtx, contract, share); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// getExecutedMsgs returns the msgs executed by the tx, with the authz executions replaced by their msgs
+func getExecutedMsgs(msgs []sdk.Msg) []sdk.Msg {
+	var executed []sdk.Msg
+	for _, msg := range msgs {
+		execMsg, ok := msg.(*authz.MsgExec)
+		if !ok {
+			executed = append(executed, msg)
+			continue
+		}
+
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			continue
+		}
+		executed = append(executed, getExecutedMsgs(innerMsgs)...)
+	}
+	return executed
+}
+
+// getPaidFees returns the fees paid by the tx in the fee denom
+// The EVM refunds the unused gas and resets the gas meter to the gas used, so only the used share of the fee is paid
+func getPaidFees(ctx sdk.Context, feeTx sdk.FeeTx, msgs []sdk.Msg, feeDenom string) math.Int {
+	fees := feeTx.GetFee().AmountOf(feeDenom)
+	gasLimit := feeTx.GetGas()
+	if !isEVMTx(msgs) || gasLimit == 0 {
+		return fees
+	}
+
+	gasUsed := min(ctx.GasMeter().GasConsumed(), gasLimit)
+	return fees.Mul(math.NewIntFromUint64(gasUsed)).Quo(math.NewIntFromUint64(gasLimit))
+}
+
+// isEVMTx returns if the msgs are EVM txs
+func isEVMTx(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getExecutedContract returns the contract executed by the msg
+// The contracts are the wasm executions and the EVM calls
+func getExecutedContract(msg sdk.Msg) (sdk.AccAddress, bool) {
+	switch m := msg.(type) {
+	case *wasmtypes.MsgExecuteContract:
+		contract, err := sdk.AccAddressFromBech32(m.Contract)
+		if err == nil {
+			return contract, true
+		}
+	case *evmtypes.MsgEthereumTx:
+		if to := m.AsTransaction().To(); to != nil {
+			return sdk.AccAddress(to.Bytes()), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,162 @@
+package post_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/post"
+)
+
+// mockRevenueKeeper records the calls to RecordRevenueFees
+type mockRevenueKeeper struct {
+	fees  map[string]math.Int
+	calls int
+}
+
+func (k *mockRevenueKeeper) RecordRevenueFees(_ sdk.Context, contract sdk.AccAddress, fees math.Int) error {
+	current, found := k.fees[contract.String()]
+	if !found {
+		current = math.ZeroInt()
+	}
+	k.fees[contract.String()] = current.Add(fees)
+	k.calls++
+	return nil
+}
+
+// mockTx is a tx with only msgs and fees
+type mockTx struct {
+	msgs     []sdk.Msg
+	fee      sdk.Coins
+	gasLimit uint64
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx mockTx) GetGas() uint64 { return tx.gasLimit }
+
+func (tx mockTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx mockTx) FeePayer() []byte { return nil }
+
+func (tx mockTx) FeeGranter() []byte { return nil }
+
+func TestRevenueDecorator(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	otherContract := sdk.AccAddress([]byte("other_contract______"))
+	sender := sdk.AccAddress([]byte("sender______________"))
+	executeMsg := &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: contract.String()}
+	otherExecuteMsg := &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: otherContract.String()}
+	sendMsg := &banktypes.MsgSend{FromAddress: sender.String(), ToAddress: contract.String()}
+	execMsg := authz.NewMsgExec(sender, []sdk.Msg{executeMsg, otherExecuteMsg})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", 1_000_000))
+
+	// EVM call with the same fee of 100_000 gas at 10akii
+	to := common.BytesToAddress(contract)
+	ethMsg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  big.NewInt(1),
+		To:       &to,
+		GasLimit: 100_000,
+		GasPrice: big.NewInt(10),
+	})
+
+	testCases := []struct {
+		name         string
+		tx           mockTx
+		simulate     bool
+		success      bool
+		expectedFees map[string]math.Int
+	}{
+		{
+			name:    "success - wasm execution gets the share of its msg",
+			tx:      mockTx{msgs: []sdk.Msg{executeMsg, sendMsg}, fee: fee, gasLimit: 100_000},
+			success: true,
+			expectedFees: map[string]math.Int{
+				contract.String(): math.NewInt(500_000),
+			},
+		},
+		{
+			name:    "success - authz executions are unwrapped",
+			tx:      mockTx{msgs: []sdk.Msg{&execMsg, sendMsg}, fee: fee, gasLimit: 100_000},
+			success: true,
+			expectedFees: map[string]math.Int{
+				contract.String():      math.NewInt(333_333),
+				otherContract.String(): math.NewInt(333_333),
+			},
+		},
+		{
+			name:    "success - EVM call pays only the gas used",
+			tx:      mockTx{msgs: []sdk.Msg{ethMsg}, fee: fee, gasLimit: 100_000},
+			success: true,
+			expectedFees: map[string]math.Int{
+				contract.String(): math.NewInt(500_000),
+			},
+		},
+		{
+			name:    "skip - feeless tx",
+			tx:      mockTx{msgs: []sdk.Msg{executeMsg}, gasLimit: 100_000},
+			success: true,
+		},
+		{
+			name:    "skip - fee in another denom",
+			tx:      mockTx{msgs: []sdk.Msg{executeMsg}, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000_000)), gasLimit: 100_000},
+			success: true,
+		},
+		{
+			name:    "skip - no contract executed",
+			tx:      mockTx{msgs: []sdk.Msg{sendMsg}, fee: fee, gasLimit: 100_000},
+			success: true,
+		},
+		{
+			name:    "skip - failed tx",
+			tx:      mockTx{msgs: []sdk.Msg{executeMsg}, fee: fee, gasLimit: 100_000},
+			success: false,
+		},
+		{
+			name:     "skip - simulation",
+			tx:       mockTx{msgs: []sdk.Msg{executeMsg}, fee: fee, gasLimit: 100_000},
+			simulate: true,
+			success:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeper := &mockRevenueKeeper{fees: make(map[string]math.Int)}
+			handler := sdk.ChainPostDecorators(post.NewRevenueDecorator(keeper, "akii"))
+
+			// Consume half of the gas limit on the tx
+			gasMeter := storetypes.NewGasMeter(100_000)
+			gasMeter.ConsumeGas(50_000, "tx")
+			ctx := sdk.Context{}.WithGasMeter(gasMeter)
+
+			_, err := handler(ctx, tc.tx, tc.simulate, tc.success)
+			require.NoError(t, err)
+
+			if tc.expectedFees == nil {
+				require.Zero(t, keeper.calls)
+				return
+			}
+			require.Equal(t, tc.expectedFees, keeper.fees)
+
+			// Recording isn't charged to the tx
+			require.Equal(t, uint64(50_000), gasMeter.GasConsumed())
+		})
+	}
+}
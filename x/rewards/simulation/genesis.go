@@ -0,0 +1,121 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// Simulation parameter constants
+const (
+	MaxReleasePerBlock = "max_release_per_block"
+	MaxReleaseHistory  = "max_release_history"
+	DeveloperShare     = "developer_share"
+	PoolAmount         = "pool_amount"
+	NumSchedules       = "num_schedules"
+
+	// maxScheduleDuration is the max duration of the random schedules
+	maxScheduleDuration = 30 * 24 * time.Hour
+)
+
+// RandMaxReleasePerBlock returns a random release cap of the denom, disabled half of the times
+func RandMaxReleasePerBlock(r *rand.Rand, denom string) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.Coins{}
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(denom, r.Int63n(1_000_000_000)+1))
+}
+
+// RandMaxReleaseHistory returns a random release history size
+func RandMaxReleaseHistory(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// RandDeveloperShare returns a random developer share up to 20%
+func RandDeveloperShare(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(r.Int63n(21), 2)
+}
+
+// RandScheduleEndTime returns a random end time after the start time
+func RandScheduleEndTime(r *rand.Rand, startTime time.Time) time.Time {
+	return startTime.Add(time.Duration(r.Int63n(int64(maxScheduleDuration))) + time.Hour)
+}
+
+// RandomizedGenState generates a random genesis state for the rewards module
+// The pool is funded with active schedules, the module account is funded on the bank genesis, so it must be generated after the bank
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{simState.BondDenom}
+	simState.AppParams.GetOrGenerate(MaxReleasePerBlock, &params.MaxReleasePerBlock, r,
+		func(r *rand.Rand) { params.MaxReleasePerBlock = RandMaxReleasePerBlock(r, simState.BondDenom) },
+	)
+	simState.AppParams.GetOrGenerate(MaxReleaseHistory, &params.MaxReleaseHistory, r,
+		func(r *rand.Rand) { params.MaxReleaseHistory = RandMaxReleaseHistory(r) },
+	)
+	simState.AppParams.GetOrGenerate(DeveloperShare, &params.DeveloperShare, r,
+		func(r *rand.Rand) { params.DeveloperShare = RandDeveloperShare(r) },
+	)
+
+	var poolAmount math.Int
+	simState.AppParams.GetOrGenerate(PoolAmount, &poolAmount, r,
+		func(r *rand.Rand) { poolAmount = simtypes.RandomAmount(r, simState.InitialStake.MulRaw(10)) },
+	)
+	var numSchedules int
+	simState.AppParams.GetOrGenerate(NumSchedules, &numSchedules, r,
+		func(r *rand.Rand) { numSchedules = r.Intn(4) },
+	)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params = params
+
+	// Commit part of the pool to the main schedule and the named schedules
+	if poolAmount.IsPositive() {
+		pool := sdk.NewCoin(simState.BondDenom, poolAmount)
+		genesis.RewardPool.CommunityPool = sdk.NewDecCoinsFromCoins(pool)
+
+		scheduleAmount := simtypes.RandomAmount(r, poolAmount).QuoRaw(int64(numSchedules + 1))
+		if scheduleAmount.IsPositive() {
+			genesis.ReleaseSchedule = types.ReleaseSchedule{
+				TotalAmount:    sdk.NewCoin(simState.BondDenom, scheduleAmount),
+				ReleasedAmount: sdk.NewCoin(simState.BondDenom, math.ZeroInt()),
+				EndTime:        RandScheduleEndTime(r, simState.GenTimestamp),
+				Active:         true,
+			}
+
+			for i := 0; i < numSchedules; i++ {
+				startTime := simState.GenTimestamp.Add(time.Duration(r.Int63n(int64(24 * time.Hour))))
+				genesis.Schedules = append(genesis.Schedules, types.NewSchedule(
+					fmt.Sprintf("sim-%d", i),
+					sdk.NewCoin(simState.BondDenom, scheduleAmount),
+					startTime,
+					RandScheduleEndTime(r, startTime),
+					nil,
+				))
+			}
+		}
+
+		// Back the pool with the module account balance
+		var bankGenesis banktypes.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+		bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+			Address: authtypes.NewModuleAddress(types.ModuleName).String(),
+			Coins:   sdk.NewCoins(pool),
+		})
+		bankGenesis.Supply = bankGenesis.Supply.Add(pool)
+		simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
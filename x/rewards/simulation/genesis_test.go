@@ -0,0 +1,67 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/simulation"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	r := rand.New(rand.NewSource(1))
+	genTime := time.Now().Add(time.Hour)
+
+	// Start from a bank genesis with a supply
+	supply := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Supply = supply
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		GenState:     map[string]json.RawMessage{banktypes.ModuleName: cdc.MustMarshalJSON(bankGenesis)},
+		InitialStake: math.NewInt(1_000_000),
+		BondDenom:    "akii",
+		GenTimestamp: genTime,
+	}
+	simulation.RandomizedGenState(&simState)
+
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, []string{"akii"}, genesis.Params.AllowedDenoms)
+
+	// The pool is backed by the module account on the bank genesis
+	pool, _ := genesis.RewardPool.CommunityPool.TruncateDecimal()
+	require.True(t, pool.IsAllPositive())
+	cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], bankGenesis)
+	require.Equal(t, supply.Add(pool...), bankGenesis.Supply)
+	require.Contains(t, bankGenesis.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Coins:   pool,
+	})
+
+	// The schedules are active and commit only the pool funds
+	require.True(t, genesis.ReleaseSchedule.Active)
+	committed := genesis.ReleaseSchedule.GetCommittedFunds()
+	for _, schedule := range genesis.Schedules {
+		committed = committed.Add(schedule.Release.GetCommittedFunds()...)
+	}
+	require.True(t, pool.IsAllGTE(committed))
+}
@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/kiichain/kiichain/v5/app/params"
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgFundPool = "op_weight_msg_rewards_fund_pool"
+
+	DefaultWeightMsgFundPool int = 50
+)
+
+// WeightedOperations returns the rewards module operations with their respective weights
+func WeightedOperations(
+	simState *module.SimulationState,
+	k keeper.Keeper,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgFundPool int
+	simState.AppParams.GetOrGenerate(OpWeightMsgFundPool, &weightMsgFundPool, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundPool = DefaultWeightMsgFundPool
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgFundPool,
+			SimulateMsgFundPool(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgFundPool funds the reward pool with a random amt of an allowed denom
+func SimulateMsgFundPool(k keeper.Keeper, ak simulation.AccountKeeper, bk simulation.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand,
+		app *baseapp.BaseApp,
+		ctx sdk.Context,
+		accs []simtypes.Account,
+		_ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgFundPool{})
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+
+		// Pick a random account and an allowed denom it holds
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := params.AllowedDenoms[r.Intn(len(params.AllowedDenoms))]
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		amount, err := simtypes.RandPositiveInt(r, spendable.AmountOf(denom))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sim account has no balance"), nil, nil
+		}
+		fund := sdk.NewCoin(denom, amount)
+
+		msg := types.NewMsgFundPool(simAccount.Address, fund)
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           appparams.MakeEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(fund),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgChangeSchedule = "op_weight_msg_rewards_change_schedule"
+
+	DefaultWeightMsgChangeSchedule int = 20
+)
+
+// ProposalMsgs returns the rewards governance msgs used on the simulated proposals
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgChangeSchedule,
+			DefaultWeightMsgChangeSchedule,
+			SimulateMsgChangeSchedule(k),
+		),
+	}
+}
+
+// SimulateMsgChangeSchedule replaces the main schedule by one releasing a random amt of the available funds
+// The available funds are the free funds and the funds committed to the replaced schedule
+func SimulateMsgChangeSchedule(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil
+		}
+		freeFunds, err := k.GetFreeFunds(ctx)
+		if err != nil {
+			return nil
+		}
+		currentSchedule, err := k.ReleaseSchedule.Get(ctx)
+		if err != nil {
+			return nil
+		}
+
+		// Pick a random amt of an allowed denom
+		denom := params.AllowedDenoms[r.Intn(len(params.AllowedDenoms))]
+		available := freeFunds.Add(sdk.NewDecCoinsFromCoins(currentSchedule.GetCommittedFunds()...)...)
+		amount, err := simtypes.RandPositiveInt(r, available.AmountOf(denom).TruncateInt())
+		if err != nil {
+			return nil
+		}
+
+		return types.NewMsgChangeSchedule(k.GetAuthority(), types.ReleaseSchedule{
+			TotalAmount:    sdk.NewCoin(denom, amount),
+			ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+			EndTime:        RandScheduleEndTime(r, ctx.BlockTime()),
+			Active:         true,
+		})
+	}
+}
@@ -15,6 +15,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgFundPool{},
 		&MsgChangeSchedule{},
+		&MsgCreateSchedule{},
+		&MsgPauseSchedule{},
+		&MsgCancelSchedule{},
+		&MsgWithdrawFromPool{},
+		&MsgRegisterRevenue{},
+		&MsgClaimRevenue{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,4 +34,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "rewards/update-params", nil)
 	cdc.RegisterConcrete(&MsgFundPool{}, "rewards/fund-pool", nil)
 	cdc.RegisterConcrete(&MsgChangeSchedule{}, "rewards/change-schedule", nil)
+	cdc.RegisterConcrete(&MsgCreateSchedule{}, "rewards/create-schedule", nil)
+	cdc.RegisterConcrete(&MsgPauseSchedule{}, "rewards/pause-schedule", nil)
+	cdc.RegisterConcrete(&MsgCancelSchedule{}, "rewards/cancel-schedule", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromPool{}, "rewards/withdraw-from-pool", nil)
+	cdc.RegisterConcrete(&MsgRegisterRevenue{}, "rewards/register-revenue", nil)
+	cdc.RegisterConcrete(&MsgClaimRevenue{}, "rewards/claim-revenue", nil)
 }
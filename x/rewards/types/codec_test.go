@@ -23,10 +23,16 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(3, len(impls))
+	suite.Require().Equal(9, len(impls))
 	suite.Require().ElementsMatch([]string{
+		"/kiichain.rewards.v1beta1.MsgCancelSchedule",
 		"/kiichain.rewards.v1beta1.MsgChangeSchedule",
+		"/kiichain.rewards.v1beta1.MsgCreateSchedule",
+		"/kiichain.rewards.v1beta1.MsgPauseSchedule",
 		"/kiichain.rewards.v1beta1.MsgFundPool",
 		"/kiichain.rewards.v1beta1.MsgUpdateParams",
+		"/kiichain.rewards.v1beta1.MsgWithdrawFromPool",
+		"/kiichain.rewards.v1beta1.MsgRegisterRevenue",
+		"/kiichain.rewards.v1beta1.MsgClaimRevenue",
 	}, impls)
 }
@@ -0,0 +1,151 @@
+package types
+
+import (
+	fmt "fmt"
+	time "time"
+
+	"cosmossdk.io/math"
+)
+
+// ln2 is the natural logarithm of two, used to calculate the exponential curve
+var ln2 = math.LegacyMustNewDecFromStr("0.693147180559945309")
+
+// Validate validates the curve of a release ending on the end time
+func (c EmissionCurve) Validate(endTime time.Time) error {
+	if _, ok := CurveType_name[int32(c.Type)]; !ok {
+		return fmt.Errorf("invalid curve type %d", c.Type)
+	}
+
+	// The linear curve releases the remaining amount, it has no parameters
+	if c.Type == CurveTypeLinear {
+		return nil
+	}
+
+	// Non-linear curves are calculated from the start time
+	if c.StartTime.IsZero() {
+		return fmt.Errorf("curve %s must have a start time", c.Type)
+	}
+	if !endTime.IsZero() && !c.StartTime.Before(endTime) {
+		return fmt.Errorf("curve start time %s must be before the end time %s", c.StartTime, endTime)
+	}
+
+	// Validate the curve parameters, the release is calculated in seconds
+	switch c.Type {
+	case CurveTypeExponential:
+		if c.HalfLife < time.Second {
+			return fmt.Errorf("half-life %s must be at least one second", c.HalfLife)
+		}
+	case CurveTypeStep:
+		if c.StepInterval < time.Second {
+			return fmt.Errorf("step interval %s must be at least one second", c.StepInterval)
+		}
+	case CurveTypeCliffLinear:
+		if c.CliffTime.Before(c.StartTime) || (!endTime.IsZero() && c.CliffTime.After(endTime)) {
+			return fmt.Errorf("cliff time %s must be between the start time %s and the end time %s", c.CliffTime, c.StartTime, endTime)
+		}
+	}
+
+	return nil
+}
+
+// Shift moves the start and the cliff of the curve by a duration
+func (c EmissionCurve) Shift(duration time.Duration) EmissionCurve {
+	if !c.StartTime.IsZero() {
+		c.StartTime = c.StartTime.Add(duration)
+	}
+	if !c.CliffTime.IsZero() {
+		c.CliffTime = c.CliffTime.Add(duration)
+	}
+	return c
+}
+
+// ReleasedFraction returns the fraction of the total amount the curve releases up to the block time
+// The fraction is between 0 and 1 and it only increases with the block time
+func (c EmissionCurve) ReleasedFraction(blockTime, endTime time.Time) math.LegacyDec {
+	// Everything is released after the end time
+	if !blockTime.Before(endTime) {
+		return math.LegacyOneDec()
+	}
+	if blockTime.Before(c.StartTime) {
+		return math.LegacyZeroDec()
+	}
+
+	// Get the time parameters, using truncated seconds
+	elapsed := int64(blockTime.Sub(c.StartTime).Seconds())
+	duration := int64(endTime.Sub(c.StartTime).Seconds())
+	if elapsed >= duration {
+		return math.LegacyOneDec()
+	}
+
+	var fraction math.LegacyDec
+	switch c.Type {
+	case CurveTypeExponential:
+		fraction = exponentialFraction(elapsed, duration, int64(c.HalfLife.Seconds()))
+	case CurveTypeStep:
+		fraction = stepFraction(elapsed, duration, int64(c.StepInterval.Seconds()))
+	case CurveTypeCliffLinear:
+		// Nothing is released before the cliff
+		if blockTime.Before(c.CliffTime) {
+			return math.LegacyZeroDec()
+		}
+		fraction = math.LegacyNewDec(elapsed).QuoInt64(duration)
+	default:
+		fraction = math.LegacyNewDec(elapsed).QuoInt64(duration)
+	}
+
+	// Cap the fraction against rounding
+	return math.LegacyMinDec(fraction, math.LegacyOneDec())
+}
+
+// exponentialFraction returns the released fraction of a curve halving its rate on every half-life
+// The curve is normalized to release everything on the end: (1 - 2^(-t/h)) / (1 - 2^(-T/h))
+func exponentialFraction(elapsed, duration, halfLife int64) math.LegacyDec {
+	numerator := math.LegacyOneDec().Sub(pow2Neg(math.LegacyNewDec(elapsed).QuoInt64(halfLife)))
+	denominator := math.LegacyOneDec().Sub(pow2Neg(math.LegacyNewDec(duration).QuoInt64(halfLife)))
+	if !denominator.IsPositive() {
+		return math.LegacyNewDec(elapsed).QuoInt64(duration)
+	}
+	return numerator.Quo(denominator)
+}
+
+// stepFraction returns the released fraction of a curve halving its rate on every interval
+// The period n releases 2^-n of the weight linearly, normalized by the weight of all the periods
+func stepFraction(elapsed, duration, interval int64) math.LegacyDec {
+	periods := (duration + interval - 1) / interval
+	period := elapsed / interval
+
+	// Get how much of the current period passed, the last period can be shorter
+	periodLength := min(interval, duration-period*interval)
+	periodProgress := math.LegacyNewDec(elapsed - period*interval).QuoInt64(periodLength)
+
+	// The weight of the periods before n is 2 * (1 - 2^-n)
+	two := math.LegacyNewDec(2)
+	periodWeight := pow2Neg(math.LegacyNewDec(period))
+	released := two.Mul(math.LegacyOneDec().Sub(periodWeight)).Add(periodWeight.Mul(periodProgress))
+	total := two.Mul(math.LegacyOneDec().Sub(pow2Neg(math.LegacyNewDec(periods))))
+	return released.Quo(total)
+}
+
+// pow2Neg calculates 2^-x for a non-negative x
+// The integer part is a power of two and the fractional part uses the series of e^(-r*ln2)
+func pow2Neg(x math.LegacyDec) math.LegacyDec {
+	// Values past 2^-63 are below the decimal precision
+	integer := x.TruncateInt64()
+	if integer >= 63 {
+		return math.LegacyZeroDec()
+	}
+	y := x.Sub(math.LegacyNewDec(integer)).Mul(ln2)
+
+	// Sum the series until the terms are below the precision
+	sum := math.LegacyOneDec()
+	term := math.LegacyOneDec()
+	for i := int64(1); i <= 40; i++ {
+		term = term.Mul(y).QuoInt64(i).Neg()
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+
+	return sum.Quo(math.LegacyNewDec(2).Power(uint64(integer)))
+}
@@ -0,0 +1,202 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestEmissionCurveValidate(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		curve     types.EmissionCurve
+		expectErr bool
+	}{
+		{
+			name:  "linear curve",
+			curve: types.EmissionCurve{},
+		},
+		{
+			name:  "exponential curve",
+			curve: types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: now, HalfLife: time.Minute},
+		},
+		{
+			name:  "step curve",
+			curve: types.EmissionCurve{Type: types.CurveTypeStep, StartTime: now, StepInterval: time.Minute},
+		},
+		{
+			name:  "cliff linear curve",
+			curve: types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: now, CliffTime: now.Add(time.Minute)},
+		},
+		{
+			name:      "invalid curve type",
+			curve:     types.EmissionCurve{Type: types.CurveType(10)},
+			expectErr: true,
+		},
+		{
+			name:      "missing start time",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, HalfLife: time.Minute},
+			expectErr: true,
+		},
+		{
+			name:      "start after end",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: endTime.Add(time.Minute), HalfLife: time.Minute},
+			expectErr: true,
+		},
+		{
+			name:      "half-life under a second",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: now, HalfLife: time.Millisecond},
+			expectErr: true,
+		},
+		{
+			name:      "zero step interval",
+			curve:     types.EmissionCurve{Type: types.CurveTypeStep, StartTime: now},
+			expectErr: true,
+		},
+		{
+			name:      "cliff after end",
+			curve:     types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: now, CliffTime: endTime.Add(time.Minute)},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.curve.Validate(endTime)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmissionCurveReleasedFraction(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0)
+	end := start.Add(4 * time.Hour)
+
+	testCases := []struct {
+		name      string
+		curve     types.EmissionCurve
+		blockTime time.Time
+		expected  math.LegacyDec
+	}{
+		{
+			name:      "before the start",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: start, HalfLife: time.Hour},
+			blockTime: start.Add(-time.Hour),
+			expected:  math.LegacyZeroDec(),
+		},
+		{
+			name:      "after the end",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: start, HalfLife: time.Hour},
+			blockTime: end.Add(time.Hour),
+			expected:  math.LegacyOneDec(),
+		},
+		{
+			// (1 - 2^-2) / (1 - 2^-4) = 0.75 / 0.9375
+			name:      "exponential after two half-lives",
+			curve:     types.EmissionCurve{Type: types.CurveTypeExponential, StartTime: start, HalfLife: time.Hour},
+			blockTime: start.Add(2 * time.Hour),
+			expected:  math.LegacyMustNewDecFromStr("0.8"),
+		},
+		{
+			// (1 + 0.5 * 0.5) / (2 * (1 - 2^-4)) = 1.25 / 1.875
+			name:      "step in the middle of the second period",
+			curve:     types.EmissionCurve{Type: types.CurveTypeStep, StartTime: start, StepInterval: time.Hour},
+			blockTime: start.Add(90 * time.Minute),
+			expected:  math.LegacyNewDec(2).QuoInt64(3),
+		},
+		{
+			name:      "cliff linear before the cliff",
+			curve:     types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: start, CliffTime: start.Add(time.Hour)},
+			blockTime: start.Add(time.Hour - time.Second),
+			expected:  math.LegacyZeroDec(),
+		},
+		{
+			name:      "cliff linear on the cliff",
+			curve:     types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: start, CliffTime: start.Add(time.Hour)},
+			blockTime: start.Add(time.Hour),
+			expected:  math.LegacyMustNewDecFromStr("0.25"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fraction := tc.curve.ReleasedFraction(tc.blockTime, end)
+			require.True(t, tc.expected.Sub(fraction).Abs().LTE(math.LegacyNewDecWithPrec(1, 15)), "expected %s, got %s", tc.expected, fraction)
+		})
+	}
+}
+
+// TestCurveReleasesNeverExceedTotal checks that the releases of any curve never exceed the total amount
+// and that everything is released after the end time
+func TestCurveReleasesNeverExceedTotal(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		start := time.Unix(rapid.Int64Range(1_600_000_000, 1_900_000_000).Draw(t, "start"), 0)
+		duration := time.Duration(rapid.Int64Range(1, 10*365*24*3600).Draw(t, "duration")) * time.Second
+		end := start.Add(duration)
+		total := math.NewIntFromUint64(rapid.Uint64Range(1, 1<<62).Draw(t, "total"))
+
+		// Draw the curve and its parameters
+		curve := types.EmissionCurve{
+			Type:      rapid.SampledFrom([]types.CurveType{types.CurveTypeLinear, types.CurveTypeExponential, types.CurveTypeStep, types.CurveTypeCliffLinear}).Draw(t, "type"),
+			StartTime: start,
+		}
+		switch curve.Type {
+		case types.CurveTypeExponential:
+			curve.HalfLife = time.Duration(rapid.Int64Range(1, int64(duration.Seconds())*2).Draw(t, "halfLife")) * time.Second
+		case types.CurveTypeStep:
+			curve.StepInterval = time.Duration(rapid.Int64Range(1, int64(duration.Seconds())).Draw(t, "stepInterval")) * time.Second
+		case types.CurveTypeCliffLinear:
+			curve.CliffTime = start.Add(time.Duration(rapid.Int64Range(0, int64(duration.Seconds())).Draw(t, "cliff")) * time.Second)
+		}
+		require.NoError(t, curve.Validate(end))
+
+		schedule := types.ReleaseSchedule{
+			TotalAmount:     sdk.NewCoin("akii", total),
+			ReleasedAmount:  sdk.NewCoin("akii", math.ZeroInt()),
+			EndTime:         end,
+			LastReleaseTime: start,
+			Active:          true,
+			Curve:           curve,
+		}
+
+		// Release on increasing block times, some of them past the end
+		steps := rapid.IntRange(1, 50).Draw(t, "steps")
+		blockTime := start
+		for i := 0; i < steps; i++ {
+			blockTime = blockTime.Add(time.Duration(rapid.Int64Range(1, int64(duration.Seconds())/4+1).Draw(t, "blockInterval")) * time.Second)
+
+			released, err := types.CalculateReward(blockTime, schedule)
+			require.NoError(t, err)
+			require.False(t, released.IsNegative())
+
+			schedule.ReleasedAmount = schedule.ReleasedAmount.Add(released)
+			schedule.LastReleaseTime = blockTime
+			require.True(t, schedule.ReleasedAmount.Amount.LTE(total), "released %s over the total %s", schedule.ReleasedAmount, total)
+		}
+
+		// Everything is released after the end time
+		afterEnd := end.Add(time.Second)
+		if blockTime.After(afterEnd) {
+			afterEnd = blockTime.Add(time.Second)
+		}
+		released, err := types.CalculateReward(afterEnd, schedule)
+		require.NoError(t, err)
+		schedule.ReleasedAmount = schedule.ReleasedAmount.Add(released)
+		require.Equal(t, total, schedule.ReleasedAmount.Amount)
+	})
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	fmt "fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReleaseDestination returns a new weighted destination
+func NewReleaseDestination(destinationType DestinationType, target string, weight uint64) ReleaseDestination {
+	return ReleaseDestination{
+		Type:   destinationType,
+		Target: target,
+		Weight: weight,
+	}
+}
+
+// DefaultReleaseDestinations returns the destinations used by schedules without destinations
+func DefaultReleaseDestinations() []ReleaseDestination {
+	return []ReleaseDestination{NewReleaseDestination(DestinationTypeFeeCollector, "", 1)}
+}
+
+// Validate validates a release destination
+func (d ReleaseDestination) Validate() error {
+	if d.Weight == 0 {
+		return fmt.Errorf("destination %s %s must have a positive weight", d.Type, d.Target)
+	}
+
+	// Validate the target for the destination type
+	switch d.Type {
+	case DestinationTypeFeeCollector, DestinationTypeCommunityPool:
+		if d.Target != "" {
+			return fmt.Errorf("destination %s can't have a target", d.Type)
+		}
+	case DestinationTypeModuleAccount:
+		if strings.TrimSpace(d.Target) == "" {
+			return fmt.Errorf("destination %s must have a module name", d.Type)
+		}
+		if d.Target == ModuleName {
+			return fmt.Errorf("rewards can't be released to the %s module", ModuleName)
+		}
+	case DestinationTypeAddress:
+		if _, err := sdk.AccAddressFromBech32(d.Target); err != nil {
+			return fmt.Errorf("invalid destination address %s: %w", d.Target, err)
+		}
+	default:
+		return fmt.Errorf("invalid destination type %d", d.Type)
+	}
+
+	return nil
+}
+
+// ValidateReleaseDestinations validates a list of release destinations, empty lists are valid
+// Destinations can't be repeated
+func ValidateReleaseDestinations(destinations []ReleaseDestination) error {
+	seen := make(map[string]struct{}, len(destinations))
+	for _, destination := range destinations {
+		if err := destination.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%d/%s", destination.Type, destination.Target)
+		if _, found := seen[key]; found {
+			return fmt.Errorf("duplicated destination %s %s", destination.Type, destination.Target)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetReleaseDestinations returns the destinations of the schedule, or the fee collector if it has none
+func (rr ReleaseSchedule) GetReleaseDestinations() []ReleaseDestination {
+	if len(rr.Destinations) == 0 {
+		return DefaultReleaseDestinations()
+	}
+	return rr.Destinations
+}
+
+// SplitRelease splits a released amount between the weighted destinations
+// Each destination gets the truncated share of its weight and the remainder goes to the first destination
+func SplitRelease(amount sdk.Coin, destinations []ReleaseDestination) []sdk.Coin {
+	weights := make([]math.Int, len(destinations))
+	for i, destination := range destinations {
+		weights[i] = math.NewIntFromUint64(destination.Weight)
+	}
+	return splitByWeights(amount, weights)
+}
+
+// splitByWeights splits an amount by the weights, the remainder goes to the first weight
+func splitByWeights(amount sdk.Coin, weights []math.Int) []sdk.Coin {
+	// Sum the weights
+	totalWeight := math.ZeroInt()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	// Calculate the share of each weight
+	shares := make([]sdk.Coin, len(weights))
+	distributed := math.ZeroInt()
+	for i, weight := range weights {
+		share := math.ZeroInt()
+		if totalWeight.IsPositive() {
+			share = amount.Amount.Mul(weight).Quo(totalWeight)
+		}
+		shares[i] = sdk.NewCoin(amount.Denom, share)
+		distributed = distributed.Add(share)
+	}
+
+	// Add the remainder to the first weight
+	if len(shares) > 0 {
+		shares[0] = shares[0].AddAmount(amount.Amount.Sub(distributed))
+	}
+
+	return shares
+}
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestValidateReleaseDestinations(t *testing.T) {
+	address := authtypes.NewModuleAddress("destination").String()
+
+	testCases := []struct {
+		name         string
+		destinations []types.ReleaseDestination
+		expectErr    bool
+	}{
+		{
+			name: "empty destinations",
+		},
+		{
+			name: "valid destinations",
+			destinations: []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+				types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 2),
+				types.NewReleaseDestination(types.DestinationTypeModuleAccount, "oracle", 3),
+				types.NewReleaseDestination(types.DestinationTypeAddress, address, 4),
+			},
+		},
+		{
+			name:         "zero weight",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 0)},
+			expectErr:    true,
+		},
+		{
+			name:         "fee collector with target",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeFeeCollector, "oracle", 1)},
+			expectErr:    true,
+		},
+		{
+			name:         "module account without name",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeModuleAccount, "", 1)},
+			expectErr:    true,
+		},
+		{
+			name:         "rewards module account",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeModuleAccount, types.ModuleName, 1)},
+			expectErr:    true,
+		},
+		{
+			name:         "invalid address",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationTypeAddress, "invalid", 1)},
+			expectErr:    true,
+		},
+		{
+			name:         "invalid type",
+			destinations: []types.ReleaseDestination{types.NewReleaseDestination(types.DestinationType(10), "", 1)},
+			expectErr:    true,
+		},
+		{
+			name: "duplicated destination",
+			destinations: []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeAddress, address, 1),
+				types.NewReleaseDestination(types.DestinationTypeAddress, address, 2),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateReleaseDestinations(tc.destinations)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitRelease(t *testing.T) {
+	destinations := []types.ReleaseDestination{
+		types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 1),
+		types.NewReleaseDestination(types.DestinationTypeModuleAccount, "oracle", 1),
+	}
+
+	testCases := []struct {
+		name         string
+		amount       sdk.Coin
+		destinations []types.ReleaseDestination
+		expected     []sdk.Coin
+	}{
+		{
+			name:         "single destination",
+			amount:       sdk.NewCoin("akii", math.NewInt(100)),
+			destinations: types.DefaultReleaseDestinations(),
+			expected:     []sdk.Coin{sdk.NewCoin("akii", math.NewInt(100))},
+		},
+		{
+			name:   "weighted destinations",
+			amount: sdk.NewCoin("akii", math.NewInt(100)),
+			destinations: []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 3),
+				types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 1),
+			},
+			expected: []sdk.Coin{sdk.NewCoin("akii", math.NewInt(75)), sdk.NewCoin("akii", math.NewInt(25))},
+		},
+		{
+			name:         "remainder goes to the first destination",
+			amount:       sdk.NewCoin("akii", math.NewInt(100)),
+			destinations: destinations,
+			expected:     []sdk.Coin{sdk.NewCoin("akii", math.NewInt(34)), sdk.NewCoin("akii", math.NewInt(33)), sdk.NewCoin("akii", math.NewInt(33))},
+		},
+		{
+			name:         "amount smaller than the destinations",
+			amount:       sdk.NewCoin("akii", math.NewInt(1)),
+			destinations: destinations,
+			expected:     []sdk.Coin{sdk.NewCoin("akii", math.NewInt(1)), sdk.NewCoin("akii", math.ZeroInt()), sdk.NewCoin("akii", math.ZeroInt())},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shares := types.SplitRelease(tc.amount, tc.destinations)
+			require.Len(t, shares, len(tc.expected))
+			for i, share := range shares {
+				require.True(t, tc.expected[i].IsEqual(share), "expected %s, got %s", tc.expected[i], share)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+// Rewards module event types
+const (
+	EventTypeReleaseRewards   = "release_rewards"
+	EventTypeWithdrawFromPool = "withdraw_from_pool"
+	EventTypeChainHalt        = "chain_halt"
+	EventTypeHaltAdjustment   = "halt_adjustment"
+	EventTypeReleaseCapped    = "release_capped"
+)
+
+// Rewards module attribute keys
+const (
+	AttributeKeySchedule        = "schedule"
+	AttributeKeyDestinationType = "destination_type"
+	AttributeKeyDestination     = "destination"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyHaltDuration    = "halt_duration"
+	AttributeKeyEndTime         = "end_time"
+	AttributeKeyReleaseCap      = "release_cap"
+)
@@ -0,0 +1,1644 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/rewards/v1beta1/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduleAction defines a change made to a release schedule
+type ScheduleAction int32
+
+const (
+	// SCHEDULE_ACTION_CHANGE replaces the main schedule
+	ScheduleActionChange ScheduleAction = 0
+	// SCHEDULE_ACTION_CREATE creates a named schedule
+	ScheduleActionCreate ScheduleAction = 1
+	// SCHEDULE_ACTION_PAUSE pauses a named schedule
+	ScheduleActionPause ScheduleAction = 2
+	// SCHEDULE_ACTION_RESUME resumes a named schedule
+	ScheduleActionResume ScheduleAction = 3
+	// SCHEDULE_ACTION_CANCEL cancels a named schedule
+	ScheduleActionCancel ScheduleAction = 4
+)
+
+var ScheduleAction_name = map[int32]string{
+	0: "SCHEDULE_ACTION_CHANGE",
+	1: "SCHEDULE_ACTION_CREATE",
+	2: "SCHEDULE_ACTION_PAUSE",
+	3: "SCHEDULE_ACTION_RESUME",
+	4: "SCHEDULE_ACTION_CANCEL",
+}
+
+var ScheduleAction_value = map[string]int32{
+	"SCHEDULE_ACTION_CHANGE": 0,
+	"SCHEDULE_ACTION_CREATE": 1,
+	"SCHEDULE_ACTION_PAUSE":  2,
+	"SCHEDULE_ACTION_RESUME": 3,
+	"SCHEDULE_ACTION_CANCEL": 4,
+}
+
+func (x ScheduleAction) String() string {
+	return proto.EnumName(ScheduleAction_name, int32(x))
+}
+
+func (ScheduleAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{0}
+}
+
+// EventRelease is emitted when rewards are released to a destination
+type EventRelease struct {
+	// schedule is the name of the released schedule, empty for the main schedule
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// destination_type is the type of the destination receiving the rewards
+	DestinationType DestinationType `protobuf:"varint,2,opt,name=destination_type,json=destinationType,proto3,enum=kiichain.rewards.v1beta1.DestinationType" json:"destination_type,omitempty"`
+	// destination is the destination target, the module name or the address
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// amount is the amount released to the destination
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventRelease) Reset()         { *m = EventRelease{} }
+func (m *EventRelease) String() string { return proto.CompactTextString(m) }
+func (*EventRelease) ProtoMessage()    {}
+func (*EventRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{0}
+}
+func (m *EventRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelease.Merge(m, src)
+}
+func (m *EventRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRelease proto.InternalMessageInfo
+
+func (m *EventRelease) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *EventRelease) GetDestinationType() DestinationType {
+	if m != nil {
+		return m.DestinationType
+	}
+	return DestinationTypeFeeCollector
+}
+
+func (m *EventRelease) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *EventRelease) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventFundPool is emitted when the reward pool is funded
+type EventFundPool struct {
+	// sender is the address funding the pool
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the amount added to the pool
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventFundPool) Reset()         { *m = EventFundPool{} }
+func (m *EventFundPool) String() string { return proto.CompactTextString(m) }
+func (*EventFundPool) ProtoMessage()    {}
+func (*EventFundPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{1}
+}
+func (m *EventFundPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFundPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundPool.Merge(m, src)
+}
+func (m *EventFundPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundPool proto.InternalMessageInfo
+
+func (m *EventFundPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventFundPool) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventScheduleChange is emitted when a release schedule is changed by
+// governance
+type EventScheduleChange struct {
+	// schedule is the name of the changed schedule, empty for the main schedule
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// action is the change made to the schedule
+	Action ScheduleAction `protobuf:"varint,2,opt,name=action,proto3,enum=kiichain.rewards.v1beta1.ScheduleAction" json:"action,omitempty"`
+	// release is the release of the schedule after the change
+	Release ReleaseSchedule `protobuf:"bytes,3,opt,name=release,proto3" json:"release"`
+}
+
+func (m *EventScheduleChange) Reset()         { *m = EventScheduleChange{} }
+func (m *EventScheduleChange) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleChange) ProtoMessage()    {}
+func (*EventScheduleChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{2}
+}
+func (m *EventScheduleChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleChange.Merge(m, src)
+}
+func (m *EventScheduleChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleChange proto.InternalMessageInfo
+
+func (m *EventScheduleChange) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *EventScheduleChange) GetAction() ScheduleAction {
+	if m != nil {
+		return m.Action
+	}
+	return ScheduleActionChange
+}
+
+func (m *EventScheduleChange) GetRelease() ReleaseSchedule {
+	if m != nil {
+		return m.Release
+	}
+	return ReleaseSchedule{}
+}
+
+// EventDeveloperRevenue is emitted when a share of a release is accrued to
+// the withdraw address of a contract
+type EventDeveloperRevenue struct {
+	// schedule is the name of the released schedule, empty for the main schedule
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// contract is the contract earning the revenue
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// withdraw_address is the address the revenue accrues to
+	WithdrawAddress string `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// fees_paid is the fees paid for the contract executions on the previous
+	// block
+	FeesPaid cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fees_paid,json=feesPaid,proto3,customtype=cosmossdk.io/math.Int" json:"fees_paid"`
+	// amount is the revenue accrued
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventDeveloperRevenue) Reset()         { *m = EventDeveloperRevenue{} }
+func (m *EventDeveloperRevenue) String() string { return proto.CompactTextString(m) }
+func (*EventDeveloperRevenue) ProtoMessage()    {}
+func (*EventDeveloperRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{3}
+}
+func (m *EventDeveloperRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeveloperRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeveloperRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeveloperRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeveloperRevenue.Merge(m, src)
+}
+func (m *EventDeveloperRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeveloperRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeveloperRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeveloperRevenue proto.InternalMessageInfo
+
+func (m *EventDeveloperRevenue) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *EventDeveloperRevenue) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventDeveloperRevenue) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func (m *EventDeveloperRevenue) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventClaimRevenue is emitted when a withdraw address claims its revenue
+type EventClaimRevenue struct {
+	// withdraw_address is the address claiming the revenue
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// amount is the revenue claimed
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventClaimRevenue) Reset()         { *m = EventClaimRevenue{} }
+func (m *EventClaimRevenue) String() string { return proto.CompactTextString(m) }
+func (*EventClaimRevenue) ProtoMessage()    {}
+func (*EventClaimRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{4}
+}
+func (m *EventClaimRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClaimRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClaimRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClaimRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClaimRevenue.Merge(m, src)
+}
+func (m *EventClaimRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClaimRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClaimRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClaimRevenue proto.InternalMessageInfo
+
+func (m *EventClaimRevenue) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func (m *EventClaimRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("kiichain.rewards.v1beta1.ScheduleAction", ScheduleAction_name, ScheduleAction_value)
+	proto.RegisterType((*EventRelease)(nil), "kiichain.rewards.v1beta1.EventRelease")
+	proto.RegisterType((*EventFundPool)(nil), "kiichain.rewards.v1beta1.EventFundPool")
+	proto.RegisterType((*EventScheduleChange)(nil), "kiichain.rewards.v1beta1.EventScheduleChange")
+	proto.RegisterType((*EventDeveloperRevenue)(nil), "kiichain.rewards.v1beta1.EventDeveloperRevenue")
+	proto.RegisterType((*EventClaimRevenue)(nil), "kiichain.rewards.v1beta1.EventClaimRevenue")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/rewards/v1beta1/events.proto", fileDescriptor_35ab8578051c3715)
+}
+
+var fileDescriptor_35ab8578051c3715 = []byte{
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x93, 0xbc, 0x3c, 0x18, 0xde, 0x03, 0xd7, 0x40, 0x6b, 0xb2, 0x30, 0x11, 0x6a, 0xa5,
+	0xf4, 0x03, 0x07, 0x28, 0x52, 0xb7, 0x75, 0x8c, 0x29, 0x91, 0x28, 0x8d, 0x9c, 0xb0, 0xe9, 0x26,
+	0x9a, 0xd8, 0xd3, 0x64, 0x44, 0x32, 0x13, 0x79, 0xc6, 0x50, 0xfa, 0x0b, 0x2a, 0x56, 0xfd, 0x03,
+	0x6c, 0xda, 0x5d, 0x77, 0x95, 0xd8, 0xb6, 0x6b, 0x96, 0x88, 0x55, 0xd5, 0x4a, 0xb4, 0x82, 0x3f,
+	0x52, 0xd9, 0x1e, 0xbb, 0x49, 0x65, 0x52, 0xb1, 0xb2, 0x67, 0xe6, 0x9c, 0x7b, 0xef, 0x39, 0xf7,
+	0xea, 0x82, 0x7b, 0x7b, 0x18, 0x3b, 0x5d, 0x88, 0x49, 0xc5, 0x43, 0x07, 0xd0, 0x73, 0x59, 0x65,
+	0x7f, 0xb5, 0x8d, 0x38, 0x5c, 0xad, 0xa0, 0x7d, 0x44, 0x38, 0xd3, 0x07, 0x1e, 0xe5, 0x54, 0x51,
+	0x63, 0x98, 0x2e, 0x60, 0xba, 0x80, 0x15, 0xe7, 0x3a, 0xb4, 0x43, 0x43, 0x50, 0x25, 0xf8, 0x8b,
+	0xf0, 0xc5, 0x05, 0x87, 0xb2, 0x3e, 0x65, 0xad, 0xe8, 0x21, 0x3a, 0x88, 0x27, 0x2d, 0x3a, 0x55,
+	0xda, 0x90, 0xa1, 0x24, 0x99, 0x43, 0x31, 0x11, 0xef, 0x77, 0xaf, 0xad, 0x88, 0x1f, 0x0e, 0x90,
+	0x88, 0xb2, 0xf4, 0x5d, 0x02, 0xff, 0x59, 0x41, 0x85, 0x36, 0xea, 0x21, 0xc8, 0x90, 0x52, 0x04,
+	0x13, 0xcc, 0xe9, 0x22, 0xd7, 0xef, 0x21, 0x55, 0x2a, 0x49, 0xe5, 0x49, 0x3b, 0x39, 0x2b, 0x4d,
+	0x20, 0xbb, 0x88, 0x71, 0x4c, 0x20, 0xc7, 0x94, 0xb4, 0x82, 0x38, 0x6a, 0xb6, 0x24, 0x95, 0xa7,
+	0xd7, 0xee, 0xeb, 0xd7, 0x09, 0xd3, 0x37, 0x7e, 0x33, 0x9a, 0x87, 0x03, 0x64, 0xcf, 0xb8, 0xa3,
+	0x17, 0x4a, 0x09, 0x4c, 0x0d, 0x5d, 0xa9, 0xb9, 0x30, 0xe9, 0xf0, 0x95, 0xf2, 0x04, 0x14, 0x60,
+	0x9f, 0xfa, 0x84, 0xab, 0xf9, 0x92, 0x54, 0x9e, 0x5a, 0x5b, 0xd0, 0x85, 0x13, 0x81, 0xf6, 0x24,
+	0x91, 0x49, 0x31, 0xa9, 0xe6, 0x4f, 0x2f, 0x16, 0x33, 0xb6, 0x80, 0x2f, 0xbd, 0x01, 0xff, 0x87,
+	0xe2, 0x36, 0x7d, 0xe2, 0xd6, 0x29, 0xed, 0x29, 0x2b, 0xa0, 0xc0, 0x10, 0x71, 0x91, 0x17, 0x69,
+	0xab, 0xaa, 0xe7, 0x27, 0xcb, 0x73, 0x22, 0x98, 0xe1, 0xba, 0x1e, 0x62, 0xac, 0xc1, 0x3d, 0x4c,
+	0x3a, 0xb6, 0xc0, 0x0d, 0xe5, 0xce, 0xde, 0x2c, 0xf7, 0x17, 0x09, 0xcc, 0x86, 0xc9, 0x1b, 0xc2,
+	0x3e, 0xb3, 0x0b, 0x49, 0x67, 0xbc, 0xc1, 0x4f, 0x41, 0x01, 0x3a, 0xa1, 0x0b, 0x91, 0xad, 0xe5,
+	0xeb, 0x6d, 0x8d, 0xa3, 0x1a, 0x21, 0xde, 0x16, 0x3c, 0xa5, 0x06, 0xfe, 0xf5, 0xa2, 0x4e, 0x86,
+	0x46, 0x4e, 0x8d, 0xeb, 0x8c, 0x68, 0x79, 0x1c, 0x49, 0xd4, 0x1f, 0xf3, 0x97, 0x3e, 0x65, 0xc1,
+	0x7c, 0x28, 0x60, 0x03, 0xed, 0xa3, 0x1e, 0x1d, 0x20, 0xcf, 0x0e, 0x66, 0xd9, 0x1f, 0x2f, 0x61,
+	0x1d, 0x4c, 0x38, 0x94, 0x70, 0x0f, 0x3a, 0x91, 0x63, 0xe3, 0x3c, 0x4e, 0x90, 0x8a, 0x09, 0xe4,
+	0x03, 0xcc, 0xbb, 0xae, 0x07, 0x0f, 0x5a, 0x30, 0xc2, 0xa8, 0xb9, 0xbf, 0xb0, 0x67, 0x62, 0x86,
+	0xb8, 0x56, 0xb6, 0xc0, 0xe4, 0x2b, 0x84, 0x58, 0x6b, 0x00, 0xb1, 0x1b, 0x4e, 0xca, 0x64, 0xf5,
+	0x61, 0x20, 0xe9, 0xdb, 0xc5, 0xe2, 0x7c, 0x14, 0x81, 0xb9, 0x7b, 0x3a, 0xa6, 0x95, 0x3e, 0xe4,
+	0x5d, 0xbd, 0x46, 0xf8, 0xf9, 0xc9, 0x32, 0x10, 0xa1, 0x6b, 0x84, 0xdb, 0x13, 0x01, 0xbb, 0x0e,
+	0xb1, 0x3b, 0xd4, 0xf4, 0x7f, 0x6e, 0xd6, 0xf4, 0xcf, 0x12, 0xb8, 0x15, 0x7a, 0x66, 0xf6, 0x20,
+	0xee, 0xc7, 0x7e, 0xa5, 0xa9, 0x93, 0x6e, 0xaa, 0xce, 0x19, 0x1a, 0xc4, 0xdc, 0xf8, 0x9a, 0x56,
+	0x82, 0x9a, 0x3e, 0xfe, 0x58, 0x2c, 0x77, 0x30, 0xef, 0xfa, 0x6d, 0xdd, 0xa1, 0x7d, 0xb1, 0x3b,
+	0xc4, 0x67, 0x99, 0xb9, 0x7b, 0x62, 0x0d, 0x04, 0x04, 0x16, 0xd7, 0xff, 0xe0, 0x7d, 0x16, 0x4c,
+	0x8f, 0x4e, 0x96, 0xb2, 0x0e, 0x6e, 0x37, 0xcc, 0x2d, 0x6b, 0x63, 0x77, 0xdb, 0x6a, 0x19, 0x66,
+	0xb3, 0xf6, 0x62, 0xa7, 0x65, 0x6e, 0x19, 0x3b, 0xcf, 0x2c, 0x39, 0x53, 0x54, 0x8f, 0x8e, 0x4b,
+	0x73, 0xa3, 0x78, 0x31, 0xe5, 0x69, 0x2c, 0xdb, 0x32, 0x9a, 0x96, 0x2c, 0xa5, 0xb2, 0x3c, 0x04,
+	0x39, 0x52, 0xd6, 0xc0, 0xfc, 0x9f, 0xac, 0xba, 0xb1, 0xdb, 0xb0, 0xe4, 0x6c, 0xf1, 0xce, 0xd1,
+	0x71, 0x69, 0x76, 0x94, 0x54, 0x87, 0x3e, 0x4b, 0xcd, 0x64, 0x5b, 0x8d, 0xdd, 0xe7, 0x96, 0x9c,
+	0x4b, 0xcb, 0x64, 0x23, 0xe6, 0xf7, 0xd3, 0xeb, 0x33, 0x76, 0x4c, 0x6b, 0x5b, 0xce, 0xa7, 0xd6,
+	0x07, 0x89, 0x83, 0x7a, 0xc5, 0xfc, 0xdb, 0x0f, 0x5a, 0xa6, 0xba, 0x79, 0x7a, 0xa9, 0x49, 0x67,
+	0x97, 0x9a, 0xf4, 0xf3, 0x52, 0x93, 0xde, 0x5d, 0x69, 0x99, 0xb3, 0x2b, 0x2d, 0xf3, 0xf5, 0x4a,
+	0xcb, 0xbc, 0x7c, 0x34, 0x64, 0x78, 0xb2, 0x7e, 0x93, 0x9f, 0xd7, 0xc9, 0x26, 0x0e, 0xad, 0x6f,
+	0x17, 0xc2, 0x15, 0xfc, 0xf8, 0xd7, 0x00, 0x51, 0xc9, 0x53, 0xed, 0x3c, 0x06, 0x00, 0x00,
+}
+
+func (m *EventRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestinationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduleChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Action != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeveloperRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeveloperRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeveloperRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeesPaid.Size()
+		i -= size
+		if _, err := m.FeesPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClaimRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClaimRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClaimRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DestinationType != 0 {
+		n += 1 + sovEvents(uint64(m.DestinationType))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventFundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventScheduleChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvents(uint64(m.Action))
+	}
+	l = m.Release.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventDeveloperRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FeesPaid.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventClaimRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			m.DestinationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationType |= DestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ScheduleAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeveloperRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeveloperRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeveloperRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,6 +3,8 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,4 +13,31 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AccountKeeper is used to check the module accounts receiving rewards
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// StakingKeeper is used to get the bonded tokens when estimating the staking APR
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+}
+
+// DistributionKeeper is used to send rewards to the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
+}
+
+// ContractAdminKeeper is used to check the admin of the contracts registered for the developer revenue
+type ContractAdminKeeper interface {
+	ValidateContractAdmin(ctx sdk.Context, sender, contract sdk.AccAddress, nonces []uint64) error
 }
@@ -1,22 +1,33 @@
 package types
 
+import "fmt"
+
 // NewGenesisState constructs a genesis state
 func NewGenesisState(
-	params Params, rp RewardPool, release ReleaseSchedule,
+	params Params, rp RewardPool, release ReleaseSchedule, schedules []Schedule, releaseHistory []ReleaseRecord,
+	revenueContracts []RevenueContract, claimableRevenues []ClaimableRevenue,
 ) *GenesisState {
 	return &GenesisState{
-		Params:          params,
-		RewardPool:      rp,
-		ReleaseSchedule: release,
+		Params:            params,
+		RewardPool:        rp,
+		ReleaseSchedule:   release,
+		Schedules:         schedules,
+		ReleaseHistory:    releaseHistory,
+		RevenueContracts:  revenueContracts,
+		ClaimableRevenues: claimableRevenues,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state of rewards.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		RewardPool:      InitialRewardPool(),
-		Params:          DefaultParams(),
-		ReleaseSchedule: InitialReleaseSchedule(),
+		RewardPool:        InitialRewardPool(),
+		Params:            DefaultParams(),
+		ReleaseSchedule:   InitialReleaseSchedule(),
+		Schedules:         []Schedule{},
+		ReleaseHistory:    []ReleaseRecord{},
+		RevenueContracts:  []RevenueContract{},
+		ClaimableRevenues: []ClaimableRevenue{},
 	}
 }
 
@@ -29,5 +40,70 @@ func (gs *GenesisState) Validate() error {
 	if err := gs.RewardPool.ValidateGenesis(); err != nil {
 		return err
 	}
-	return gs.ReleaseSchedule.ValidateGenesis()
+	if err := gs.ReleaseSchedule.ValidateGenesis(); err != nil {
+		return err
+	}
+	committed := gs.ReleaseSchedule.GetCommittedFunds()
+
+	// Validate the named schedules, names must be unique
+	names := make(map[string]struct{}, len(gs.Schedules))
+	for _, schedule := range gs.Schedules {
+		if _, found := names[schedule.Name]; found {
+			return fmt.Errorf("duplicated schedule %s", schedule.Name)
+		}
+		names[schedule.Name] = struct{}{}
+
+		if err := schedule.ValidateGenesis(); err != nil {
+			return err
+		}
+		committed = committed.Add(schedule.Release.GetCommittedFunds()...)
+	}
+
+	// The active schedules must release allowed denoms
+	for _, coin := range committed {
+		if !gs.Params.IsAllowedDenom(coin.Denom) {
+			return fmt.Errorf("schedules release denom %s which is not allowed", coin.Denom)
+		}
+	}
+
+	// Validate the release history, ids must be unique
+	ids := make(map[uint64]struct{}, len(gs.ReleaseHistory))
+	for _, record := range gs.ReleaseHistory {
+		if _, found := ids[record.Id]; found {
+			return fmt.Errorf("duplicated release record %d", record.Id)
+		}
+		ids[record.Id] = struct{}{}
+
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the revenue contracts, contracts must be unique
+	contracts := make(map[string]struct{}, len(gs.RevenueContracts))
+	for _, contract := range gs.RevenueContracts {
+		if _, found := contracts[contract.Contract]; found {
+			return fmt.Errorf("duplicated revenue contract %s", contract.Contract)
+		}
+		contracts[contract.Contract] = struct{}{}
+
+		if err := contract.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate the claimable revenues, addresses must be unique
+	addresses := make(map[string]struct{}, len(gs.ClaimableRevenues))
+	for _, revenue := range gs.ClaimableRevenues {
+		if _, found := addresses[revenue.Address]; found {
+			return fmt.Errorf("duplicated claimable revenue %s", revenue.Address)
+		}
+		addresses[revenue.Address] = struct{}{}
+
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -32,6 +32,14 @@ type GenesisState struct {
 	ReleaseSchedule ReleaseSchedule `protobuf:"bytes,2,opt,name=release_schedule,json=releaseSchedule,proto3" json:"release_schedule"`
 	// reward_pool has information on the community pool
 	RewardPool RewardPool `protobuf:"bytes,3,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool"`
+	// schedules are the named release schedules
+	Schedules []Schedule `protobuf:"bytes,4,rep,name=schedules,proto3" json:"schedules"`
+	// release_history are the records of the release history
+	ReleaseHistory []ReleaseRecord `protobuf:"bytes,5,rep,name=release_history,json=releaseHistory,proto3" json:"release_history"`
+	// revenue_contracts are the contracts registered for the developer revenue
+	RevenueContracts []RevenueContract `protobuf:"bytes,6,rep,name=revenue_contracts,json=revenueContracts,proto3" json:"revenue_contracts"`
+	// claimable_revenues are the developer revenues not claimed yet
+	ClaimableRevenues []ClaimableRevenue `protobuf:"bytes,7,rep,name=claimable_revenues,json=claimableRevenues,proto3" json:"claimable_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +96,34 @@ func (m *GenesisState) GetRewardPool() RewardPool {
 	return RewardPool{}
 }
 
+func (m *GenesisState) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReleaseHistory() []ReleaseRecord {
+	if m != nil {
+		return m.ReleaseHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevenueContracts() []RevenueContract {
+	if m != nil {
+		return m.RevenueContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimableRevenues() []ClaimableRevenue {
+	if m != nil {
+		return m.ClaimableRevenues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.rewards.v1beta1.GenesisState")
 }
@@ -97,26 +133,34 @@ func init() {
 }
 
 var fileDescriptor_96ab53dc25b7c542 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x63, 0x40, 0x1d, 0x5c, 0x24, 0x50, 0xc4, 0x10, 0x75, 0x30, 0x15, 0x2a, 0x08, 0x24,
-	0x64, 0xab, 0xb0, 0x33, 0x30, 0xc0, 0xc0, 0x52, 0xb5, 0x5b, 0x97, 0xca, 0x49, 0x4f, 0xa9, 0x45,
-	0x9a, 0x8b, 0x6c, 0x97, 0x3f, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x10, 0xac,
-	0xa8, 0x8e, 0x09, 0x62, 0x08, 0x9b, 0x73, 0xf9, 0x7d, 0xbf, 0x3b, 0x7d, 0xf4, 0xec, 0x51, 0xa9,
-	0x64, 0x21, 0x55, 0x2e, 0x34, 0x3c, 0x4b, 0x3d, 0x37, 0xe2, 0x69, 0x18, 0x83, 0x95, 0x43, 0x91,
-	0x42, 0x0e, 0x46, 0x19, 0x5e, 0x68, 0xb4, 0x18, 0x46, 0x3f, 0x1c, 0xf7, 0x1c, 0xf7, 0x5c, 0xef,
-	0x28, 0xc5, 0x14, 0x1d, 0x24, 0xb6, 0xaf, 0x9a, 0xef, 0xb1, 0x04, 0xcd, 0x12, 0x8d, 0x88, 0xa5,
-	0x81, 0x46, 0x99, 0xa0, 0xca, 0xfd, 0xff, 0xd3, 0xd6, 0xbd, 0x85, 0xd4, 0x72, 0xe9, 0xd7, 0xf6,
-	0x06, 0xad, 0x98, 0x7d, 0x2d, 0xc0, 0x53, 0x27, 0x5f, 0x84, 0xee, 0xdf, 0xd7, 0xe7, 0x4e, 0xac,
-	0xb4, 0x10, 0xde, 0xd0, 0x4e, 0xad, 0x89, 0x48, 0x9f, 0x9c, 0x77, 0xaf, 0xfa, 0xbc, 0xed, 0x7c,
-	0x3e, 0x72, 0xdc, 0xed, 0xde, 0xfa, 0xe3, 0x38, 0x18, 0xfb, 0x54, 0x38, 0xa5, 0x87, 0x1a, 0x32,
-	0x90, 0x06, 0x66, 0x26, 0x59, 0xc0, 0x7c, 0x95, 0x41, 0xb4, 0xe3, 0x4c, 0x17, 0xed, 0xa6, 0x71,
-	0x9d, 0x98, 0xf8, 0x80, 0x57, 0x1e, 0xe8, 0xbf, 0xe3, 0xf0, 0x81, 0x76, 0xeb, 0xe4, 0xac, 0x40,
-	0xcc, 0xa2, 0x5d, 0xa7, 0x1d, 0xfc, 0xa7, 0xdd, 0x7e, 0x8f, 0x10, 0x33, 0x6f, 0xa4, 0xfa, 0x77,
-	0x72, 0xb7, 0x2e, 0x19, 0xd9, 0x94, 0x8c, 0x7c, 0x96, 0x8c, 0xbc, 0x55, 0x2c, 0xd8, 0x54, 0x2c,
-	0x78, 0xaf, 0x58, 0x30, 0xbd, 0x4c, 0x95, 0x5d, 0xac, 0x62, 0x9e, 0xe0, 0x52, 0x34, 0x25, 0x36,
-	0x8f, 0x97, 0xa6, 0x4f, 0xd7, 0x63, 0xdc, 0x71, 0x45, 0x5e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x22, 0xbd, 0xae, 0x98, 0x0f, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0x77, 0x5d, 0x71, 0x56, 0xb4, 0x1d, 0x3c, 0x0c, 0x7b, 0x88, 0xa1, 0x54, 0xad,
+	0x22, 0x09, 0xad, 0x77, 0x0f, 0x2d, 0x54, 0xc1, 0x4b, 0xd9, 0x82, 0x87, 0x22, 0x84, 0xc9, 0xec,
+	0x23, 0x19, 0x4c, 0x32, 0x61, 0x66, 0xb6, 0xda, 0x6f, 0xe1, 0xc7, 0xda, 0xe3, 0x9e, 0xc4, 0x93,
+	0xc8, 0xee, 0x17, 0x91, 0x9d, 0xbc, 0x44, 0x76, 0x21, 0xdd, 0xdb, 0xe4, 0xcd, 0xff, 0xff, 0x7b,
+	0xef, 0x65, 0xfe, 0xe4, 0xd5, 0x37, 0x29, 0x45, 0xce, 0x65, 0x15, 0x6b, 0xf8, 0xce, 0xf5, 0xcc,
+	0xc4, 0xb7, 0xa7, 0x29, 0x58, 0x7e, 0x1a, 0x67, 0x50, 0x81, 0x91, 0x26, 0xaa, 0xb5, 0xb2, 0x8a,
+	0xb2, 0x56, 0x17, 0xa1, 0x2e, 0x42, 0xdd, 0xe4, 0x79, 0xa6, 0x32, 0xe5, 0x44, 0xf1, 0xe6, 0xd4,
+	0xe8, 0x27, 0x81, 0x50, 0xa6, 0x54, 0x26, 0x4e, 0xb9, 0x81, 0x0e, 0x29, 0x94, 0xac, 0xf0, 0xfe,
+	0x65, 0x6f, 0xdf, 0x9a, 0x6b, 0x5e, 0x62, 0xdb, 0xc9, 0x71, 0xaf, 0xcc, 0xde, 0xd5, 0xd0, 0xaa,
+	0xfa, 0x97, 0xd0, 0x70, 0x0b, 0xd5, 0x1c, 0x1a, 0xdd, 0xd1, 0xaf, 0x21, 0x79, 0xf2, 0xb1, 0x59,
+	0xeb, 0xda, 0x72, 0x0b, 0xf4, 0x03, 0x19, 0x35, 0xed, 0x98, 0x1f, 0xfa, 0x27, 0xe3, 0xb3, 0x30,
+	0xea, 0x5b, 0x33, 0xba, 0x72, 0xba, 0xf3, 0xe1, 0xe2, 0xcf, 0x0b, 0x6f, 0x8a, 0x2e, 0x7a, 0x43,
+	0x0e, 0x34, 0x14, 0xc0, 0x0d, 0x24, 0x46, 0xe4, 0x30, 0x9b, 0x17, 0xc0, 0x1e, 0x38, 0xd2, 0x9b,
+	0x7e, 0xd2, 0xb4, 0x71, 0x5c, 0xa3, 0x01, 0x91, 0xcf, 0xf4, 0x76, 0x99, 0x7e, 0x26, 0xe3, 0xc6,
+	0x99, 0xd4, 0x4a, 0x15, 0x6c, 0xe0, 0xb0, 0xc7, 0xf7, 0x61, 0x37, 0xdf, 0x57, 0x4a, 0x15, 0x48,
+	0x24, 0xba, 0xab, 0xd0, 0x4b, 0xf2, 0xb8, 0x1d, 0xd0, 0xb0, 0x61, 0x38, 0x38, 0x19, 0x9f, 0x1d,
+	0xf5, 0xa3, 0x76, 0x46, 0xfb, 0x6f, 0xa5, 0x5f, 0x48, 0x3b, 0x67, 0x92, 0x4b, 0x63, 0x95, 0xbe,
+	0x63, 0x0f, 0x1d, 0xed, 0xf5, 0xde, 0x7d, 0xa7, 0x20, 0x94, 0x9e, 0x21, 0xf2, 0x29, 0x52, 0x3e,
+	0x35, 0x10, 0xfa, 0x95, 0x1c, 0xe2, 0x53, 0x25, 0x42, 0x55, 0x56, 0x73, 0x61, 0x0d, 0x1b, 0x85,
+	0x83, 0x7d, 0x7f, 0xd2, 0x59, 0x2e, 0xd0, 0x81, 0xec, 0x03, 0xbd, 0x5d, 0x36, 0x34, 0x21, 0x54,
+	0x14, 0x5c, 0x96, 0x3c, 0x2d, 0x20, 0xc1, 0x5b, 0xc3, 0x1e, 0x39, 0xfc, 0xdb, 0x7e, 0xfc, 0x45,
+	0xeb, 0xc1, 0x3e, 0xc8, 0x3f, 0x14, 0x3b, 0x75, 0x73, 0x7e, 0xb9, 0x58, 0x05, 0xfe, 0x72, 0x15,
+	0xf8, 0x7f, 0x57, 0x81, 0xff, 0x73, 0x1d, 0x78, 0xcb, 0x75, 0xe0, 0xfd, 0x5e, 0x07, 0xde, 0xcd,
+	0xbb, 0x4c, 0xda, 0x7c, 0x9e, 0x46, 0x42, 0x95, 0x71, 0x97, 0xd2, 0xee, 0xf0, 0xa3, 0x0b, 0xac,
+	0x8b, 0x73, 0x3a, 0x72, 0x39, 0x7d, 0xff, 0x6f, 0x00, 0x1d, 0xff, 0x74, 0x73, 0x96, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +183,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimableRevenues) > 0 {
+		for iNdEx := len(m.ClaimableRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RevenueContracts) > 0 {
+		for iNdEx := len(m.RevenueContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReleaseHistory) > 0 {
+		for iNdEx := len(m.ReleaseHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -195,6 +295,30 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.RewardPool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReleaseHistory) > 0 {
+		for _, e := range m.ReleaseHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevenueContracts) > 0 {
+		for _, e := range m.RevenueContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimableRevenues) > 0 {
+		for _, e := range m.ClaimableRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +456,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseHistory = append(m.ReleaseHistory, ReleaseRecord{})
+			if err := m.ReleaseHistory[len(m.ReleaseHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueContracts = append(m.RevenueContracts, RevenueContract{})
+			if err := m.RevenueContracts[len(m.RevenueContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableRevenues = append(m.ClaimableRevenues, ClaimableRevenue{})
+			if err := m.ClaimableRevenues[len(m.ClaimableRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
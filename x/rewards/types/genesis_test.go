@@ -28,7 +28,7 @@ func (suite *GenesisTestSuite) TestNewGenesisState() {
 	schedule := types.InitialReleaseSchedule()
 
 	// Test creation
-	genesis := types.NewGenesisState(params, pool, schedule)
+	genesis := types.NewGenesisState(params, pool, schedule, []types.Schedule{}, []types.ReleaseRecord{}, []types.RevenueContract{}, []types.ClaimableRevenue{})
 
 	suite.Require().Equal(params, genesis.Params)
 	suite.Require().Equal(pool, genesis.RewardPool)
@@ -53,6 +53,8 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		LastReleaseTime: time.Time{},
 		Active:          true,
 	}
+	contract := sdk.AccAddress([]byte("contract____________"))
+	admin := sdk.AccAddress([]byte("admin_______________"))
 
 	testCases := []struct {
 		name         string
@@ -76,14 +78,112 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			name: "invalid params",
 			modifyFn: func(gs *types.GenesisState) {
-				gs.Params.TokenDenom = "" // invalid empty denom
+				gs.Params.AllowedDenoms = nil // invalid empty denoms
 			},
 			expectedPass: false,
 		},
 		{
-			name: "invalid release schedule - past end time",
+			name: "invalid release schedule - last release after end time",
 			modifyFn: func(gs *types.GenesisState) {
-				gs.ReleaseSchedule.EndTime = time.Now().Add(-time.Hour)
+				gs.ReleaseSchedule = validSchedule
+				gs.ReleaseSchedule.LastReleaseTime = validSchedule.EndTime.Add(time.Hour)
+			},
+			expectedPass: false,
+		},
+		{
+			name: "valid named schedules",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.Schedules = []types.Schedule{
+					types.NewSchedule("ecosystem", validSchedule.TotalAmount, time.Now(), validSchedule.EndTime, nil),
+					types.NewSchedule("grants", validSchedule.TotalAmount, time.Now(), validSchedule.EndTime, nil),
+				}
+			},
+			expectedPass: true,
+		},
+		{
+			name: "duplicated named schedules",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.Schedules = []types.Schedule{
+					types.NewSchedule("ecosystem", validSchedule.TotalAmount, time.Now(), validSchedule.EndTime, nil),
+					types.NewSchedule("ecosystem", validSchedule.TotalAmount, time.Now(), validSchedule.EndTime, nil),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "valid schedules on multiple denoms",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.Params.AllowedDenoms = []string{"akii", "uatom"}
+				gs.Schedules = []types.Schedule{
+					types.NewSchedule("ecosystem", validSchedule.TotalAmount, time.Now(), validSchedule.EndTime, nil),
+					types.NewSchedule("partner", sdk.NewCoin("uatom", math.NewInt(1000)), time.Now(), validSchedule.EndTime, nil),
+				}
+			},
+			expectedPass: true,
+		},
+		{
+			name: "invalid schedule - denom not allowed",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.Schedules = []types.Schedule{
+					types.NewSchedule("partner", sdk.NewCoin("uatom", math.NewInt(1000)), time.Now(), validSchedule.EndTime, nil),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "valid release history",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(0, 10, time.Now(), "", types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1), sdk.NewCoin("akii", math.NewInt(10))),
+					types.NewReleaseRecord(1, 11, time.Now(), "ecosystem", types.NewReleaseDestination(types.DestinationTypeModuleAccount, "oracle", 1), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+			},
+			expectedPass: true,
+		},
+		{
+			name: "duplicated release records",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(0, 10, time.Now(), "", types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1), sdk.NewCoin("akii", math.NewInt(10))),
+					types.NewReleaseRecord(0, 11, time.Now(), "", types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "invalid release record destination",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(0, 10, time.Now(), "", types.NewReleaseDestination(types.DestinationTypeAddress, "invalid", 1), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "valid developer revenue",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.RevenueContracts = []types.RevenueContract{types.NewRevenueContract(contract, admin, admin)}
+				gs.ClaimableRevenues = []types.ClaimableRevenue{types.NewClaimableRevenue(admin, sdk.NewCoins(sdk.NewInt64Coin("akii", 10)))}
+			},
+			expectedPass: true,
+		},
+		{
+			name: "duplicated revenue contracts",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.RevenueContracts = []types.RevenueContract{
+					types.NewRevenueContract(contract, admin, admin),
+					types.NewRevenueContract(contract, admin, contract),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "duplicated claimable revenues",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ClaimableRevenues = []types.ClaimableRevenue{
+					types.NewClaimableRevenue(admin, sdk.NewCoins(sdk.NewInt64Coin("akii", 10))),
+					types.NewClaimableRevenue(admin, sdk.NewCoins(sdk.NewInt64Coin("akii", 20))),
+				}
 			},
 			expectedPass: false,
 		},
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReleaseRecord returns a new release history record
+func NewReleaseRecord(id uint64, height int64, blockTime time.Time, schedule string, destination ReleaseDestination, amount sdk.Coin) ReleaseRecord {
+	return ReleaseRecord{
+		Id:              id,
+		Height:          height,
+		Time:            blockTime,
+		Schedule:        schedule,
+		DestinationType: destination.Type,
+		Destination:     destination.Target,
+		Amount:          amount,
+	}
+}
+
+// Validate validates a release history record
+func (r ReleaseRecord) Validate() error {
+	if r.Height < 0 {
+		return fmt.Errorf("invalid height for release record %d: %d", r.Id, r.Height)
+	}
+
+	// The main schedule has no name
+	if r.Schedule != "" {
+		if err := ValidateScheduleName(r.Schedule); err != nil {
+			return fmt.Errorf("invalid schedule for release record %d: %w", r.Id, err)
+		}
+	}
+
+	// Validate the destination, the weight isn't recorded
+	destination := NewReleaseDestination(r.DestinationType, r.Destination, 1)
+	if err := destination.Validate(); err != nil {
+		return fmt.Errorf("invalid destination for release record %d: %w", r.Id, err)
+	}
+
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount for release record %d: %w", r.Id, err)
+	}
+
+	return nil
+}
+
+// GetReleaseHistoryCutoff returns the first record id kept with the max history, records before it are pruned
+func GetReleaseHistoryCutoff(nextID, maxHistory uint64) uint64 {
+	if nextID < maxHistory {
+		return 0
+	}
+	return nextID - maxHistory
+}
@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestGetReleaseHistoryCutoff(t *testing.T) {
+	// Nothing is pruned under the max history
+	require.Equal(t, uint64(0), types.GetReleaseHistoryCutoff(5, 10))
+	require.Equal(t, uint64(0), types.GetReleaseHistoryCutoff(10, 10))
+
+	// The oldest records over the max history are pruned
+	require.Equal(t, uint64(5), types.GetReleaseHistoryCutoff(15, 10))
+
+	// A disabled history is all pruned
+	require.Equal(t, uint64(15), types.GetReleaseHistoryCutoff(15, 0))
+}
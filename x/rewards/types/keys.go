@@ -3,9 +3,16 @@ package types
 import "cosmossdk.io/collections"
 
 var (
-	ParamsKey          = collections.NewPrefix(0)
-	RewardPoolKey      = collections.NewPrefix(1)
-	ReleaseScheduleKey = collections.NewPrefix(2)
+	ParamsKey            = collections.NewPrefix(0)
+	RewardPoolKey        = collections.NewPrefix(1)
+	ReleaseScheduleKey   = collections.NewPrefix(2)
+	SchedulesKey         = collections.NewPrefix(3)
+	LastBlockTimeKey     = collections.NewPrefix(4)
+	ReleaseHistoryKey    = collections.NewPrefix(5)
+	ReleaseRecordIDKey   = collections.NewPrefix(6)
+	RevenueContractsKey  = collections.NewPrefix(7)
+	RevenueFeesKey       = collections.NewPrefix(8)
+	ClaimableRevenuesKey = collections.NewPrefix(9)
 )
 
 const (
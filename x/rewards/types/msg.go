@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,12 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgFundPool)(nil)
 	_ sdk.Msg = (*MsgChangeSchedule)(nil)
+	_ sdk.Msg = (*MsgCreateSchedule)(nil)
+	_ sdk.Msg = (*MsgPauseSchedule)(nil)
+	_ sdk.Msg = (*MsgCancelSchedule)(nil)
+	_ sdk.Msg = (*MsgWithdrawFromPool)(nil)
+	_ sdk.Msg = (*MsgRegisterRevenue)(nil)
+	_ sdk.Msg = (*MsgClaimRevenue)(nil)
 )
 
 // NewMsgUpdateParams returns a new MsgUpdateParams with the authority
@@ -37,3 +45,70 @@ func NewMsgChangeSchedule(authority string, schedule ReleaseSchedule) *MsgChange
 		Schedule:  schedule,
 	}
 }
+
+// NewMsgCreateSchedule returns a new MsgCreateSchedule with the authority,
+// the new named schedule, its destinations and its curve.
+func NewMsgCreateSchedule(
+	authority, name string, totalAmount sdk.Coin, startTime, endTime time.Time,
+	destinations []ReleaseDestination, curve EmissionCurve,
+) *MsgCreateSchedule {
+	return &MsgCreateSchedule{
+		Authority:    authority,
+		Name:         name,
+		TotalAmount:  totalAmount,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Destinations: destinations,
+		Curve:        curve,
+	}
+}
+
+// NewMsgPauseSchedule returns a new MsgPauseSchedule with the authority,
+// the schedule name and if it should be paused or resumed.
+func NewMsgPauseSchedule(authority, name string, paused bool) *MsgPauseSchedule {
+	return &MsgPauseSchedule{
+		Authority: authority,
+		Name:      name,
+		Paused:    paused,
+	}
+}
+
+// NewMsgCancelSchedule returns a new MsgCancelSchedule with the authority
+// and the schedule name.
+func NewMsgCancelSchedule(authority, name string) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Authority: authority,
+		Name:      name,
+	}
+}
+
+// NewMsgWithdrawFromPool returns a new MsgWithdrawFromPool with the authority,
+// the recipient and the amount.
+func NewMsgWithdrawFromPool(authority string, recipient sdk.AccAddress, amount sdk.Coin) *MsgWithdrawFromPool {
+	return &MsgWithdrawFromPool{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    amount,
+	}
+}
+
+// NewMsgRegisterRevenue returns a new MsgRegisterRevenue with the sender,
+// the contract, the withdraw address and the EVM deployer nonces.
+func NewMsgRegisterRevenue(sender, contract, withdrawAddress sdk.AccAddress, nonces []uint64) *MsgRegisterRevenue {
+	msg := &MsgRegisterRevenue{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Nonces:   nonces,
+	}
+	if !withdrawAddress.Empty() {
+		msg.WithdrawAddress = withdrawAddress.String()
+	}
+	return msg
+}
+
+// NewMsgClaimRevenue returns a new MsgClaimRevenue with the withdraw address.
+func NewMsgClaimRevenue(sender sdk.AccAddress) *MsgClaimRevenue {
+	return &MsgClaimRevenue{
+		Sender: sender.String(),
+	}
+}
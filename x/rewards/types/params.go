@@ -3,21 +3,82 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/kiichain/kiichain/v5/app/params"
 )
 
+// DefaultMaxReleaseHistory is the default number of records kept on the release history
+const DefaultMaxReleaseHistory = uint64(100_000)
+
 // DefaultParams returns default rewards parameters
 func DefaultParams() Params {
 	return Params{
-		TokenDenom: params.BaseDenom, // akii base denom
+		MaxReleasePerBlock: sdk.Coins{}, // no release cap
+		HaltThreshold:      0,           // no halt detection
+		ShiftOnHalt:        false,
+		MaxReleaseHistory:  DefaultMaxReleaseHistory,
+		AllowedDenoms:      []string{params.BaseDenom}, // akii base denom
+		DeveloperShare:     math.LegacyZeroDec(),       // no developer revenue
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	denom := p.TokenDenom
-	if denom == "" {
-		return fmt.Errorf("invalid denom, empty: %s", denom)
+	if len(p.AllowedDenoms) == 0 {
+		return fmt.Errorf("allowed denoms cannot be empty")
+	}
+	seen := make(map[string]bool, len(p.AllowedDenoms))
+	for _, denom := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom %s: %w", denom, err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	// Denoms without a release cap are not capped
+	if err := p.MaxReleasePerBlock.Validate(); err != nil {
+		return fmt.Errorf("invalid max release per block: %w", err)
+	}
+	if p.HaltThreshold < 0 {
+		return fmt.Errorf("halt threshold cannot be negative: %s", p.HaltThreshold)
+	}
+
+	// An unset developer share is disabled
+	if !p.DeveloperShare.IsNil() && (p.DeveloperShare.IsNegative() || p.DeveloperShare.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("developer share must be between 0 and 1: %s", p.DeveloperShare)
 	}
 	return nil
 }
+
+// GetReleaseCap returns the max amount of a denom all the schedules release in a block and if the cap is enabled
+func (p Params) GetReleaseCap(denom string) (math.Int, bool) {
+	releaseCap := p.MaxReleasePerBlock.AmountOf(denom)
+	if !releaseCap.IsPositive() {
+		return math.ZeroInt(), false
+	}
+	return releaseCap, true
+}
+
+// GetDeveloperShare returns the share of the releases sent to the contract developers and if it is enabled
+func (p Params) GetDeveloperShare() (math.LegacyDec, bool) {
+	if p.DeveloperShare.IsNil() || !p.DeveloperShare.IsPositive() {
+		return math.LegacyZeroDec(), false
+	}
+	return p.DeveloperShare, true
+}
+
+// IsAllowedDenom returns if the pool can be funded with and the schedules can release the denom
+func (p Params) IsAllowedDenom(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
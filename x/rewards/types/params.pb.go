@@ -4,18 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +33,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the rewards module.
 type Params struct {
-	// Denom used
-	TokenDenom string `protobuf:"bytes,1,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"`
+	// Single denom used before the allowed denoms, moved to the allowed denoms
+	// on the upgrade
+	TokenDenom string `protobuf:"bytes,1,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"` // Deprecated: Do not use.
+	// Max amount of each denom released in a block by all the schedules, denoms
+	// without a cap are not capped. The amount over the cap is released on the
+	// next blocks
+	MaxReleasePerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_release_per_block,json=maxReleasePerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_release_per_block"`
+	// Time between blocks handled as a chain halt, zero disables the halt
+	// detection
+	HaltThreshold time.Duration `protobuf:"bytes,3,opt,name=halt_threshold,json=haltThreshold,proto3,stdduration" json:"halt_threshold"`
+	// If the schedules are shifted by the halt duration, so the halted time
+	// isn't released
+	ShiftOnHalt bool `protobuf:"varint,4,opt,name=shift_on_halt,json=shiftOnHalt,proto3" json:"shift_on_halt,omitempty"`
+	// Max number of records kept on the release history, the oldest records
+	// are pruned. Zero disables the history
+	MaxReleaseHistory uint64 `protobuf:"varint,5,opt,name=max_release_history,json=maxReleaseHistory,proto3" json:"max_release_history,omitempty"`
+	// Denoms the pool can be funded with and the schedules can release
+	AllowedDenoms []string `protobuf:"bytes,6,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Share of each release sent to the developers of the registered contracts,
+	// split by the fees paid for the contracts on the previous block. Zero
+	// disables the developer revenue
+	DeveloperShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=developer_share,json=developerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"developer_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,6 +90,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetTokenDenom() string {
 	if m != nil {
 		return m.TokenDenom
@@ -69,6 +98,41 @@ func (m *Params) GetTokenDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxReleasePerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxReleasePerBlock
+	}
+	return nil
+}
+
+func (m *Params) GetHaltThreshold() time.Duration {
+	if m != nil {
+		return m.HaltThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetShiftOnHalt() bool {
+	if m != nil {
+		return m.ShiftOnHalt
+	}
+	return false
+}
+
+func (m *Params) GetMaxReleaseHistory() uint64 {
+	if m != nil {
+		return m.MaxReleaseHistory
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.rewards.v1beta1.Params")
 }
@@ -78,19 +142,38 @@ func init() {
 }
 
 var fileDescriptor_54abd846c753e163 = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x54, 0x70, 0x1a, 0x5b, 0x52, 0x59, 0x90,
-	0x0a, 0x35, 0x55, 0x49, 0x93, 0x8b, 0x2d, 0x00, 0x6c, 0x8b, 0x90, 0x3c, 0x17, 0x77, 0x49, 0x7e,
-	0x76, 0x6a, 0x5e, 0x7c, 0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x17, 0x58, 0xc8, 0x05, 0x24, 0xe2, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x70, 0x5b,
-	0xe1, 0x8c, 0x0a, 0xb8, 0x03, 0xc0, 0x16, 0x27, 0xb1, 0x81, 0x6d, 0x36, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x46, 0xe3, 0xb3, 0x7a, 0xf8, 0x00, 0x00, 0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x12, 0xda, 0x8d, 0x12, 0x84, 0x01, 0xc9, 0x2d, 0x92, 0x63, 0x15, 0x2a, 0xf9,
+	0x40, 0xd7, 0x04, 0xde, 0x20, 0x44, 0xa8, 0x42, 0x48, 0x54, 0x86, 0x53, 0x2f, 0xd6, 0xda, 0x9e,
+	0xda, 0x96, 0x7f, 0x26, 0xda, 0xdd, 0xb4, 0xc9, 0x85, 0x67, 0xe0, 0xc8, 0x33, 0x70, 0xe6, 0x21,
+	0x7a, 0x8c, 0x38, 0x21, 0x0e, 0x2d, 0x4a, 0x5e, 0x04, 0xad, 0xbd, 0x31, 0xbd, 0xf4, 0xe4, 0xdd,
+	0x99, 0x6f, 0xe6, 0xfb, 0xf1, 0x92, 0xe3, 0x3c, 0xcb, 0xa2, 0x94, 0x65, 0x95, 0xc7, 0xe1, 0x8a,
+	0xf1, 0x58, 0x78, 0x97, 0xe3, 0x10, 0x24, 0x1b, 0x7b, 0x33, 0xc6, 0x59, 0x29, 0xe8, 0x8c, 0xa3,
+	0x44, 0xd3, 0xda, 0xc2, 0xa8, 0x86, 0x51, 0x0d, 0x3b, 0x7c, 0x9a, 0x60, 0x82, 0x35, 0xc8, 0x53,
+	0xa7, 0x06, 0x7f, 0x78, 0x10, 0xa1, 0x28, 0x51, 0x04, 0x4d, 0xa3, 0xb9, 0xe8, 0x96, 0xdd, 0xdc,
+	0xbc, 0x90, 0x09, 0x68, 0xc9, 0x22, 0xcc, 0x2a, 0xdd, 0x7f, 0x79, 0xaf, 0x22, 0xb9, 0x9c, 0x41,
+	0xbb, 0x25, 0x41, 0x4c, 0x0a, 0xf0, 0xea, 0x5b, 0x38, 0xbf, 0xf0, 0xe2, 0x39, 0x67, 0x32, 0x43,
+	0xbd, 0xe5, 0x68, 0xd5, 0x25, 0xbd, 0xb3, 0xda, 0x81, 0xf9, 0x82, 0xf4, 0x25, 0xe6, 0x50, 0x05,
+	0x31, 0x54, 0x58, 0x5a, 0x86, 0x63, 0xb8, 0xfb, 0x93, 0x1d, 0xcb, 0xf0, 0x49, 0x5d, 0x9e, 0xaa,
+	0xaa, 0xf9, 0x95, 0x3c, 0x2b, 0xd9, 0x22, 0xe0, 0x50, 0x00, 0x13, 0x10, 0xcc, 0x80, 0x07, 0x61,
+	0x81, 0x51, 0x6e, 0xed, 0x38, 0x5d, 0xb7, 0xff, 0xe6, 0x80, 0x6a, 0x0f, 0x4a, 0xf5, 0xd6, 0x3b,
+	0x7d, 0x87, 0x59, 0x35, 0x79, 0x7d, 0x7d, 0x33, 0xea, 0xfc, 0xb8, 0x1d, 0xb9, 0x49, 0x26, 0xd3,
+	0x79, 0x48, 0x23, 0x2c, 0xb5, 0x61, 0xfd, 0x39, 0x11, 0x71, 0xae, 0xb5, 0xab, 0x01, 0xe1, 0x9b,
+	0x25, 0x5b, 0xf8, 0x0d, 0xd1, 0x19, 0xf0, 0x89, 0xa2, 0x31, 0x3f, 0x90, 0x61, 0xca, 0x0a, 0x19,
+	0xc8, 0x94, 0x83, 0x48, 0xb1, 0x88, 0xad, 0xae, 0x63, 0xd4, 0xc4, 0x8d, 0x51, 0xba, 0x35, 0x4a,
+	0xa7, 0xda, 0xe8, 0x64, 0x4f, 0x11, 0x7f, 0xbf, 0x1d, 0x19, 0xfe, 0x40, 0x8d, 0x7e, 0xd9, 0x4e,
+	0x9a, 0x47, 0x64, 0x20, 0xd2, 0xec, 0x42, 0x06, 0x58, 0x05, 0xaa, 0x63, 0xed, 0x3a, 0x86, 0xbb,
+	0xe7, 0xf7, 0xeb, 0xe2, 0xa7, 0xea, 0x94, 0x15, 0xd2, 0xa4, 0xe4, 0xc9, 0x5d, 0xbf, 0x69, 0x26,
+	0x24, 0xf2, 0xa5, 0xf5, 0xc0, 0x31, 0xdc, 0x5d, 0xff, 0xf1, 0x7f, 0x81, 0xa7, 0x4d, 0xc3, 0x3c,
+	0x26, 0x43, 0x56, 0x14, 0x78, 0x05, 0x71, 0x13, 0xa3, 0xb0, 0x7a, 0x4e, 0xd7, 0xdd, 0xf7, 0x07,
+	0xba, 0x5a, 0xa7, 0x28, 0xcc, 0x73, 0xf2, 0x28, 0x86, 0x4b, 0x28, 0x50, 0x05, 0x28, 0x52, 0xc6,
+	0xc1, 0x7a, 0x58, 0xe7, 0x3d, 0x56, 0x62, 0xff, 0xdc, 0x8c, 0x9e, 0x37, 0x99, 0x88, 0x38, 0xa7,
+	0x19, 0x7a, 0x25, 0x93, 0x29, 0xfd, 0x08, 0x09, 0x8b, 0x96, 0x53, 0x88, 0x7e, 0xfd, 0x3c, 0x21,
+	0x3a, 0xe6, 0x29, 0x44, 0xfe, 0xb0, 0xdd, 0xf4, 0x59, 0x2d, 0x9a, 0xbc, 0xbf, 0x5e, 0xdb, 0xc6,
+	0x6a, 0x6d, 0x1b, 0x7f, 0xd7, 0xb6, 0xf1, 0x6d, 0x63, 0x77, 0x56, 0x1b, 0xbb, 0xf3, 0x7b, 0x63,
+	0x77, 0xce, 0x5f, 0xdd, 0x89, 0xbe, 0x7d, 0x3d, 0xed, 0x61, 0xd1, 0x3e, 0xa4, 0xfa, 0x27, 0x84,
+	0xbd, 0x3a, 0xca, 0xb7, 0xff, 0x06, 0x00, 0x26, 0xbe, 0x84, 0x28, 0xfb, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +196,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DeveloperShare.Size()
+		i -= size
+		if _, err := m.DeveloperShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxReleaseHistory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReleaseHistory))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShiftOnHalt {
+		i--
+		if m.ShiftOnHalt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HaltThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HaltThreshold):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MaxReleasePerBlock) > 0 {
+		for iNdEx := len(m.MaxReleasePerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxReleasePerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TokenDenom) > 0 {
 		i -= len(m.TokenDenom)
 		copy(dAtA[i:], m.TokenDenom)
@@ -144,6 +283,28 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.MaxReleasePerBlock) > 0 {
+		for _, e := range m.MaxReleasePerBlock {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HaltThreshold)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ShiftOnHalt {
+		n += 2
+	}
+	if m.MaxReleaseHistory != 0 {
+		n += 1 + sovParams(uint64(m.MaxReleaseHistory))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.DeveloperShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -214,6 +375,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleasePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxReleasePerBlock = append(m.MaxReleasePerBlock, types.Coin{})
+			if err := m.MaxReleasePerBlock[len(m.MaxReleasePerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HaltThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShiftOnHalt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShiftOnHalt = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleaseHistory", wireType)
+			}
+			m.MaxReleaseHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReleaseHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,16 +2,24 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/kiichain/kiichain/v5/x/rewards/types"
 )
 
 func TestParamsValidateBasic(t *testing.T) {
 	type fields struct {
 		GovernanceMinDeposit string
-		TokenDenom           string
+		AllowedDenoms        []string
+		MaxReleasePerBlock   sdk.Coins
+		HaltThreshold        time.Duration
+		DeveloperShare       math.LegacyDec
 	}
 	tests := []struct {
 		name    string
@@ -21,14 +29,92 @@ func TestParamsValidateBasic(t *testing.T) {
 		{
 			name: "success - valid params",
 			fields: fields{
-				TokenDenom: "akii",
+				AllowedDenoms: []string{"akii"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - multiple denoms",
+			fields: fields{
+				AllowedDenoms: []string{"akii", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
 			},
 			wantErr: false,
 		},
 		{
-			name: "invalid - empty token denom",
+			name: "invalid - empty allowed denoms",
+			fields: fields{
+				AllowedDenoms: []string{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - empty denom",
 			fields: fields{
-				TokenDenom: "",
+				AllowedDenoms: []string{""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - duplicated denom",
+			fields: fields{
+				AllowedDenoms: []string{"akii", "akii"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success - release cap and halt threshold",
+			fields: fields{
+				AllowedDenoms:      []string{"akii"},
+				MaxReleasePerBlock: sdk.NewCoins(sdk.NewInt64Coin("akii", 1000), sdk.NewInt64Coin("uatom", 10)),
+				HaltThreshold:      time.Hour,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid - negative release cap",
+			fields: fields{
+				AllowedDenoms:      []string{"akii"},
+				MaxReleasePerBlock: sdk.Coins{{Denom: "akii", Amount: math.NewInt(-1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - duplicated release cap denom",
+			fields: fields{
+				AllowedDenoms:      []string{"akii"},
+				MaxReleasePerBlock: sdk.Coins{sdk.NewInt64Coin("akii", 1), sdk.NewInt64Coin("akii", 2)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - negative halt threshold",
+			fields: fields{
+				AllowedDenoms: []string{"akii"},
+				HaltThreshold: -time.Hour,
+			},
+			wantErr: true,
+		},
+		{
+			name: "success - developer share",
+			fields: fields{
+				AllowedDenoms:  []string{"akii"},
+				DeveloperShare: math.LegacyNewDecWithPrec(1, 1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid - negative developer share",
+			fields: fields{
+				AllowedDenoms:  []string{"akii"},
+				DeveloperShare: math.LegacyNewDec(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - developer share over one",
+			fields: fields{
+				AllowedDenoms:  []string{"akii"},
+				DeveloperShare: math.LegacyNewDecWithPrec(11, 1),
 			},
 			wantErr: true,
 		},
@@ -36,7 +122,10 @@ func TestParamsValidateBasic(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				TokenDenom: tt.fields.TokenDenom,
+				AllowedDenoms:      tt.fields.AllowedDenoms,
+				MaxReleasePerBlock: tt.fields.MaxReleasePerBlock,
+				HaltThreshold:      tt.fields.HaltThreshold,
+				DeveloperShare:     tt.fields.DeveloperShare,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -51,5 +140,39 @@ func TestDefaultParams(t *testing.T) {
 	require.NoError(t, defaultParams.ValidateBasic())
 
 	// Verify specific default values
-	require.Equal(t, "akii", defaultParams.TokenDenom)
+	require.Equal(t, []string{"akii"}, defaultParams.AllowedDenoms)
+
+	// The release cap is disabled by default
+	_, enabled := defaultParams.GetReleaseCap("akii")
+	require.False(t, enabled)
+
+	// The developer revenue is disabled by default
+	_, enabled = defaultParams.GetDeveloperShare()
+	require.False(t, enabled)
+}
+
+func TestParamsIsAllowedDenom(t *testing.T) {
+	params := types.Params{AllowedDenoms: []string{"akii", "uatom"}}
+
+	require.True(t, params.IsAllowedDenom("akii"))
+	require.True(t, params.IsAllowedDenom("uatom"))
+	require.False(t, params.IsAllowedDenom("uosmo"))
+	require.False(t, params.IsAllowedDenom(""))
+}
+
+func TestGetReleaseCap(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxReleasePerBlock = sdk.NewCoins(sdk.NewInt64Coin("akii", 1000), sdk.NewInt64Coin("uatom", 10))
+
+	// Each denom has its own cap
+	releaseCap, enabled := params.GetReleaseCap("akii")
+	require.True(t, enabled)
+	require.Equal(t, math.NewInt(1000), releaseCap)
+	releaseCap, enabled = params.GetReleaseCap("uatom")
+	require.True(t, enabled)
+	require.Equal(t, math.NewInt(10), releaseCap)
+
+	// Denoms without a cap are not capped
+	_, enabled = params.GetReleaseCap("uosmo")
+	require.False(t, enabled)
 }
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProjectRelease returns the amt a schedule is projected to release between two times
+// Times before the current block are projected from the current block, inactive schedules release nothing
+func (rr ReleaseSchedule) ProjectRelease(blockTime, from, to time.Time) sdk.Coin {
+	zero := sdk.Coin{Denom: rr.TotalAmount.Denom, Amount: math.ZeroInt()}
+	if !rr.Active || rr.TotalAmount.Amount.IsNil() || rr.TotalAmount.IsZero() {
+		return zero
+	}
+
+	// Project from the current block
+	if from.Before(blockTime) {
+		from = blockTime
+	}
+	if !to.After(from) {
+		return zero
+	}
+
+	released := rr.projectReleased(blockTime, to).Sub(rr.projectReleased(blockTime, from))
+	if !released.IsPositive() {
+		return zero
+	}
+	return sdk.NewCoin(rr.TotalAmount.Denom, released)
+}
+
+// projectReleased returns the total amt the schedule is projected to have released up to a time
+func (rr ReleaseSchedule) projectReleased(blockTime, t time.Time) math.Int {
+	released := math.ZeroInt()
+	if !rr.ReleasedAmount.Amount.IsNil() {
+		released = rr.ReleasedAmount.Amount
+	}
+	remaining := rr.TotalAmount.Amount.Sub(released)
+	if !remaining.IsPositive() {
+		return released
+	}
+
+	// Non-linear curves give the fraction of the total amount released up to the time
+	if rr.Curve.Type != CurveTypeLinear {
+		fraction := rr.Curve.ReleasedFraction(t, rr.EndTime)
+		target := math.LegacyNewDecFromInt(rr.TotalAmount.Amount).Mul(fraction).TruncateInt()
+		return math.MinInt(math.MaxInt(target, released), rr.TotalAmount.Amount)
+	}
+
+	// The linear release starts on the last release, or the current block for the first release
+	lastRelease := rr.LastReleaseTime
+	if lastRelease.IsZero() {
+		lastRelease = blockTime
+	}
+	if !t.After(lastRelease) {
+		return released
+	}
+	if !t.Before(rr.EndTime) {
+		return rr.TotalAmount.Amount
+	}
+
+	// Release the remaining amount linearly until the end time
+	elapsed := math.LegacyNewDec(int64(t.Sub(lastRelease)))
+	duration := math.LegacyNewDec(int64(rr.EndTime.Sub(lastRelease)))
+	return released.Add(math.LegacyNewDecFromInt(remaining).Mul(elapsed).Quo(duration).TruncateInt())
+}
+
+// GetStakingShare returns the share of the released rewards sent to the fee collector, reaching the stakers
+// The developer share is taken from the release before the destinations
+func (rr ReleaseSchedule) GetStakingShare(developerShare math.LegacyDec) math.LegacyDec {
+	destinations := rr.GetReleaseDestinations()
+
+	// Sum the weights
+	totalWeight := math.ZeroInt()
+	stakingWeight := math.ZeroInt()
+	for _, destination := range destinations {
+		weight := math.NewIntFromUint64(destination.Weight)
+		totalWeight = totalWeight.Add(weight)
+		if destination.Type == DestinationTypeFeeCollector {
+			stakingWeight = stakingWeight.Add(weight)
+		}
+	}
+	if totalWeight.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDecFromInt(stakingWeight).QuoInt(totalWeight).Mul(math.LegacyOneDec().Sub(developerShare))
+}
+
+// CalculateStakingAPR returns the staking APR given by the annual staking rewards over the bonded tokens
+func CalculateStakingAPR(annualRewards, totalBonded math.Int) math.LegacyDec {
+	if !totalBonded.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDecFromInt(annualRewards).QuoInt(totalBonded)
+}
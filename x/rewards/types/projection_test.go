@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestProjectRelease(t *testing.T) {
+	now := time.Now().UTC()
+	denom := "akii"
+
+	linear := types.ReleaseSchedule{
+		Active:          true,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(200)),
+		LastReleaseTime: now,
+		EndTime:         now.Add(4 * time.Hour),
+	}
+
+	testCases := []struct {
+		name     string
+		schedule types.ReleaseSchedule
+		from     time.Time
+		to       time.Time
+		expected math.Int
+	}{
+		{
+			name:     "linear - half of the remaining period",
+			schedule: linear,
+			from:     now,
+			to:       now.Add(2 * time.Hour),
+			expected: math.NewInt(400),
+		},
+		{
+			name:     "linear - start before the block is projected from the block",
+			schedule: linear,
+			from:     now.Add(-time.Hour),
+			to:       now.Add(time.Hour),
+			expected: math.NewInt(200),
+		},
+		{
+			name:     "linear - period over the end",
+			schedule: linear,
+			from:     now.Add(3 * time.Hour),
+			to:       now.Add(10 * time.Hour),
+			expected: math.NewInt(200),
+		},
+		{
+			name:     "linear - period after the end",
+			schedule: linear,
+			from:     now.Add(5 * time.Hour),
+			to:       now.Add(10 * time.Hour),
+			expected: math.ZeroInt(),
+		},
+		{
+			name: "linear - first release projected from the block",
+			schedule: types.ReleaseSchedule{
+				Active:         true,
+				TotalAmount:    sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+				EndTime:        now.Add(4 * time.Hour),
+			},
+			from:     now,
+			to:       now.Add(time.Hour),
+			expected: math.NewInt(250),
+		},
+		{
+			name: "cliff - nothing before the cliff",
+			schedule: types.ReleaseSchedule{
+				Active:          true,
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseTime: now,
+				EndTime:         now.Add(4 * time.Hour),
+				Curve:           types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: now, CliffTime: now.Add(time.Hour)},
+			},
+			from:     now,
+			to:       now.Add(30 * time.Minute),
+			expected: math.ZeroInt(),
+		},
+		{
+			name: "cliff - accrued amount released on the cliff",
+			schedule: types.ReleaseSchedule{
+				Active:          true,
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseTime: now,
+				EndTime:         now.Add(4 * time.Hour),
+				Curve:           types.EmissionCurve{Type: types.CurveTypeCliffLinear, StartTime: now, CliffTime: now.Add(time.Hour)},
+			},
+			from:     now,
+			to:       now.Add(2 * time.Hour),
+			expected: math.NewInt(500),
+		},
+		{
+			name:     "inactive schedule",
+			schedule: types.ReleaseSchedule{Active: false, TotalAmount: sdk.NewCoin(denom, math.NewInt(1000))},
+			from:     now,
+			to:       now.Add(time.Hour),
+			expected: math.ZeroInt(),
+		},
+		{
+			name:     "empty schedule",
+			schedule: types.InitialReleaseSchedule(),
+			from:     now,
+			to:       now.Add(time.Hour),
+			expected: math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projected := tc.schedule.ProjectRelease(now, tc.from, tc.to)
+			require.True(t, tc.expected.Equal(projected.Amount), "expected %s, got %s", tc.expected, projected.Amount)
+		})
+	}
+}
+
+func TestGetStakingShare(t *testing.T) {
+	address := sdk.AccAddress([]byte("destination_address")).String()
+
+	// Schedules without destinations send everything to the fee collector
+	require.Equal(t, math.LegacyOneDec(), types.ReleaseSchedule{}.GetStakingShare(math.LegacyZeroDec()))
+
+	// Only the fee collector weight reaches the stakers
+	schedule := types.ReleaseSchedule{
+		Destinations: []types.ReleaseDestination{
+			types.NewReleaseDestination(types.DestinationTypeFeeCollector, "", 1),
+			types.NewReleaseDestination(types.DestinationTypeCommunityPool, "", 2),
+			types.NewReleaseDestination(types.DestinationTypeAddress, address, 1),
+		},
+	}
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), schedule.GetStakingShare(math.LegacyZeroDec()))
+
+	// The developer share is taken before the destinations
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), schedule.GetStakingShare(math.LegacyMustNewDecFromStr("0.2")))
+	require.True(t, schedule.GetStakingShare(math.LegacyOneDec()).IsZero())
+}
+
+func TestCalculateStakingAPR(t *testing.T) {
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), types.CalculateStakingAPR(math.NewInt(100), math.NewInt(1000)))
+	require.Equal(t, math.LegacyZeroDec(), types.CalculateStakingAPR(math.NewInt(100), math.ZeroInt()))
+}
@@ -5,24 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -239,6 +247,11 @@ var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
 // RewardPool gRPC query.
 type QueryRewardPoolResponse struct {
 	RewardPool RewardPool `protobuf:"bytes,1,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool" yaml:"reward_pool"`
+	// committed_funds are the pool funds still to be released by the active
+	// schedules
+	CommittedFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=committed_funds,json=committedFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"committed_funds" yaml:"committed_funds"`
+	// free_funds are the pool funds not committed to any schedule
+	FreeFunds github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=free_funds,json=freeFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"free_funds" yaml:"free_funds"`
 }
 
 func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
@@ -281,465 +294,3548 @@ func (m *QueryRewardPoolResponse) GetRewardPool() RewardPool {
 	return RewardPool{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.rewards.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.rewards.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryReleaseScheduleRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleRequest")
-	proto.RegisterType((*QueryReleaseScheduleResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleResponse")
-	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolRequest")
-	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolResponse")
+func (m *QueryRewardPoolResponse) GetCommittedFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommittedFunds
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("kiichain/rewards/v1beta1/query.proto", fileDescriptor_12435df56ac62847)
+func (m *QueryRewardPoolResponse) GetFreeFunds() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FreeFunds
+	}
+	return nil
 }
 
-var fileDescriptor_12435df56ac62847 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0xfc, 0xc9, 0x61, 0x7b, 0x28, 0x5a, 0x2a, 0x1a, 0x99, 0xe2, 0x44, 0xab, 0x56,
-	0x94, 0x2a, 0xb1, 0x49, 0x10, 0x1c, 0x38, 0x70, 0xc8, 0x81, 0x73, 0x31, 0xe2, 0xc2, 0xa5, 0xda,
-	0xa4, 0x2b, 0xc7, 0xc2, 0xf1, 0xb8, 0x5e, 0x1b, 0x88, 0xc4, 0x89, 0x17, 0x00, 0x09, 0x71, 0xe7,
-	0x21, 0x78, 0x88, 0x1e, 0x2b, 0x71, 0xe1, 0x54, 0xa1, 0x84, 0x27, 0x80, 0x17, 0x40, 0xde, 0x1d,
-	0xbb, 0xaa, 0x5b, 0x37, 0xe4, 0xe6, 0xec, 0x7c, 0xf3, 0x7d, 0xbf, 0xec, 0x8c, 0x96, 0x6e, 0xbf,
-	0x09, 0x82, 0xf1, 0x44, 0x04, 0x91, 0x9b, 0xc8, 0x77, 0x22, 0x39, 0x54, 0xee, 0xdb, 0xfe, 0x48,
-	0xa6, 0xa2, 0xef, 0x1e, 0x65, 0x32, 0x99, 0x39, 0x71, 0x02, 0x29, 0xb0, 0x56, 0xa1, 0x72, 0x50,
-	0xe5, 0xa0, 0xca, 0xda, 0xf0, 0xc1, 0x07, 0x2d, 0x72, 0xf3, 0x2f, 0xa3, 0xb7, 0xb6, 0x7c, 0x00,
-	0x3f, 0x94, 0xae, 0x88, 0x03, 0x57, 0x44, 0x11, 0xa4, 0x22, 0x0d, 0x20, 0x52, 0x58, 0xdd, 0x1b,
-	0x83, 0x9a, 0x82, 0x72, 0x47, 0x42, 0x49, 0x13, 0x53, 0x86, 0xc6, 0xc2, 0x0f, 0x22, 0x2d, 0x46,
-	0x6d, 0x3d, 0x5f, 0x3a, 0x8b, 0x65, 0xe1, 0xb8, 0x53, 0xab, 0x8a, 0x45, 0x22, 0xa6, 0x28, 0xe3,
-	0x1b, 0x94, 0xbd, 0xc8, 0xe3, 0xf6, 0xf5, 0xa1, 0x27, 0x8f, 0x32, 0xa9, 0x52, 0xfe, 0x8a, 0xde,
-	0x3e, 0x77, 0xaa, 0x62, 0x88, 0x94, 0x64, 0xcf, 0x68, 0xd3, 0x34, 0xb7, 0x48, 0x87, 0xec, 0xae,
-	0x0d, 0x3a, 0x4e, 0xdd, 0x25, 0x38, 0xa6, 0x73, 0x78, 0xe3, 0xf8, 0xb4, 0xdd, 0xf0, 0xb0, 0x8b,
-	0xdf, 0xa3, 0x77, 0xb5, 0xad, 0x27, 0x43, 0x29, 0x94, 0x7c, 0x39, 0x9e, 0xc8, 0xc3, 0x2c, 0x94,
-	0x45, 0xea, 0x57, 0x42, 0xb7, 0x2e, 0xaf, 0x63, 0x7e, 0x46, 0x6f, 0x25, 0xa6, 0x74, 0xa0, 0xb0,
-	0x86, 0x24, 0x0f, 0xea, 0x49, 0x2a, 0x66, 0xc3, 0x76, 0x8e, 0xf4, 0xe7, 0xb4, 0xbd, 0x39, 0x13,
-	0xd3, 0xf0, 0x29, 0xaf, 0x1a, 0x72, 0x6f, 0x3d, 0x39, 0xdf, 0xc1, 0x5b, 0xf4, 0x0e, 0x62, 0xe5,
-	0xce, 0xfb, 0x00, 0x61, 0x41, 0xfc, 0x81, 0x6e, 0x5e, 0xa8, 0x20, 0xab, 0xa0, 0x6b, 0x86, 0xe4,
-	0x20, 0x06, 0x08, 0x11, 0x73, 0xfb, 0x2a, 0xcc, 0xc2, 0x62, 0x68, 0x21, 0x21, 0x2b, 0x08, 0x4b,
-	0x1b, 0xee, 0xd1, 0xa4, 0xd4, 0x0d, 0xfe, 0x5e, 0xa7, 0x37, 0x75, 0x3c, 0xfb, 0x44, 0x68, 0xd3,
-	0xdc, 0x38, 0xeb, 0xd6, 0x47, 0x5c, 0x1c, 0xb4, 0xd5, 0xfb, 0x4f, 0xb5, 0xf9, 0x53, 0x7c, 0xf7,
-	0xe3, 0x8f, 0xdf, 0x5f, 0xae, 0x71, 0xd6, 0x71, 0x97, 0x6c, 0x17, 0xfb, 0x4e, 0xe8, 0x7a, 0xe5,
-	0xe6, 0xd9, 0xe3, 0x25, 0x61, 0x97, 0xaf, 0x85, 0xf5, 0x64, 0xd5, 0x36, 0x84, 0x1d, 0x68, 0xd8,
-	0x2e, 0xdb, 0xab, 0x87, 0xc5, 0x49, 0xf7, 0x8a, 0xe1, 0xb3, 0x6f, 0x84, 0xd2, 0xb3, 0x49, 0xb0,
-	0x87, 0x4b, 0xa3, 0x2b, 0x1b, 0x61, 0xf5, 0x57, 0xe8, 0x40, 0xce, 0x9e, 0xe6, 0xbc, 0xcf, 0x76,
-	0xae, 0xe2, 0xcc, 0x7f, 0xf7, 0xf2, 0x15, 0x18, 0x3e, 0x3f, 0x9e, 0xdb, 0xe4, 0x64, 0x6e, 0x93,
-	0x5f, 0x73, 0x9b, 0x7c, 0x5e, 0xd8, 0x8d, 0x93, 0x85, 0xdd, 0xf8, 0xb9, 0xb0, 0x1b, 0xaf, 0xbb,
-	0x7e, 0x90, 0x4e, 0xb2, 0x91, 0x33, 0x86, 0xe9, 0x99, 0x55, 0xf9, 0xf1, 0xbe, 0x74, 0xd5, 0xcf,
-	0xc4, 0xa8, 0xa9, 0x1f, 0x80, 0x47, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x94, 0x24, 0x7b,
-	0xef, 0x04, 0x00, 0x00,
+// QuerySchedulesRequest defines the request structure for the
+// Schedules gRPC query.
+type QuerySchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom filters the schedules releasing the denom, empty returns all
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QuerySchedulesRequest) Reset()         { *m = QuerySchedulesRequest{} }
+func (m *QuerySchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesRequest) ProtoMessage()    {}
+func (*QuerySchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{6}
+}
+func (m *QuerySchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesRequest.Merge(m, src)
+}
+func (m *QuerySchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySchedulesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the reward module's
-	// parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ReleaseSchedule defines a gRPC query method for fetching
-	// ReleaseSchedule data.
-	ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error)
-	// RewardPool defines a gRPC query method for fetching
-	// RewardPool data.
-	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+func (m *QuerySchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySchedulesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QuerySchedulesResponse defines the response structure for the
+// Schedules gRPC query.
+type QuerySchedulesResponse struct {
+	Schedules []Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules" yaml:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySchedulesResponse) Reset()         { *m = QuerySchedulesResponse{} }
+func (m *QuerySchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchedulesResponse) ProtoMessage()    {}
+func (*QuerySchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{7}
+}
+func (m *QuerySchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchedulesResponse.Merge(m, src)
+}
+func (m *QuerySchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchedulesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error) {
-	out := new(QueryReleaseScheduleResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySchedulesResponse proto.InternalMessageInfo
+
+func (m *QuerySchedulesResponse) GetSchedules() []Schedule {
+	if m != nil {
+		return m.Schedules
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
-	out := new(QueryRewardPoolResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/RewardPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the reward module's
-	// parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ReleaseSchedule defines a gRPC query method for fetching
-	// ReleaseSchedule data.
-	ReleaseSchedule(context.Context, *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error)
-	// RewardPool defines a gRPC query method for fetching
-	// RewardPool data.
-	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+// QueryProjectedReleaseRequest defines the request structure for the
+// ProjectedRelease gRPC query.
+type QueryProjectedReleaseRequest struct {
+	// from_time is the start of the projection, times before the current block
+	// are projected from the current block
+	FromTime time.Time `protobuf:"bytes,1,opt,name=from_time,json=fromTime,proto3,stdtime" json:"from_time"`
+	// to_time is the end of the projection
+	ToTime time.Time `protobuf:"bytes,2,opt,name=to_time,json=toTime,proto3,stdtime" json:"to_time"`
+	// denom filters the schedules releasing the denom, empty projects all
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryProjectedReleaseRequest) Reset()         { *m = QueryProjectedReleaseRequest{} }
+func (m *QueryProjectedReleaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedReleaseRequest) ProtoMessage()    {}
+func (*QueryProjectedReleaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryProjectedReleaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ReleaseSchedule(ctx context.Context, req *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedule not implemented")
+func (m *QueryProjectedReleaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedReleaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+func (m *QueryProjectedReleaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedReleaseRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryProjectedReleaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedReleaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedReleaseRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryProjectedReleaseRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedReleaseRequest) GetFromTime() time.Time {
+	if m != nil {
+		return m.FromTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_ReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReleaseScheduleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReleaseSchedule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseSchedule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReleaseSchedule(ctx, req.(*QueryReleaseScheduleRequest))
+func (m *QueryProjectedReleaseRequest) GetToTime() time.Time {
+	if m != nil {
+		return m.ToTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/RewardPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+func (m *QueryProjectedReleaseRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.rewards.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ReleaseSchedule",
-			Handler:    _Query_ReleaseSchedule_Handler,
-		},
-		{
-			MethodName: "RewardPool",
-			Handler:    _Query_RewardPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/rewards/v1beta1/query.proto",
+// QueryProjectedReleaseResponse defines the response structure for the
+// ProjectedRelease gRPC query.
+type QueryProjectedReleaseResponse struct {
+	// amount is the total amount projected to be released on each denom
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// schedules are the amounts projected for each schedule releasing on the
+	// period, the main schedule has no name
+	Schedules []ScheduleProjection `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedReleaseResponse) Reset()         { *m = QueryProjectedReleaseResponse{} }
+func (m *QueryProjectedReleaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedReleaseResponse) ProtoMessage()    {}
+func (*QueryProjectedReleaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{9}
+}
+func (m *QueryProjectedReleaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedReleaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedReleaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProjectedReleaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedReleaseResponse.Merge(m, src)
+}
+func (m *QueryProjectedReleaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedReleaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedReleaseResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryProjectedReleaseResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedReleaseResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProjectedReleaseResponse) GetSchedules() []ScheduleProjection {
+	if m != nil {
+		return m.Schedules
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduleProjection defines the amount projected to be released by a
+// schedule.
+type ScheduleProjection struct {
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ScheduleProjection) Reset()         { *m = ScheduleProjection{} }
+func (m *ScheduleProjection) String() string { return proto.CompactTextString(m) }
+func (*ScheduleProjection) ProtoMessage()    {}
+func (*ScheduleProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{10}
+}
+func (m *ScheduleProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ScheduleProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleProjection.Merge(m, src)
+}
+func (m *ScheduleProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleProjection.DiscardUnknown(m)
 }
 
-func (m *QueryReleaseScheduleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduleProjection proto.InternalMessageInfo
+
+func (m *ScheduleProjection) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryReleaseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduleProjection) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *QueryReleaseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryEstimatedStakingAPRRequest defines the request structure for the
+// EstimatedStakingAPR gRPC query.
+type QueryEstimatedStakingAPRRequest struct {
 }
 
-func (m *QueryReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimatedStakingAPRRequest) Reset()         { *m = QueryEstimatedStakingAPRRequest{} }
+func (m *QueryEstimatedStakingAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedStakingAPRRequest) ProtoMessage()    {}
+func (*QueryEstimatedStakingAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{11}
+}
+func (m *QueryEstimatedStakingAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedStakingAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedStakingAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEstimatedStakingAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedStakingAPRRequest.Merge(m, src)
+}
+func (m *QueryEstimatedStakingAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedStakingAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedStakingAPRRequest.DiscardUnknown(m)
 }
 
-func (m *QueryReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEstimatedStakingAPRRequest proto.InternalMessageInfo
+
+// QueryEstimatedStakingAPRResponse defines the response structure for the
+// EstimatedStakingAPR gRPC query.
+type QueryEstimatedStakingAPRResponse struct {
+	// apr is the estimated staking APR
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// annual_staking_rewards are the rewards projected to reach the stakers on
+	// the next year, after the community tax
+	AnnualStakingRewards types.Coin `protobuf:"bytes,2,opt,name=annual_staking_rewards,json=annualStakingRewards,proto3" json:"annual_staking_rewards"`
+	// total_bonded is the amount of bonded tokens
+	TotalBonded cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_bonded,json=totalBonded,proto3,customtype=cosmossdk.io/math.Int" json:"total_bonded"`
+	// community_tax is the distribution community tax
+	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
 }
 
-func (m *QueryReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReleaseSchedule.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEstimatedStakingAPRResponse) Reset()         { *m = QueryEstimatedStakingAPRResponse{} }
+func (m *QueryEstimatedStakingAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedStakingAPRResponse) ProtoMessage()    {}
+func (*QueryEstimatedStakingAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{12}
+}
+func (m *QueryEstimatedStakingAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedStakingAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedStakingAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEstimatedStakingAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedStakingAPRResponse.Merge(m, src)
+}
+func (m *QueryEstimatedStakingAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedStakingAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedStakingAPRResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEstimatedStakingAPRResponse proto.InternalMessageInfo
+
+func (m *QueryEstimatedStakingAPRResponse) GetAnnualStakingRewards() types.Coin {
+	if m != nil {
+		return m.AnnualStakingRewards
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReleaseHistoryRequest defines the request structure for the
+// ReleaseHistory gRPC query.
+type QueryReleaseHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryReleaseHistoryRequest) Reset()         { *m = QueryReleaseHistoryRequest{} }
+func (m *QueryReleaseHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseHistoryRequest) ProtoMessage()    {}
+func (*QueryReleaseHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{13}
+}
+func (m *QueryReleaseHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseHistoryRequest.Merge(m, src)
+}
+func (m *QueryReleaseHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryReleaseHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryReleaseHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryReleaseHistoryResponse defines the response structure for the
+// ReleaseHistory gRPC query.
+type QueryReleaseHistoryResponse struct {
+	Records []ReleaseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records" yaml:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryReleaseHistoryResponse) Reset()         { *m = QueryReleaseHistoryResponse{} }
+func (m *QueryReleaseHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseHistoryResponse) ProtoMessage()    {}
+func (*QueryReleaseHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{14}
+}
+func (m *QueryReleaseHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseHistoryResponse.Merge(m, src)
+}
+func (m *QueryReleaseHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseHistoryResponse) GetRecords() []ReleaseRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryReleaseHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRevenueContractRequest defines the request structure for the
+// RevenueContract gRPC query.
+type QueryRevenueContractRequest struct {
+	// contract is the address of the registered contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryRevenueContractRequest) Reset()         { *m = QueryRevenueContractRequest{} }
+func (m *QueryRevenueContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueContractRequest) ProtoMessage()    {}
+func (*QueryRevenueContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{15}
+}
+func (m *QueryRevenueContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevenueContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevenueContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevenueContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueContractRequest.Merge(m, src)
+}
+func (m *QueryRevenueContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevenueContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueContractRequest proto.InternalMessageInfo
+
+func (m *QueryRevenueContractRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// QueryRevenueContractResponse defines the response structure for the
+// RevenueContract gRPC query.
+type QueryRevenueContractResponse struct {
+	RevenueContract RevenueContract `protobuf:"bytes,1,opt,name=revenue_contract,json=revenueContract,proto3" json:"revenue_contract"`
+}
+
+func (m *QueryRevenueContractResponse) Reset()         { *m = QueryRevenueContractResponse{} }
+func (m *QueryRevenueContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevenueContractResponse) ProtoMessage()    {}
+func (*QueryRevenueContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{16}
+}
+func (m *QueryRevenueContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevenueContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevenueContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevenueContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevenueContractResponse.Merge(m, src)
+}
+func (m *QueryRevenueContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevenueContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevenueContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevenueContractResponse proto.InternalMessageInfo
+
+func (m *QueryRevenueContractResponse) GetRevenueContract() RevenueContract {
+	if m != nil {
+		return m.RevenueContract
+	}
+	return RevenueContract{}
+}
+
+// QueryClaimableRevenueRequest defines the request structure for the
+// ClaimableRevenue gRPC query.
+type QueryClaimableRevenueRequest struct {
+	// address is the withdraw address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryClaimableRevenueRequest) Reset()         { *m = QueryClaimableRevenueRequest{} }
+func (m *QueryClaimableRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRevenueRequest) ProtoMessage()    {}
+func (*QueryClaimableRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{17}
+}
+func (m *QueryClaimableRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRevenueRequest.Merge(m, src)
+}
+func (m *QueryClaimableRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRevenueRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryClaimableRevenueResponse defines the response structure for the
+// ClaimableRevenue gRPC query.
+type QueryClaimableRevenueResponse struct {
+	// amount is the revenue to be claimed
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryClaimableRevenueResponse) Reset()         { *m = QueryClaimableRevenueResponse{} }
+func (m *QueryClaimableRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRevenueResponse) ProtoMessage()    {}
+func (*QueryClaimableRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{18}
+}
+func (m *QueryClaimableRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRevenueResponse.Merge(m, src)
+}
+func (m *QueryClaimableRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.rewards.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.rewards.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryReleaseScheduleRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleRequest")
+	proto.RegisterType((*QueryReleaseScheduleResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolResponse")
+	proto.RegisterType((*QuerySchedulesRequest)(nil), "kiichain.rewards.v1beta1.QuerySchedulesRequest")
+	proto.RegisterType((*QuerySchedulesResponse)(nil), "kiichain.rewards.v1beta1.QuerySchedulesResponse")
+	proto.RegisterType((*QueryProjectedReleaseRequest)(nil), "kiichain.rewards.v1beta1.QueryProjectedReleaseRequest")
+	proto.RegisterType((*QueryProjectedReleaseResponse)(nil), "kiichain.rewards.v1beta1.QueryProjectedReleaseResponse")
+	proto.RegisterType((*ScheduleProjection)(nil), "kiichain.rewards.v1beta1.ScheduleProjection")
+	proto.RegisterType((*QueryEstimatedStakingAPRRequest)(nil), "kiichain.rewards.v1beta1.QueryEstimatedStakingAPRRequest")
+	proto.RegisterType((*QueryEstimatedStakingAPRResponse)(nil), "kiichain.rewards.v1beta1.QueryEstimatedStakingAPRResponse")
+	proto.RegisterType((*QueryReleaseHistoryRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseHistoryRequest")
+	proto.RegisterType((*QueryReleaseHistoryResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseHistoryResponse")
+	proto.RegisterType((*QueryRevenueContractRequest)(nil), "kiichain.rewards.v1beta1.QueryRevenueContractRequest")
+	proto.RegisterType((*QueryRevenueContractResponse)(nil), "kiichain.rewards.v1beta1.QueryRevenueContractResponse")
+	proto.RegisterType((*QueryClaimableRevenueRequest)(nil), "kiichain.rewards.v1beta1.QueryClaimableRevenueRequest")
+	proto.RegisterType((*QueryClaimableRevenueResponse)(nil), "kiichain.rewards.v1beta1.QueryClaimableRevenueResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/rewards/v1beta1/query.proto", fileDescriptor_12435df56ac62847)
+}
+
+var fileDescriptor_12435df56ac62847 = []byte{
+	// 1428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xdd, 0x6f, 0x14, 0x55,
+	0x14, 0xc0, 0x3b, 0x2d, 0x14, 0x7a, 0x8b, 0xb4, 0x5e, 0x4a, 0x59, 0x86, 0xb2, 0x5b, 0x47, 0x3e,
+	0x0a, 0x65, 0x77, 0x68, 0xf9, 0xa8, 0x92, 0x40, 0xc2, 0x16, 0x11, 0x8c, 0x31, 0x75, 0x0a, 0x26,
+	0xf2, 0xb2, 0xb9, 0x3b, 0x73, 0xbb, 0x1d, 0xbb, 0x33, 0x77, 0x99, 0xb9, 0x8b, 0x54, 0xc2, 0x8b,
+	0xd1, 0x57, 0x25, 0x31, 0x3e, 0xf9, 0xe2, 0xb3, 0x4f, 0x3e, 0x10, 0xe3, 0x8b, 0x89, 0x9a, 0x98,
+	0xf0, 0x26, 0xc1, 0xc4, 0x18, 0x1f, 0xc0, 0x80, 0xf1, 0x0f, 0xf0, 0x2f, 0x30, 0xf7, 0xde, 0x73,
+	0x67, 0x3f, 0xba, 0xd3, 0xe9, 0x12, 0xe2, 0xd3, 0xce, 0xcc, 0x3d, 0x1f, 0xbf, 0x73, 0xef, 0xb9,
+	0xe7, 0x9c, 0x45, 0x87, 0xd6, 0x7c, 0xdf, 0x5d, 0x25, 0x7e, 0x68, 0x47, 0xf4, 0x43, 0x12, 0x79,
+	0xb1, 0x7d, 0x6b, 0xae, 0x4a, 0x39, 0x99, 0xb3, 0x6f, 0x36, 0x69, 0xb4, 0x5e, 0x6a, 0x44, 0x8c,
+	0x33, 0x9c, 0xd3, 0x52, 0x25, 0x90, 0x2a, 0x81, 0x94, 0x39, 0x51, 0x63, 0x35, 0x26, 0x85, 0x6c,
+	0xf1, 0xa4, 0xe4, 0xcd, 0xa9, 0x1a, 0x63, 0xb5, 0x3a, 0xb5, 0x49, 0xc3, 0xb7, 0x49, 0x18, 0x32,
+	0x4e, 0xb8, 0xcf, 0xc2, 0x18, 0x56, 0x0b, 0xb0, 0x2a, 0xdf, 0xaa, 0xcd, 0x15, 0x9b, 0xfb, 0x01,
+	0x8d, 0x39, 0x09, 0x1a, 0x20, 0xb0, 0xdf, 0x65, 0x71, 0xc0, 0xe2, 0x8a, 0xb2, 0xab, 0x5e, 0x60,
+	0xe9, 0xb8, 0x7a, 0xb3, 0xab, 0x24, 0xa6, 0x0a, 0x31, 0x01, 0x6e, 0x90, 0x9a, 0x1f, 0x4a, 0x47,
+	0x20, 0x9b, 0x6f, 0x97, 0xd5, 0x52, 0x2e, 0xf3, 0xf5, 0x7a, 0x7a, 0xec, 0x7c, 0xbd, 0x41, 0xb5,
+	0xc7, 0xc3, 0xa9, 0x52, 0x0d, 0x12, 0x91, 0x40, 0x8b, 0x1d, 0x49, 0x15, 0x8b, 0xe8, 0x2d, 0x1a,
+	0x36, 0xa9, 0x92, 0xb3, 0x26, 0x10, 0x7e, 0x57, 0x60, 0x2f, 0x49, 0x65, 0x87, 0xde, 0x6c, 0xd2,
+	0x98, 0x5b, 0xd7, 0xd1, 0x9e, 0x8e, 0xaf, 0x71, 0x83, 0x85, 0x31, 0xc5, 0x17, 0xd0, 0xb0, 0x72,
+	0x92, 0x33, 0xa6, 0x8d, 0x99, 0xd1, 0xf9, 0xe9, 0x52, 0xda, 0x41, 0x94, 0x94, 0x66, 0x79, 0xdb,
+	0x83, 0xc7, 0x85, 0x01, 0x07, 0xb4, 0xac, 0x83, 0xe8, 0x80, 0x34, 0xeb, 0xd0, 0x3a, 0x25, 0x31,
+	0x5d, 0x76, 0x57, 0xa9, 0xd7, 0xac, 0x53, 0xed, 0xf5, 0x4b, 0x03, 0x4d, 0xf5, 0x5e, 0x07, 0xff,
+	0x4d, 0x34, 0x1e, 0xa9, 0xa5, 0x4a, 0x0c, 0x6b, 0x40, 0x72, 0x2c, 0x9d, 0xa4, 0xcb, 0x58, 0xb9,
+	0x20, 0x90, 0xfe, 0x7d, 0x5c, 0xd8, 0xb7, 0x4e, 0x82, 0xfa, 0x39, 0xab, 0xdb, 0xa0, 0xe5, 0x8c,
+	0x45, 0x9d, 0x1a, 0x56, 0x0e, 0x4d, 0x02, 0x96, 0xb0, 0xbc, 0xc4, 0x58, 0x3d, 0x21, 0x1e, 0x42,
+	0xfb, 0x36, 0x2c, 0x01, 0x2c, 0x41, 0xa3, 0x0a, 0xa5, 0xd2, 0x60, 0xac, 0x0e, 0x9c, 0x87, 0x36,
+	0xe3, 0xd4, 0x26, 0xca, 0x26, 0x20, 0x62, 0x8d, 0x98, 0x98, 0xb1, 0x1c, 0x14, 0x25, 0x72, 0xf8,
+	0x33, 0x03, 0x8d, 0xb9, 0x2c, 0x08, 0x7c, 0xce, 0xa9, 0x57, 0x59, 0x69, 0x86, 0x5e, 0x9c, 0x1b,
+	0x9c, 0x1e, 0x9a, 0x19, 0x9d, 0xdf, 0x5f, 0x82, 0x34, 0x15, 0xc9, 0x96, 0xb8, 0x58, 0x64, 0x7e,
+	0x58, 0x7e, 0x0b, 0x8c, 0x4f, 0x2a, 0xe3, 0x5d, 0xfa, 0xd6, 0x37, 0x4f, 0x0a, 0x33, 0x35, 0x9f,
+	0xaf, 0x36, 0xab, 0x25, 0x97, 0x05, 0x90, 0xed, 0xf0, 0x53, 0x8c, 0xbd, 0x35, 0x48, 0x46, 0x61,
+	0x2a, 0x76, 0x76, 0x27, 0xda, 0x97, 0x85, 0x32, 0xfe, 0xd4, 0x40, 0x68, 0x25, 0xa2, 0x14, 0x58,
+	0x86, 0x24, 0xcb, 0x54, 0x4f, 0x96, 0x4b, 0xd4, 0x95, 0x38, 0x57, 0x00, 0xe7, 0x65, 0x85, 0xd3,
+	0xd2, 0x16, 0x24, 0xb3, 0x5b, 0x20, 0x01, 0x43, 0xb1, 0x33, 0x22, 0x74, 0x25, 0x87, 0xd5, 0x44,
+	0x7b, 0xe5, 0xb1, 0xe8, 0x23, 0xd4, 0x89, 0x8d, 0x2f, 0x23, 0xd4, 0xba, 0x97, 0x70, 0x26, 0x47,
+	0x3a, 0xf8, 0x54, 0x9d, 0x69, 0xa5, 0x71, 0x4d, 0xa7, 0xa7, 0xd3, 0xa6, 0x89, 0x27, 0xd0, 0x76,
+	0x8f, 0x86, 0x2c, 0xc8, 0x0d, 0x4e, 0x1b, 0x33, 0x23, 0x8e, 0x7a, 0xb1, 0x7e, 0x30, 0xd0, 0x64,
+	0xb7, 0x5f, 0xc8, 0x86, 0x1b, 0x68, 0x44, 0x67, 0x98, 0xb8, 0x3d, 0x62, 0x5f, 0xac, 0xf4, 0x5c,
+	0x48, 0x92, 0x35, 0x07, 0xbb, 0x33, 0xae, 0x76, 0x27, 0x31, 0x61, 0x39, 0x2d, 0x73, 0xf8, 0xcd,
+	0x8e, 0xa0, 0x06, 0x65, 0x50, 0x47, 0x33, 0x83, 0x52, 0x60, 0xed, 0x51, 0x59, 0xdf, 0xe9, 0x0b,
+	0xb8, 0x14, 0xb1, 0x0f, 0xa8, 0xcb, 0xa9, 0x07, 0x97, 0x47, 0x6f, 0xdf, 0x45, 0x34, 0xb2, 0x12,
+	0xb1, 0xa0, 0x22, 0x2a, 0x24, 0xec, 0x9e, 0x59, 0x52, 0xe5, 0xb3, 0xa4, 0xcb, 0x67, 0xe9, 0x9a,
+	0x2e, 0x9f, 0xe5, 0x9d, 0x82, 0xfe, 0xde, 0x93, 0x82, 0xe1, 0xec, 0x14, 0x6a, 0x62, 0x01, 0x9f,
+	0x47, 0x3b, 0x38, 0x53, 0x06, 0x06, 0xfb, 0x30, 0x30, 0xcc, 0x99, 0x54, 0x4f, 0x36, 0x7e, 0xa8,
+	0x7d, 0xe3, 0x7f, 0x37, 0xd0, 0xc1, 0x14, 0x70, 0xd8, 0x7f, 0x17, 0x0d, 0x93, 0x80, 0x35, 0x43,
+	0x9e, 0x33, 0xb2, 0x2e, 0xc8, 0x49, 0xe1, 0xb4, 0xaf, 0x6b, 0x00, 0xa6, 0xf1, 0x52, 0xfb, 0x21,
+	0xab, 0x8b, 0x78, 0x22, 0xfb, 0x90, 0x81, 0xd9, 0x67, 0x21, 0x94, 0xcb, 0x96, 0x11, 0x8b, 0x20,
+	0xbc, 0x51, 0x0c, 0x63, 0xb4, 0x2d, 0x24, 0x70, 0x02, 0x23, 0x8e, 0x7c, 0xc6, 0x0b, 0x49, 0x80,
+	0x6a, 0x5b, 0x37, 0x09, 0x10, 0x8a, 0xb2, 0x12, 0xb7, 0x5e, 0x41, 0x05, 0xb9, 0x75, 0x6f, 0xc4,
+	0xdc, 0x0f, 0x08, 0xa7, 0xde, 0x32, 0x27, 0x6b, 0x7e, 0x58, 0xbb, 0xb8, 0xe4, 0xe8, 0x32, 0xf7,
+	0xcf, 0x20, 0x9a, 0x4e, 0x97, 0x81, 0x1d, 0x5e, 0x44, 0x43, 0xa4, 0x11, 0x29, 0xa6, 0xf2, 0x9c,
+	0x70, 0xf1, 0xe7, 0xe3, 0xc2, 0x01, 0x05, 0x11, 0x7b, 0x6b, 0x25, 0x9f, 0xd9, 0x01, 0xe1, 0xab,
+	0xa5, 0xb7, 0x69, 0x8d, 0xb8, 0xeb, 0x97, 0xa8, 0xfb, 0xe8, 0x7e, 0x11, 0x01, 0xe3, 0x25, 0xea,
+	0x3a, 0x42, 0x1b, 0x5f, 0x47, 0x93, 0x24, 0x0c, 0x9b, 0xa4, 0x5e, 0x89, 0x95, 0x87, 0x0a, 0xec,
+	0xda, 0x56, 0xa3, 0x9a, 0x50, 0xea, 0xc0, 0xa7, 0x6a, 0x6a, 0x8c, 0xdf, 0x41, 0xbb, 0x38, 0xe3,
+	0xa4, 0x5e, 0xa9, 0xb2, 0xd0, 0xa3, 0x9e, 0x4a, 0x9e, 0xf2, 0x2c, 0x40, 0xee, 0xdd, 0x08, 0x79,
+	0x35, 0xe4, 0x6d, 0x78, 0x57, 0x43, 0xee, 0x8c, 0x4a, 0x03, 0x65, 0xa9, 0x8f, 0xdf, 0x43, 0x2f,
+	0x89, 0xca, 0xd7, 0x0c, 0x7d, 0xbe, 0x5e, 0xe1, 0xe4, 0x76, 0x6e, 0xdb, 0xf3, 0x46, 0xbd, 0x2b,
+	0xb1, 0x73, 0x8d, 0xdc, 0xb6, 0x3c, 0x64, 0xb6, 0x37, 0xc0, 0x2b, 0x7e, 0xcc, 0x59, 0xb4, 0x0e,
+	0xc7, 0xf0, 0xa2, 0x8a, 0x97, 0xf5, 0x93, 0x81, 0x0e, 0xf4, 0x74, 0x03, 0x27, 0xf9, 0x3e, 0xda,
+	0x11, 0x51, 0x97, 0x89, 0x5d, 0x57, 0x97, 0xe5, 0x68, 0x66, 0x77, 0x75, 0xa4, 0x7c, 0x79, 0x12,
+	0xca, 0xd5, 0x6e, 0xdd, 0xb8, 0xa4, 0x15, 0xcb, 0xd1, 0xf6, 0x5e, 0x5c, 0xa9, 0x5a, 0x4e, 0x42,
+	0x90, 0xd3, 0xcc, 0x22, 0x0b, 0x79, 0x44, 0x5c, 0xae, 0xb7, 0xea, 0x34, 0xda, 0xe9, 0xc2, 0x27,
+	0xc8, 0xc8, 0xdc, 0xa3, 0xfb, 0xc5, 0x09, 0x70, 0x74, 0xd1, 0xf3, 0x22, 0x1a, 0xc7, 0xcb, 0x3c,
+	0x12, 0x39, 0x92, 0x48, 0x5a, 0x1f, 0xa1, 0xa9, 0xde, 0x46, 0x93, 0x22, 0x3e, 0x0e, 0xd3, 0x53,
+	0xa5, 0xc3, 0x7a, 0xc6, 0xfc, 0xd1, 0x61, 0x0c, 0xf2, 0x74, 0x2c, 0xea, 0xfc, 0x6c, 0x39, 0xe0,
+	0x7b, 0xb1, 0x4e, 0xfc, 0x80, 0x54, 0xc5, 0xd4, 0x23, 0x05, 0x74, 0x44, 0xf3, 0x68, 0x07, 0x51,
+	0xd8, 0x99, 0x01, 0x69, 0x41, 0xeb, 0x13, 0x5d, 0x16, 0x37, 0x1a, 0xfd, 0x1f, 0xcb, 0xe2, 0xfc,
+	0xaf, 0xbb, 0xd0, 0x76, 0x89, 0x81, 0x3f, 0x37, 0xd0, 0xb0, 0x9a, 0x0c, 0xf1, 0x26, 0x85, 0x71,
+	0xe3, 0x40, 0x6a, 0x16, 0xb7, 0x28, 0xad, 0xc2, 0xb2, 0x66, 0x3e, 0xfe, 0xed, 0xef, 0x2f, 0x06,
+	0x2d, 0x3c, 0x6d, 0x67, 0x4c, 0xcb, 0xf8, 0xbe, 0x81, 0xc6, 0xba, 0x26, 0x44, 0x7c, 0x26, 0xc3,
+	0x59, 0xef, 0xf1, 0xd5, 0x3c, 0xdb, 0xaf, 0x1a, 0xc0, 0xce, 0x4b, 0xd8, 0x13, 0xf8, 0xb8, 0xbd,
+	0xc9, 0xcc, 0x2e, 0x55, 0x8b, 0xba, 0x31, 0xe0, 0xaf, 0x0d, 0x84, 0x5a, 0x03, 0x23, 0x3e, 0x99,
+	0xe9, 0xba, 0x6b, 0x72, 0x35, 0xe7, 0xfa, 0xd0, 0x00, 0xce, 0xa2, 0xe4, 0x3c, 0x8a, 0x0f, 0x6f,
+	0xc6, 0x29, 0xde, 0x8b, 0x62, 0x52, 0xc5, 0x5f, 0x19, 0x68, 0x64, 0x39, 0x99, 0x51, 0xec, 0x0c,
+	0x7f, 0xdd, 0x93, 0x9a, 0x79, 0x72, 0xeb, 0x0a, 0xc0, 0x37, 0x2b, 0xf9, 0x0e, 0xe3, 0x57, 0xd3,
+	0xf9, 0x5a, 0x33, 0xd3, 0xf7, 0x06, 0x1a, 0xef, 0x1e, 0x16, 0x70, 0xd6, 0x09, 0xa6, 0x8c, 0x45,
+	0xe6, 0x42, 0xdf, 0x7a, 0x80, 0x7c, 0x4a, 0x22, 0x17, 0xf1, 0xec, 0x26, 0x79, 0xaa, 0x75, 0x8b,
+	0x90, 0x04, 0xf8, 0x17, 0x03, 0xed, 0xe9, 0xd1, 0x88, 0xf1, 0xeb, 0x19, 0x14, 0xe9, 0x0d, 0xde,
+	0x3c, 0xf7, 0x3c, 0xaa, 0x10, 0xc3, 0x82, 0x8c, 0x61, 0x0e, 0xdb, 0xe9, 0x31, 0x50, 0xad, 0x5e,
+	0x84, 0xae, 0x5e, 0x14, 0xbd, 0xfe, 0x5b, 0x03, 0xed, 0xee, 0xec, 0x40, 0xf8, 0xf4, 0xd6, 0xae,
+	0x50, 0x67, 0x5f, 0x34, 0xcf, 0xf4, 0xa9, 0x05, 0xe0, 0x73, 0x12, 0x7c, 0x16, 0x1f, 0xcb, 0xbe,
+	0x77, 0xab, 0xc0, 0xf7, 0xa3, 0xac, 0x16, 0x1d, 0x85, 0x7b, 0x0b, 0xd5, 0xa2, 0x57, 0x87, 0x32,
+	0xcf, 0xf6, 0xab, 0x06, 0xd4, 0x17, 0x24, 0xf5, 0x6b, 0xf8, 0xac, 0x9d, 0xf5, 0x0f, 0xbf, 0xa8,
+	0x7b, 0x54, 0x6c, 0xdf, 0xd1, 0x8f, 0x77, 0xf1, 0xcf, 0x06, 0x1a, 0xef, 0x6e, 0x07, 0x99, 0x89,
+	0x9f, 0xd2, 0x94, 0xcc, 0x85, 0xbe, 0xf5, 0x20, 0x8a, 0xf3, 0x32, 0x8a, 0x05, 0x7c, 0x26, 0x3d,
+	0x0a, 0x57, 0xeb, 0x16, 0x21, 0x1e, 0xfb, 0x0e, 0xf4, 0xb5, 0xbb, 0xe5, 0xcb, 0x0f, 0x9e, 0xe6,
+	0x8d, 0x87, 0x4f, 0xf3, 0xc6, 0x5f, 0x4f, 0xf3, 0xc6, 0xbd, 0x67, 0xf9, 0x81, 0x87, 0xcf, 0xf2,
+	0x03, 0x7f, 0x3c, 0xcb, 0x0f, 0xdc, 0x38, 0xd1, 0xd6, 0x9d, 0x12, 0xd3, 0xc9, 0xc3, 0xed, 0xc4,
+	0x8b, 0xec, 0x53, 0xd5, 0x61, 0xf9, 0x9f, 0xe3, 0xd4, 0x7f, 0x03, 0x00, 0x36, 0x8e, 0x35, 0xc1,
+	0x77, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the reward module's
+	// parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ReleaseSchedule defines a gRPC query method for fetching
+	// ReleaseSchedule data.
+	ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error)
+	// RewardPool defines a gRPC query method for fetching
+	// RewardPool data.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// Schedules defines a gRPC query method for listing the named release
+	// schedules.
+	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// ProjectedRelease defines a gRPC query method for projecting the rewards
+	// released by the active schedules between two times.
+	ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error)
+	// EstimatedStakingAPR defines a gRPC query method for estimating the
+	// staking APR given by the rewards projected for the next year.
+	EstimatedStakingAPR(ctx context.Context, in *QueryEstimatedStakingAPRRequest, opts ...grpc.CallOption) (*QueryEstimatedStakingAPRResponse, error)
+	// ReleaseHistory defines a gRPC query method for listing the records of the
+	// release history.
+	ReleaseHistory(ctx context.Context, in *QueryReleaseHistoryRequest, opts ...grpc.CallOption) (*QueryReleaseHistoryResponse, error)
+	// RevenueContract defines a gRPC query method for fetching a contract
+	// registered for the developer revenue.
+	RevenueContract(ctx context.Context, in *QueryRevenueContractRequest, opts ...grpc.CallOption) (*QueryRevenueContractResponse, error)
+	// ClaimableRevenue defines a gRPC query method for fetching the developer
+	// revenue accrued to a withdraw address.
+	ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error) {
+	out := new(QueryReleaseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error) {
+	out := new(QuerySchedulesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Schedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRelease(ctx context.Context, in *QueryProjectedReleaseRequest, opts ...grpc.CallOption) (*QueryProjectedReleaseResponse, error) {
+	out := new(QueryProjectedReleaseResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ProjectedRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedStakingAPR(ctx context.Context, in *QueryEstimatedStakingAPRRequest, opts ...grpc.CallOption) (*QueryEstimatedStakingAPRResponse, error) {
+	out := new(QueryEstimatedStakingAPRResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/EstimatedStakingAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseHistory(ctx context.Context, in *QueryReleaseHistoryRequest, opts ...grpc.CallOption) (*QueryReleaseHistoryResponse, error) {
+	out := new(QueryReleaseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevenueContract(ctx context.Context, in *QueryRevenueContractRequest, opts ...grpc.CallOption) (*QueryRevenueContractResponse, error) {
+	out := new(QueryRevenueContractResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/RevenueContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error) {
+	out := new(QueryClaimableRevenueResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ClaimableRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the reward module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ReleaseSchedule defines a gRPC query method for fetching
+	// ReleaseSchedule data.
+	ReleaseSchedule(context.Context, *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error)
+	// RewardPool defines a gRPC query method for fetching
+	// RewardPool data.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// Schedules defines a gRPC query method for listing the named release
+	// schedules.
+	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// ProjectedRelease defines a gRPC query method for projecting the rewards
+	// released by the active schedules between two times.
+	ProjectedRelease(context.Context, *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error)
+	// EstimatedStakingAPR defines a gRPC query method for estimating the
+	// staking APR given by the rewards projected for the next year.
+	EstimatedStakingAPR(context.Context, *QueryEstimatedStakingAPRRequest) (*QueryEstimatedStakingAPRResponse, error)
+	// ReleaseHistory defines a gRPC query method for listing the records of the
+	// release history.
+	ReleaseHistory(context.Context, *QueryReleaseHistoryRequest) (*QueryReleaseHistoryResponse, error)
+	// RevenueContract defines a gRPC query method for fetching a contract
+	// registered for the developer revenue.
+	RevenueContract(context.Context, *QueryRevenueContractRequest) (*QueryRevenueContractResponse, error)
+	// ClaimableRevenue defines a gRPC query method for fetching the developer
+	// revenue accrued to a withdraw address.
+	ClaimableRevenue(context.Context, *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseSchedule(ctx context.Context, req *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedRelease(ctx context.Context, req *QueryProjectedReleaseRequest) (*QueryProjectedReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRelease not implemented")
+}
+func (*UnimplementedQueryServer) EstimatedStakingAPR(ctx context.Context, req *QueryEstimatedStakingAPRRequest) (*QueryEstimatedStakingAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedStakingAPR not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseHistory(ctx context.Context, req *QueryReleaseHistoryRequest) (*QueryReleaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHistory not implemented")
+}
+func (*UnimplementedQueryServer) RevenueContract(ctx context.Context, req *QueryRevenueContractRequest) (*QueryRevenueContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevenueContract not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableRevenue(ctx context.Context, req *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRevenue not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseSchedule(ctx, req.(*QueryReleaseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/Schedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedules(ctx, req.(*QuerySchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ProjectedRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRelease(ctx, req.(*QueryProjectedReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedStakingAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedStakingAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedStakingAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/EstimatedStakingAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedStakingAPR(ctx, req.(*QueryEstimatedStakingAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseHistory(ctx, req.(*QueryReleaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevenueContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevenueContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevenueContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/RevenueContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevenueContract(ctx, req.(*QueryRevenueContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ClaimableRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRevenue(ctx, req.(*QueryClaimableRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.rewards.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ReleaseSchedule",
+			Handler:    _Query_ReleaseSchedule_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "Schedules",
+			Handler:    _Query_Schedules_Handler,
+		},
+		{
+			MethodName: "ProjectedRelease",
+			Handler:    _Query_ProjectedRelease_Handler,
+		},
+		{
+			MethodName: "EstimatedStakingAPR",
+			Handler:    _Query_EstimatedStakingAPR_Handler,
+		},
+		{
+			MethodName: "ReleaseHistory",
+			Handler:    _Query_ReleaseHistory_Handler,
+		},
+		{
+			MethodName: "RevenueContract",
+			Handler:    _Query_RevenueContract_Handler,
+		},
+		{
+			MethodName: "ClaimableRevenue",
+			Handler:    _Query_ClaimableRevenue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/rewards/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReleaseSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FreeFunds) > 0 {
+		for iNdEx := len(m.FreeFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreeFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommittedFunds) > 0 {
+		for iNdEx := len(m.CommittedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommittedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedReleaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedReleaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedReleaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ToTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FromTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedReleaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedReleaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedReleaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedStakingAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedStakingAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedStakingAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedStakingAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedStakingAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedStakingAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalBonded.Size()
+		i -= size
+		if _, err := m.TotalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AnnualStakingRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevenueContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenueContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenueContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevenueContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenueContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenueContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RevenueContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReleaseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleaseSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CommittedFunds) > 0 {
+		for _, e := range m.CommittedFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FreeFunds) > 0 {
+		for _, e := range m.FreeFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedReleaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FromTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ToTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedReleaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimatedStakingAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEstimatedStakingAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualStakingRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBonded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RevenueContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommittedFunds = append(m.CommittedFunds, types.Coin{})
+			if err := m.CommittedFunds[len(m.CommittedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreeFunds = append(m.FreeFunds, types.DecCoin{})
+			if err := m.FreeFunds[len(m.FreeFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, Schedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedReleaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedReleaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedReleaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ToTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedReleaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedReleaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedReleaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, ScheduleProjection{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedStakingAPRRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedStakingAPRRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedStakingAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedStakingAPRResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedStakingAPRResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedStakingAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualStakingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualStakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryReleaseScheduleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryReleaseScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ReleaseSchedule.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryRewardPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryRewardPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RewardPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReleaseHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,12 +3858,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReleaseHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReleaseHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -789,7 +3921,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReleaseHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -812,15 +3944,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReleaseHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReleaseHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -847,7 +3979,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ReleaseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -872,7 +4041,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevenueContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -895,12 +4064,44 @@ func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseScheduleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevenueContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevenueContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -922,7 +4123,7 @@ func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRevenueContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -945,15 +4146,15 @@ func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevenueContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevenueContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueContract", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -980,7 +4181,7 @@ func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleaseSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RevenueContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1005,7 +4206,7 @@ func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRevenueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1028,12 +4229,44 @@ func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRevenueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1055,7 +4288,7 @@ func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRevenueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +4311,15 @@ func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRevenueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1113,7 +4346,8 @@ func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
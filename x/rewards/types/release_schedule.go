@@ -18,16 +18,53 @@ func InitialReleaseSchedule() ReleaseSchedule {
 	}
 }
 
+// GetCommittedFunds returns the amt the schedule still has to release from the pool
+// Inactive schedules release nothing
+func (rr ReleaseSchedule) GetCommittedFunds() sdk.Coins {
+	if !rr.Active || rr.TotalAmount.Amount.IsNil() {
+		return sdk.NewCoins()
+	}
+
+	remaining := rr.TotalAmount.Amount
+	if !rr.ReleasedAmount.Amount.IsNil() {
+		remaining = remaining.Sub(rr.ReleasedAmount.Amount)
+	}
+	if !remaining.IsPositive() {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewCoin(rr.TotalAmount.Denom, remaining))
+}
+
+// IsOverReleased returns if the schedule released more than its total amt
+func (rr ReleaseSchedule) IsOverReleased() bool {
+	if rr.ReleasedAmount.Amount.IsNil() || rr.ReleasedAmount.IsZero() {
+		return false
+	}
+	if rr.TotalAmount.Amount.IsNil() || rr.ReleasedAmount.Denom != rr.TotalAmount.Denom {
+		return true
+	}
+	return rr.ReleasedAmount.Amount.GT(rr.TotalAmount.Amount)
+}
+
+// Shift moves the end, the last release and the curve of the schedule by a duration
+func (rr ReleaseSchedule) Shift(duration time.Duration) ReleaseSchedule {
+	rr.EndTime = rr.EndTime.Add(duration)
+	rr.LastReleaseTime = rr.LastReleaseTime.Add(duration)
+	rr.Curve = rr.Curve.Shift(duration)
+	return rr
+}
+
 // ValidateGenesis validates the release schedule for a genesis state
 func (rr ReleaseSchedule) ValidateGenesis() error {
-	// Validate EndTime (zero time is allowed for genesis)
-	if !rr.EndTime.IsZero() && rr.EndTime.Before(time.Now()) {
-		return fmt.Errorf("end time %s cannot be in the past", rr.EndTime.String())
+	// The times aren't checked against the clock, the genesis has no block time and is exported after the schedule starts
+	// Validate LastReleaseTime (zero end time is allowed for genesis)
+	if !rr.EndTime.IsZero() && rr.LastReleaseTime.After(rr.EndTime) {
+		return fmt.Errorf("last release time %s cannot be after end time %s", rr.LastReleaseTime, rr.EndTime)
 	}
 
-	// Validate LastReleaseTime
-	if rr.LastReleaseTime.After(time.Now()) {
-		return fmt.Errorf("last release time %s cannot be in the future", rr.EndTime.String())
+	// Validate the destinations
+	if err := ValidateReleaseDestinations(rr.Destinations); err != nil {
+		return fmt.Errorf("invalid destinations: %w", err)
 	}
 
 	// Some validations just make sense if active
@@ -42,6 +79,10 @@ func (rr ReleaseSchedule) ValidateGenesis() error {
 		if rr.EndTime.IsZero() {
 			return fmt.Errorf("active reward releaser must have an end time")
 		}
+		// Validate the curve
+		if err := rr.Curve.Validate(rr.EndTime); err != nil {
+			return fmt.Errorf("invalid curve: %w", err)
+		}
 		// Validate ReleasedAmount if not zero
 		if !rr.ReleasedAmount.IsZero() {
 			if err := rr.ReleasedAmount.Validate(); err != nil {
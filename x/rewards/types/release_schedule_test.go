@@ -89,19 +89,18 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 			errMsg:  "cannot be greater than total amount",
 		},
 		{
-			name: "end time in past",
+			name: "end time in past of the clock",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
 				ReleasedAmount:  sdk.Coin{},
 				EndTime:         now.Add(-time.Hour * 24),
-				LastReleaseTime: time.Time{},
+				LastReleaseTime: now.Add(-time.Hour * 48),
 				Active:          false,
 			},
-			wantErr: true,
-			errMsg:  "cannot be in the past",
+			wantErr: false,
 		},
 		{
-			name: "last release in future",
+			name: "last release in future of the clock",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
 				ReleasedAmount:  sdk.Coin{},
@@ -109,8 +108,19 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 				LastReleaseTime: now.Add(time.Hour * 24),
 				Active:          false,
 			},
+			wantErr: false,
+		},
+		{
+			name: "last release after end time",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     validCoin,
+				ReleasedAmount:  sdk.Coin{},
+				EndTime:         now.Add(time.Hour * 24),
+				LastReleaseTime: now.Add(time.Hour * 48),
+				Active:          false,
+			},
 			wantErr: true,
-			errMsg:  "cannot be in the future",
+			errMsg:  "cannot be after end time",
 		},
 		{
 			name: "active with zero total",
@@ -152,3 +162,74 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseScheduleGetCommittedFunds(t *testing.T) {
+	schedule := types.ReleaseSchedule{
+		TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount: sdk.NewCoin("akii", math.NewInt(400)),
+		EndTime:        time.Now().Add(time.Hour),
+		Active:         true,
+	}
+
+	// The remaining amount is committed
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(600))), schedule.GetCommittedFunds())
+
+	// Inactive schedules commit nothing
+	schedule.Active = false
+	require.True(t, schedule.GetCommittedFunds().IsZero())
+
+	// Empty schedules commit nothing
+	require.True(t, types.InitialReleaseSchedule().GetCommittedFunds().IsZero())
+}
+
+func TestReleaseScheduleIsOverReleased(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule types.ReleaseSchedule
+		expected bool
+	}{
+		{
+			name:     "initial schedule",
+			schedule: types.InitialReleaseSchedule(),
+			expected: false,
+		},
+		{
+			name: "partially released",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+				ReleasedAmount: sdk.NewCoin("akii", math.NewInt(400)),
+			},
+			expected: false,
+		},
+		{
+			name: "fully released",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+				ReleasedAmount: sdk.NewCoin("akii", math.NewInt(1000)),
+			},
+			expected: false,
+		},
+		{
+			name: "released over the total",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+				ReleasedAmount: sdk.NewCoin("akii", math.NewInt(1001)),
+			},
+			expected: true,
+		},
+		{
+			name: "released another denom",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+				ReleasedAmount: sdk.NewCoin("uatom", math.NewInt(1)),
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.schedule.IsOverReleased())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRevenueContract returns a new contract registered for the developer revenue
+func NewRevenueContract(contract, admin, withdrawAddress sdk.AccAddress) RevenueContract {
+	return RevenueContract{
+		Contract:        contract.String(),
+		Admin:           admin.String(),
+		WithdrawAddress: withdrawAddress.String(),
+	}
+}
+
+// Validate validates a contract registered for the developer revenue
+func (rc RevenueContract) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(rc.Contract); err != nil {
+		return fmt.Errorf("invalid revenue contract %s: %w", rc.Contract, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(rc.Admin); err != nil {
+		return fmt.Errorf("invalid admin for revenue contract %s: %w", rc.Contract, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(rc.WithdrawAddress); err != nil {
+		return fmt.Errorf("invalid withdraw address for revenue contract %s: %w", rc.Contract, err)
+	}
+	return nil
+}
+
+// NewClaimableRevenue returns the developer revenue accrued to a withdraw address
+func NewClaimableRevenue(address sdk.AccAddress, amount sdk.Coins) ClaimableRevenue {
+	return ClaimableRevenue{
+		Address: address.String(),
+		Amount:  amount,
+	}
+}
+
+// Validate validates the developer revenue accrued to a withdraw address
+func (cr ClaimableRevenue) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cr.Address); err != nil {
+		return fmt.Errorf("invalid claimable revenue address %s: %w", cr.Address, err)
+	}
+	if err := cr.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid claimable revenue for %s: %w", cr.Address, err)
+	}
+	return nil
+}
+
+// SplitRevenue splits the developer revenue between the contracts by the fees paid for their executions
+// Each contract gets the truncated share of its fees and the remainder goes to the first contract
+func SplitRevenue(amount sdk.Coin, feesPaid []math.Int) []sdk.Coin {
+	return splitByWeights(amount, feesPaid)
+}
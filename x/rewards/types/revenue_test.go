@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestRevenueContractValidate(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	admin := sdk.AccAddress([]byte("admin_______________"))
+
+	// A valid registration
+	require.NoError(t, types.NewRevenueContract(contract, admin, admin).Validate())
+
+	// Invalid addresses are rejected
+	invalid := types.NewRevenueContract(contract, admin, admin)
+	invalid.WithdrawAddress = "invalid"
+	require.Error(t, invalid.Validate())
+
+	invalid = types.NewRevenueContract(contract, admin, admin)
+	invalid.Contract = ""
+	require.Error(t, invalid.Validate())
+}
+
+func TestClaimableRevenueValidate(t *testing.T) {
+	address := sdk.AccAddress([]byte("withdraw____________"))
+
+	// A valid claimable revenue
+	revenue := types.NewClaimableRevenue(address, sdk.NewCoins(sdk.NewInt64Coin("akii", 100)))
+	require.NoError(t, revenue.Validate())
+
+	// Invalid address and amount are rejected
+	require.Error(t, types.ClaimableRevenue{Address: "invalid"}.Validate())
+	require.Error(t, types.ClaimableRevenue{
+		Address: address.String(),
+		Amount:  sdk.Coins{sdk.Coin{Denom: "akii", Amount: math.NewInt(-1)}},
+	}.Validate())
+}
+
+func TestSplitRevenue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   sdk.Coin
+		feesPaid []math.Int
+		expected []sdk.Coin
+	}{
+		{
+			name:     "single contract",
+			amount:   sdk.NewInt64Coin("akii", 100),
+			feesPaid: []math.Int{math.NewInt(50_000)},
+			expected: []sdk.Coin{sdk.NewInt64Coin("akii", 100)},
+		},
+		{
+			name:     "split by fees paid",
+			amount:   sdk.NewInt64Coin("akii", 100),
+			feesPaid: []math.Int{math.NewInt(30_000), math.NewInt(10_000)},
+			expected: []sdk.Coin{sdk.NewInt64Coin("akii", 75), sdk.NewInt64Coin("akii", 25)},
+		},
+		{
+			name:     "remainder goes to the first contract",
+			amount:   sdk.NewInt64Coin("akii", 100),
+			feesPaid: []math.Int{math.OneInt(), math.OneInt(), math.OneInt()},
+			expected: []sdk.Coin{sdk.NewInt64Coin("akii", 34), sdk.NewInt64Coin("akii", 33), sdk.NewInt64Coin("akii", 33)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.SplitRevenue(tc.amount, tc.feesPaid))
+		})
+	}
+}
@@ -18,6 +18,11 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 		return remaining, nil
 	}
 
+	// Non-linear curves release up to the fraction of the total amount given by the curve
+	if schedule.Curve.Type != CurveTypeLinear {
+		return calculateCurveReward(blockTime, schedule, remaining), nil
+	}
+
 	// If total duration would be 0, there would be a div by 0
 	if schedule.EndTime.Equal(schedule.LastReleaseTime) {
 		return sdk.Coin{}, fmt.Errorf("end time is equal to last release and would do a division by 0. EndTime: %s", schedule.EndTime)
@@ -43,3 +48,20 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 
 	return sdk.NewCoin(schedule.TotalAmount.Denom, amountToRelease), nil
 }
+
+// calculateCurveReward figures the amt released by a non-linear curve in the current block
+// The curve gives the total released up to the block time, the amt already released is deducted
+func calculateCurveReward(blockTime time.Time, schedule ReleaseSchedule, remaining sdk.Coin) sdk.Coin {
+	// Calculate the amount the curve released until now
+	fraction := schedule.Curve.ReleasedFraction(blockTime, schedule.EndTime)
+	target := math.LegacyNewDecFromInt(schedule.TotalAmount.Amount).Mul(fraction).TruncateInt()
+
+	// Deduct the released amount and cap at remaining amount
+	amountToRelease := target.Sub(schedule.ReleasedAmount.Amount)
+	if !amountToRelease.IsPositive() {
+		return sdk.NewCoin(schedule.TotalAmount.Denom, math.ZeroInt())
+	}
+	amountToRelease = math.MinInt(amountToRelease, remaining.Amount)
+
+	return sdk.NewCoin(schedule.TotalAmount.Denom, amountToRelease)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,3 +24,15 @@ func (rp RewardPool) ValidateGenesis() error {
 
 	return nil
 }
+
+// GetFreeFunds returns the pool funds not committed to the schedules
+func (rp RewardPool) GetFreeFunds(committed sdk.Coins) sdk.DecCoins {
+	free := sdk.NewDecCoins()
+	for _, coin := range rp.CommunityPool {
+		amount := coin.Amount.Sub(math.LegacyNewDecFromInt(committed.AmountOf(coin.Denom)))
+		if amount.IsPositive() {
+			free = free.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return free
+}
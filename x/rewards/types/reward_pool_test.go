@@ -19,3 +19,18 @@ func TestRewardPoolValidateGenesis(t *testing.T) {
 	rp2 := types.RewardPool{CommunityPool: sdk.DecCoins{{Denom: "tkii", Amount: math.LegacyNewDec(-1)}}}
 	require.NotNil(t, rp2.ValidateGenesis())
 }
+
+func TestRewardPoolGetFreeFunds(t *testing.T) {
+	rp := types.RewardPool{CommunityPool: sdk.NewDecCoins(
+		sdk.NewDecCoin("akii", math.NewInt(1000)),
+		sdk.NewDecCoin("tkii", math.NewInt(500)),
+	)}
+
+	// Committed funds are deducted
+	free := rp.GetFreeFunds(sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(400))))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("akii", math.NewInt(600)), sdk.NewDecCoin("tkii", math.NewInt(500))), free)
+
+	// Fully committed denoms have no free funds
+	free = rp.GetFreeFunds(sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1500))))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin("tkii", math.NewInt(500))), free)
+}
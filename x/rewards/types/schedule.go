@@ -0,0 +1,63 @@
+package types
+
+import (
+	fmt "fmt"
+	"regexp"
+	time "time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// scheduleNameRegex defines the allowed schedule names
+var scheduleNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// NewSchedule returns a new active named schedule
+func NewSchedule(name string, totalAmount sdk.Coin, startTime, endTime time.Time, destinations []ReleaseDestination) Schedule {
+	return Schedule{
+		Name: name,
+		Release: ReleaseSchedule{
+			TotalAmount:    totalAmount,
+			ReleasedAmount: sdk.NewCoin(totalAmount.Denom, math.ZeroInt()),
+			EndTime:        endTime,
+			Active:         true,
+			Destinations:   destinations,
+		},
+		StartTime: startTime,
+	}
+}
+
+// ValidateScheduleName checks if the schedule name is valid
+func ValidateScheduleName(name string) error {
+	if !scheduleNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid schedule name %q, must have 1 to 64 alphanumeric, '-' or '_' characters", name)
+	}
+
+	return nil
+}
+
+// ValidateGenesis validates the named schedule for a genesis state
+func (s Schedule) ValidateGenesis() error {
+	// Validate the name
+	if err := ValidateScheduleName(s.Name); err != nil {
+		return err
+	}
+
+	// The release must start before it ends
+	if !s.StartTime.IsZero() && !s.Release.EndTime.IsZero() && !s.StartTime.Before(s.Release.EndTime) {
+		return fmt.Errorf("start time %s of schedule %s must be before the end time %s", s.StartTime, s.Name, s.Release.EndTime)
+	}
+
+	// Validate the release
+	if err := s.Release.ValidateGenesis(); err != nil {
+		return fmt.Errorf("invalid release for schedule %s: %w", s.Name, err)
+	}
+
+	return nil
+}
+
+// IsReleasing checks if the schedule releases rewards at the block time
+func (s Schedule) IsReleasing(blockTime time.Time) bool {
+	return s.Release.Active && !s.Paused && !blockTime.Before(s.StartTime)
+}
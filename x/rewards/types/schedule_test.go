@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v5/x/rewards/types"
+)
+
+func TestValidateScheduleName(t *testing.T) {
+	testCases := []struct {
+		name      string
+		schedule  string
+		expectErr bool
+	}{
+		{name: "valid name", schedule: "ecosystem_grants-2025"},
+		{name: "empty name", schedule: "", expectErr: true},
+		{name: "invalid characters", schedule: "eco system", expectErr: true},
+		{name: "too long", schedule: strings.Repeat("a", 65), expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateScheduleName(tc.schedule)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleValidateGenesis(t *testing.T) {
+	now := time.Now()
+	amount := sdk.NewCoin("akii", math.NewInt(1000))
+	destinations := []types.ReleaseDestination{
+		types.NewReleaseDestination(types.DestinationTypeAddress, authtypes.NewModuleAddress("destination").String(), 1),
+	}
+
+	testCases := []struct {
+		name      string
+		schedule  types.Schedule
+		expectErr bool
+	}{
+		{
+			name:     "valid schedule",
+			schedule: types.NewSchedule("ecosystem", amount, now, now.Add(time.Hour), destinations),
+		},
+		{
+			name:      "invalid name",
+			schedule:  types.NewSchedule("", amount, now, now.Add(time.Hour), destinations),
+			expectErr: true,
+		},
+		{
+			name: "invalid destination",
+			schedule: types.NewSchedule("ecosystem", amount, now, now.Add(time.Hour), []types.ReleaseDestination{
+				types.NewReleaseDestination(types.DestinationTypeAddress, "invalid", 1),
+			}),
+			expectErr: true,
+		},
+		{
+			name:      "start after end",
+			schedule:  types.NewSchedule("ecosystem", amount, now.Add(2*time.Hour), now.Add(time.Hour), destinations),
+			expectErr: true,
+		},
+		{
+			name:      "zero total amount",
+			schedule:  types.NewSchedule("ecosystem", sdk.NewCoin("akii", math.ZeroInt()), now, now.Add(time.Hour), destinations),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.ValidateGenesis()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleIsReleasing(t *testing.T) {
+	now := time.Now()
+	schedule := types.NewSchedule("ecosystem", sdk.NewCoin("akii", math.NewInt(1000)), now, now.Add(time.Hour), nil)
+
+	// Not started yet
+	require.False(t, schedule.IsReleasing(now.Add(-time.Second)))
+	// Started
+	require.True(t, schedule.IsReleasing(now))
+
+	// Paused
+	schedule.Paused = true
+	require.False(t, schedule.IsReleasing(now.Add(time.Minute)))
+
+	// Inactive
+	schedule.Paused = false
+	schedule.Release.Active = false
+	require.False(t, schedule.IsReleasing(now.Add(time.Minute)))
+}
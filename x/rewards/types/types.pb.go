@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +31,84 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DestinationType defines where a part of the released rewards goes
+type DestinationType int32
+
+const (
+	// DESTINATION_TYPE_FEE_COLLECTOR sends the rewards to the fee collector to
+	// be distributed to the stakers
+	DestinationTypeFeeCollector DestinationType = 0
+	// DESTINATION_TYPE_COMMUNITY_POOL sends the rewards to the community pool
+	DestinationTypeCommunityPool DestinationType = 1
+	// DESTINATION_TYPE_MODULE_ACCOUNT sends the rewards to the module account
+	// named by the target
+	DestinationTypeModuleAccount DestinationType = 2
+	// DESTINATION_TYPE_ADDRESS sends the rewards to the account or contract
+	// address on the target
+	DestinationTypeAddress DestinationType = 3
+)
+
+var DestinationType_name = map[int32]string{
+	0: "DESTINATION_TYPE_FEE_COLLECTOR",
+	1: "DESTINATION_TYPE_COMMUNITY_POOL",
+	2: "DESTINATION_TYPE_MODULE_ACCOUNT",
+	3: "DESTINATION_TYPE_ADDRESS",
+}
+
+var DestinationType_value = map[string]int32{
+	"DESTINATION_TYPE_FEE_COLLECTOR":  0,
+	"DESTINATION_TYPE_COMMUNITY_POOL": 1,
+	"DESTINATION_TYPE_MODULE_ACCOUNT": 2,
+	"DESTINATION_TYPE_ADDRESS":        3,
+}
+
+func (x DestinationType) String() string {
+	return proto.EnumName(DestinationType_name, int32(x))
+}
+
+func (DestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{0}
+}
+
+// CurveType defines the shape of the release of a schedule
+type CurveType int32
+
+const (
+	// CURVE_TYPE_LINEAR releases the remaining amount linearly until the end
+	// time
+	CurveTypeLinear CurveType = 0
+	// CURVE_TYPE_EXPONENTIAL releases front-loaded amounts, halving the release
+	// rate on every half-life
+	CurveTypeExponential CurveType = 1
+	// CURVE_TYPE_STEP halves the release rate on every step interval
+	CurveTypeStep CurveType = 2
+	// CURVE_TYPE_CLIFF_LINEAR releases nothing until the cliff time, then
+	// releases linearly from the start time
+	CurveTypeCliffLinear CurveType = 3
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_LINEAR",
+	1: "CURVE_TYPE_EXPONENTIAL",
+	2: "CURVE_TYPE_STEP",
+	3: "CURVE_TYPE_CLIFF_LINEAR",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_LINEAR":       0,
+	"CURVE_TYPE_EXPONENTIAL":  1,
+	"CURVE_TYPE_STEP":         2,
+	"CURVE_TYPE_CLIFF_LINEAR": 3,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{1}
+}
+
 // ReleaseSchedule defines information related to reward distribution
 type ReleaseSchedule struct {
 	// Total amount to be rewarded
@@ -42,6 +121,13 @@ type ReleaseSchedule struct {
 	LastReleaseTime time.Time `protobuf:"bytes,5,opt,name=last_release_time,json=lastReleaseTime,proto3,stdtime" json:"last_release_time" yaml:"last_release_time"`
 	// If reward pool is active
 	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
+	// Curve used to release the total amount
+	Curve EmissionCurve `protobuf:"bytes,7,opt,name=curve,proto3" json:"curve" yaml:"curve"`
+	// Weighted destinations of the released rewards, the fee collector is used
+	// if empty
+	Destinations []ReleaseDestination `protobuf:"bytes,8,rep,name=destinations,proto3" json:"destinations" yaml:"destinations"`
+	// Total time the schedule was shifted by chain halts
+	HaltAdjustment time.Duration `protobuf:"bytes,9,opt,name=halt_adjustment,json=haltAdjustment,proto3,stdduration" json:"halt_adjustment" yaml:"halt_adjustment"`
 }
 
 func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
@@ -112,6 +198,248 @@ func (m *ReleaseSchedule) GetActive() bool {
 	return false
 }
 
+func (m *ReleaseSchedule) GetCurve() EmissionCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return EmissionCurve{}
+}
+
+func (m *ReleaseSchedule) GetDestinations() []ReleaseDestination {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *ReleaseSchedule) GetHaltAdjustment() time.Duration {
+	if m != nil {
+		return m.HaltAdjustment
+	}
+	return 0
+}
+
+// ReleaseDestination defines a weighted destination of the released rewards
+type ReleaseDestination struct {
+	// Type of the destination
+	Type DestinationType `protobuf:"varint,1,opt,name=type,proto3,enum=kiichain.rewards.v1beta1.DestinationType" json:"type,omitempty" yaml:"type"`
+	// Target is the module name or the address, empty for the fee collector and
+	// the community pool
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty" yaml:"target"`
+	// Weight of the destination, relative to the other destinations
+	Weight uint64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty" yaml:"weight"`
+}
+
+func (m *ReleaseDestination) Reset()         { *m = ReleaseDestination{} }
+func (m *ReleaseDestination) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDestination) ProtoMessage()    {}
+func (*ReleaseDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{1}
+}
+func (m *ReleaseDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDestination.Merge(m, src)
+}
+func (m *ReleaseDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDestination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseDestination proto.InternalMessageInfo
+
+func (m *ReleaseDestination) GetType() DestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return DestinationTypeFeeCollector
+}
+
+func (m *ReleaseDestination) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *ReleaseDestination) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// EmissionCurve defines the curve used to release a schedule
+type EmissionCurve struct {
+	// Type of the curve
+	Type CurveType `protobuf:"varint,1,opt,name=type,proto3,enum=kiichain.rewards.v1beta1.CurveType" json:"type,omitempty" yaml:"type"`
+	// Timestamp of the start of the curve, used by the non-linear curves
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Half-life of the exponential curve
+	HalfLife time.Duration `protobuf:"bytes,3,opt,name=half_life,json=halfLife,proto3,stdduration" json:"half_life" yaml:"half_life"`
+	// Interval between the halvings of the step curve
+	StepInterval time.Duration `protobuf:"bytes,4,opt,name=step_interval,json=stepInterval,proto3,stdduration" json:"step_interval" yaml:"step_interval"`
+	// Timestamp of the cliff of the cliff plus linear curve
+	CliffTime time.Time `protobuf:"bytes,5,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time" yaml:"cliff_time"`
+}
+
+func (m *EmissionCurve) Reset()         { *m = EmissionCurve{} }
+func (m *EmissionCurve) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurve) ProtoMessage()    {}
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{2}
+}
+func (m *EmissionCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurve.Merge(m, src)
+}
+func (m *EmissionCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurve proto.InternalMessageInfo
+
+func (m *EmissionCurve) GetType() CurveType {
+	if m != nil {
+		return m.Type
+	}
+	return CurveTypeLinear
+}
+
+func (m *EmissionCurve) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *EmissionCurve) GetHalfLife() time.Duration {
+	if m != nil {
+		return m.HalfLife
+	}
+	return 0
+}
+
+func (m *EmissionCurve) GetStepInterval() time.Duration {
+	if m != nil {
+		return m.StepInterval
+	}
+	return 0
+}
+
+func (m *EmissionCurve) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+// Schedule is a named release schedule, released alongside the other
+// schedules
+type Schedule struct {
+	// Name identifies the schedule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Release has the amounts and the release times of the schedule
+	Release ReleaseSchedule `protobuf:"bytes,2,opt,name=release,proto3" json:"release" yaml:"release"`
+	// Timestamp of the start of the release
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// If the schedule is paused
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{3}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Schedule) GetRelease() ReleaseSchedule {
+	if m != nil {
+		return m.Release
+	}
+	return ReleaseSchedule{}
+}
+
+func (m *Schedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Schedule) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // RewardPool is the global fee pool for distribution.
 type RewardPool struct {
 	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool"`
@@ -121,7 +449,7 @@ func (m *RewardPool) Reset()         { *m = RewardPool{} }
 func (m *RewardPool) String() string { return proto.CompactTextString(m) }
 func (*RewardPool) ProtoMessage()    {}
 func (*RewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_890c6773eb163743, []int{1}
+	return fileDescriptor_890c6773eb163743, []int{4}
 }
 func (m *RewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,9 +485,116 @@ func (m *RewardPool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
+// ReleaseRecord is an entry of the release history, recording the rewards
+// released to a destination on a block
+type ReleaseRecord struct {
+	// Id of the record, increasing with each release
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Height of the block of the release
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Timestamp of the block of the release
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// Name of the released schedule, empty for the main schedule
+	Schedule string `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty" yaml:"schedule"`
+	// Type of the destination receiving the rewards
+	DestinationType DestinationType `protobuf:"varint,5,opt,name=destination_type,json=destinationType,proto3,enum=kiichain.rewards.v1beta1.DestinationType" json:"destination_type,omitempty" yaml:"destination_type"`
+	// Destination target, the module name or the address
+	Destination string `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Amount released to the destination
+	Amount types.Coin `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *ReleaseRecord) Reset()         { *m = ReleaseRecord{} }
+func (m *ReleaseRecord) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRecord) ProtoMessage()    {}
+func (*ReleaseRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{5}
+}
+func (m *ReleaseRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRecord.Merge(m, src)
+}
+func (m *ReleaseRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRecord proto.InternalMessageInfo
+
+func (m *ReleaseRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReleaseRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReleaseRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseRecord) GetSchedule() string {
+	if m != nil {
+		return m.Schedule
+	}
+	return ""
+}
+
+func (m *ReleaseRecord) GetDestinationType() DestinationType {
+	if m != nil {
+		return m.DestinationType
+	}
+	return DestinationTypeFeeCollector
+}
+
+func (m *ReleaseRecord) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *ReleaseRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
+	proto.RegisterEnum("kiichain.rewards.v1beta1.DestinationType", DestinationType_name, DestinationType_value)
+	proto.RegisterEnum("kiichain.rewards.v1beta1.CurveType", CurveType_name, CurveType_value)
 	proto.RegisterType((*ReleaseSchedule)(nil), "kiichain.rewards.v1beta1.ReleaseSchedule")
+	proto.RegisterType((*ReleaseDestination)(nil), "kiichain.rewards.v1beta1.ReleaseDestination")
+	proto.RegisterType((*EmissionCurve)(nil), "kiichain.rewards.v1beta1.EmissionCurve")
+	proto.RegisterType((*Schedule)(nil), "kiichain.rewards.v1beta1.Schedule")
 	proto.RegisterType((*RewardPool)(nil), "kiichain.rewards.v1beta1.RewardPool")
+	proto.RegisterType((*ReleaseRecord)(nil), "kiichain.rewards.v1beta1.ReleaseRecord")
 }
 
 func init() {
@@ -167,38 +602,88 @@ func init() {
 }
 
 var fileDescriptor_890c6773eb163743 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0x5f, 0xf5, 0x2b, 0x27, 0x97, 0xbb, 0xaa, 0x01, 0xa1, 0x50, 0x50, 0x52, 0x45,
-	0x37, 0x94, 0x7f, 0x8e, 0xee, 0x58, 0x10, 0x1b, 0x05, 0x31, 0xa3, 0xc0, 0x02, 0x4b, 0xe5, 0x24,
-	0x26, 0xb5, 0xce, 0xce, 0x53, 0xd5, 0xce, 0x41, 0x07, 0x5e, 0x01, 0xcb, 0xbd, 0x0c, 0xc4, 0xc4,
-	0x1b, 0x60, 0xbf, 0xf1, 0x46, 0xa6, 0x1e, 0x6a, 0x07, 0xf6, 0x7b, 0x05, 0x28, 0xb6, 0x13, 0x9d,
-	0x00, 0xe9, 0x96, 0xc4, 0x7e, 0xf4, 0x7c, 0x3f, 0xdf, 0x27, 0xdf, 0xd8, 0x78, 0xff, 0x88, 0xf3,
-	0x6c, 0x4e, 0x79, 0x19, 0x2f, 0xd9, 0x07, 0xba, 0xcc, 0x55, 0x7c, 0x7c, 0x90, 0x32, 0x4d, 0x0f,
-	0x62, 0xbd, 0x5a, 0x30, 0x45, 0x16, 0x4b, 0xd0, 0xe0, 0xf9, 0x4d, 0x17, 0x71, 0x5d, 0xc4, 0x75,
-	0x8d, 0x82, 0x0c, 0x94, 0x04, 0x15, 0xa7, 0x54, 0xb1, 0x56, 0x9a, 0x01, 0x2f, 0xad, 0x72, 0x74,
-	0xb3, 0x80, 0x02, 0xcc, 0x32, 0xae, 0x57, 0xae, 0x1a, 0x16, 0x00, 0x85, 0x60, 0xb1, 0xd9, 0xa5,
-	0xd5, 0xfb, 0x58, 0x73, 0xc9, 0x94, 0xa6, 0x72, 0xe1, 0x1a, 0x86, 0x54, 0xf2, 0x12, 0x62, 0xf3,
-	0xb4, 0xa5, 0xe8, 0x7b, 0x17, 0x0f, 0x12, 0x26, 0x18, 0x55, 0xec, 0x75, 0x36, 0x67, 0x79, 0x25,
-	0x98, 0xf7, 0x16, 0x5f, 0xd7, 0xa0, 0xa9, 0x98, 0x51, 0x09, 0x55, 0xa9, 0x7d, 0x34, 0x46, 0x93,
-	0xfe, 0xe1, 0x6d, 0x62, 0x87, 0x22, 0xf5, 0x50, 0xcd, 0xa4, 0xe4, 0x39, 0xf0, 0x72, 0x7a, 0xe7,
-	0x74, 0x1d, 0x76, 0x2e, 0xd6, 0xe1, 0x8d, 0x15, 0x95, 0xe2, 0x69, 0x74, 0x59, 0x1c, 0x25, 0x7d,
-	0xb3, 0x7d, 0x66, 0x76, 0x5e, 0x8a, 0x07, 0x4b, 0xeb, 0x96, 0x37, 0xf4, 0xff, 0xae, 0xa2, 0x07,
-	0x8e, 0x7e, 0xcb, 0xd2, 0xff, 0xd0, 0x47, 0xc9, 0x5e, 0x53, 0x71, 0x1e, 0x09, 0xde, 0x61, 0x65,
-	0x3e, 0xab, 0x3f, 0xde, 0xef, 0x1a, 0xf8, 0x88, 0xd8, 0x64, 0x48, 0x93, 0x0c, 0x79, 0xd3, 0x24,
-	0xd3, 0xce, 0x3e, 0xb0, 0xf4, 0x46, 0x19, 0x9d, 0x9c, 0x87, 0x28, 0xb9, 0xc6, 0xca, 0xbc, 0x6e,
-	0xf5, 0x04, 0x1e, 0x0a, 0xaa, 0xf4, 0xcc, 0x59, 0x59, 0xf8, 0xff, 0x57, 0xc2, 0xf7, 0x1d, 0xdc,
-	0xb7, 0xf0, 0xbf, 0x10, 0xd6, 0x65, 0x50, 0xd7, 0xdd, 0x4f, 0x30, 0x6e, 0xf7, 0x70, 0x8f, 0x66,
-	0x9a, 0x1f, 0x33, 0xbf, 0x37, 0x46, 0x93, 0x9d, 0xe9, 0xf0, 0x62, 0x1d, 0xee, 0x5a, 0x84, 0xad,
-	0x47, 0x89, 0x6b, 0x88, 0x3e, 0x23, 0x8c, 0x13, 0x73, 0x7a, 0x5e, 0x01, 0x08, 0xef, 0x13, 0xde,
-	0xcb, 0x40, 0xca, 0xaa, 0xe4, 0x7a, 0x35, 0x5b, 0x00, 0x08, 0x1f, 0x8d, 0xbb, 0x93, 0xfe, 0xe1,
-	0xdd, 0x7f, 0xc6, 0xfb, 0x82, 0x65, 0x26, 0xe1, 0x27, 0xf5, 0x98, 0x5f, 0xcf, 0xc3, 0x07, 0x05,
-	0xd7, 0xf3, 0x2a, 0x25, 0x19, 0xc8, 0xd8, 0x9d, 0x40, 0xfb, 0x7a, 0xa4, 0xf2, 0x23, 0x77, 0x74,
-	0x9d, 0x46, 0x7d, 0xf9, 0xf5, 0xed, 0x3e, 0x4a, 0x76, 0x5b, 0xb7, 0xda, 0x7e, 0xfa, 0xf2, 0x74,
-	0x13, 0xa0, 0xb3, 0x4d, 0x80, 0x7e, 0x6e, 0x02, 0x74, 0xb2, 0x0d, 0x3a, 0x67, 0xdb, 0xa0, 0xf3,
-	0x63, 0x1b, 0x74, 0xde, 0x3d, 0xbc, 0x84, 0x6e, 0x2f, 0x47, 0xbb, 0xf8, 0xd8, 0xde, 0x13, 0x63,
-	0x92, 0xf6, 0x4c, 0x96, 0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x75, 0x10, 0x1e, 0x59, 0x48,
-	0x03, 0x00, 0x00,
+	// 1289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x36, 0x25, 0xf9, 0x87, 0xce, 0x96, 0x25, 0x5f, 0x5c, 0x87, 0x55, 0x12, 0x49, 0x60, 0x82,
+	0x36, 0x76, 0x53, 0x09, 0x49, 0x5b, 0x20, 0xe8, 0x26, 0x51, 0x74, 0x23, 0x40, 0x96, 0x0c, 0x4a,
+	0x2e, 0x92, 0x76, 0x60, 0x69, 0xf2, 0x24, 0xb1, 0x21, 0x79, 0x2a, 0x79, 0x72, 0xe2, 0xa1, 0x7b,
+	0xe1, 0x2e, 0xe9, 0xd6, 0xc5, 0x53, 0x3b, 0x14, 0x9d, 0x3a, 0x77, 0x2f, 0x10, 0x74, 0x0a, 0x3a,
+	0x75, 0x52, 0x8a, 0x64, 0x28, 0xba, 0xfa, 0x2f, 0x28, 0x78, 0x77, 0xa4, 0x69, 0x3a, 0x8e, 0x52,
+	0xa0, 0x8b, 0xcd, 0x7b, 0xfa, 0xbe, 0xef, 0xde, 0xaf, 0x7b, 0x77, 0xe0, 0xc6, 0x43, 0xcb, 0x32,
+	0x46, 0xba, 0xe5, 0xd6, 0x3c, 0xf4, 0x48, 0xf7, 0x4c, 0xbf, 0x76, 0x70, 0x7b, 0x1f, 0x11, 0xfd,
+	0x76, 0x8d, 0x1c, 0x8e, 0x91, 0x5f, 0x1d, 0x7b, 0x98, 0x60, 0x28, 0x86, 0xa8, 0x2a, 0x47, 0x55,
+	0x39, 0xaa, 0x58, 0x32, 0xb0, 0xef, 0x60, 0xbf, 0xb6, 0xaf, 0xfb, 0x28, 0xa2, 0x1a, 0xd8, 0x72,
+	0x19, 0xb3, 0xb8, 0x3e, 0xc4, 0x43, 0x4c, 0x3f, 0x6b, 0xc1, 0x17, 0xb7, 0x96, 0x87, 0x18, 0x0f,
+	0x6d, 0x54, 0xa3, 0xab, 0xfd, 0xc9, 0xa0, 0x46, 0x2c, 0x07, 0xf9, 0x44, 0x77, 0xc6, 0x1c, 0x50,
+	0x4a, 0x02, 0xcc, 0x89, 0xa7, 0x13, 0x0b, 0x87, 0xb2, 0x6b, 0xba, 0x63, 0xb9, 0xb8, 0x46, 0xff,
+	0x32, 0x93, 0xf4, 0xcf, 0x3c, 0xc8, 0xab, 0xc8, 0x46, 0xba, 0x8f, 0x7a, 0xc6, 0x08, 0x99, 0x13,
+	0x1b, 0xc1, 0x07, 0x60, 0x85, 0x60, 0xa2, 0xdb, 0x9a, 0xee, 0xe0, 0x89, 0x4b, 0x44, 0xa1, 0x22,
+	0xdc, 0x5c, 0xbe, 0xf3, 0x76, 0x95, 0x39, 0x5d, 0x0d, 0x9c, 0x0e, 0x23, 0xa9, 0xca, 0xd8, 0x72,
+	0x1b, 0x57, 0x9e, 0x4e, 0xcb, 0x73, 0x27, 0xd3, 0xf2, 0xa5, 0x43, 0xdd, 0xb1, 0x3f, 0x96, 0xe2,
+	0x64, 0x49, 0x5d, 0xa6, 0xcb, 0x3a, 0x5d, 0xc1, 0x7d, 0x90, 0xf7, 0xd8, 0x6e, 0x66, 0xa8, 0x9e,
+	0x9a, 0xa5, 0x5e, 0xe2, 0xea, 0x1b, 0x4c, 0x3d, 0xc1, 0x97, 0xd4, 0xd5, 0xd0, 0xc2, 0xf7, 0x50,
+	0xc1, 0x12, 0x72, 0x4d, 0x2d, 0x48, 0x8e, 0x98, 0xa6, 0xe2, 0xc5, 0x2a, 0x4b, 0x4c, 0x35, 0x4c,
+	0x4c, 0xb5, 0x1f, 0x66, 0x2e, 0xf2, 0x3d, 0xcf, 0xd4, 0x43, 0xa6, 0xf4, 0xe4, 0x79, 0x59, 0x50,
+	0x17, 0x91, 0x6b, 0x06, 0x50, 0x68, 0x83, 0x35, 0x5b, 0xf7, 0x89, 0xc6, 0xb7, 0x62, 0xe2, 0xf3,
+	0x33, 0xc5, 0x6f, 0x70, 0x71, 0x91, 0x89, 0x9f, 0x93, 0x60, 0xbb, 0xe4, 0x03, 0x3b, 0x2f, 0x02,
+	0xdd, 0x6d, 0x13, 0x2c, 0xe8, 0x06, 0xb1, 0x0e, 0x90, 0xb8, 0x50, 0x11, 0x6e, 0x2e, 0x35, 0xd6,
+	0x4e, 0xa6, 0xe5, 0x1c, 0x93, 0x60, 0x76, 0x49, 0xe5, 0x00, 0xd8, 0x03, 0xf3, 0xc6, 0xc4, 0x3b,
+	0x40, 0xe2, 0x22, 0x75, 0xe6, 0xdd, 0xea, 0x45, 0x3d, 0x57, 0x55, 0x1c, 0xcb, 0xf7, 0x2d, 0xec,
+	0xca, 0x01, 0xbc, 0xb1, 0xce, 0x3d, 0x5b, 0x61, 0xb2, 0x54, 0x43, 0x52, 0x99, 0x16, 0x74, 0xc0,
+	0x8a, 0x89, 0x7c, 0x62, 0xb9, 0xb4, 0x79, 0x7c, 0x71, 0xa9, 0x92, 0xbe, 0xb9, 0x7c, 0xe7, 0xd6,
+	0xc5, 0xda, 0xdc, 0xf9, 0xe6, 0x29, 0x29, 0xd9, 0x13, 0x71, 0x3d, 0x49, 0x3d, 0x23, 0x0f, 0x07,
+	0x20, 0x3f, 0xd2, 0x6d, 0xa2, 0xe9, 0xe6, 0x97, 0x13, 0x9f, 0x38, 0xc8, 0x25, 0x62, 0x96, 0x37,
+	0x45, 0x32, 0xb5, 0x4d, 0xde, 0xd0, 0x0d, 0xe9, 0x6c, 0x53, 0x24, 0xf8, 0xd2, 0xf7, 0x41, 0x5e,
+	0x57, 0x03, 0x6b, 0xfd, 0xd4, 0xf8, 0xab, 0x00, 0xe0, 0x79, 0x4f, 0x61, 0x07, 0x64, 0x82, 0x53,
+	0x4b, 0xdb, 0x7c, 0xf5, 0xce, 0xe6, 0xc5, 0x51, 0xc6, 0x48, 0xfd, 0xc3, 0x31, 0x6a, 0xe4, 0x4f,
+	0xa6, 0xe5, 0x65, 0xde, 0xf2, 0x87, 0x63, 0x24, 0xa9, 0x54, 0x27, 0xa8, 0x1e, 0xd1, 0xbd, 0x21,
+	0x62, 0xad, 0x9d, 0x8d, 0x57, 0x8f, 0xd9, 0x25, 0x95, 0x03, 0x02, 0xe8, 0x23, 0x64, 0x0d, 0x47,
+	0x84, 0x36, 0x6a, 0x26, 0x0e, 0x65, 0x76, 0x49, 0xe5, 0x00, 0xe9, 0xb7, 0x34, 0xc8, 0x9d, 0x29,
+	0x21, 0xbc, 0x77, 0xc6, 0xef, 0xeb, 0x17, 0xfb, 0x4d, 0xe1, 0xaf, 0xf3, 0xf8, 0x3e, 0x00, 0x3e,
+	0xd1, 0x3d, 0xc2, 0xda, 0x3a, 0x35, 0xb3, 0xad, 0xaf, 0xf1, 0xe4, 0xaf, 0x31, 0xa9, 0x53, 0x2e,
+	0xeb, 0xe7, 0x2c, 0x35, 0xd0, 0x4e, 0xee, 0x83, 0xec, 0x48, 0xb7, 0x07, 0x9a, 0x6d, 0x0d, 0xc2,
+	0xc3, 0xf8, 0x9a, 0xa2, 0x5e, 0xe5, 0xba, 0x85, 0xa8, 0xa8, 0x8c, 0xc9, 0xca, 0xb9, 0x14, 0xac,
+	0xdb, 0xd6, 0x00, 0xc1, 0x2f, 0x40, 0xce, 0x27, 0x68, 0xac, 0x59, 0x2e, 0x41, 0xde, 0x81, 0x6e,
+	0x8b, 0x99, 0x59, 0xca, 0x15, 0xae, 0xbc, 0x1e, 0x7a, 0x1c, 0x63, 0x33, 0xf5, 0x95, 0xc0, 0xd6,
+	0xe2, 0xa6, 0x20, 0x23, 0x86, 0x6d, 0x0d, 0x06, 0x6f, 0x7a, 0xd0, 0x13, 0x19, 0x39, 0xe5, 0xf2,
+	0x8c, 0x50, 0x43, 0x00, 0x97, 0xbe, 0x4b, 0x81, 0xa5, 0x68, 0xd2, 0x5e, 0x07, 0x19, 0x57, 0x77,
+	0x58, 0x09, 0xb3, 0xf1, 0xea, 0x04, 0x56, 0x49, 0xa5, 0x3f, 0xc2, 0xcf, 0xc1, 0x22, 0x9f, 0x19,
+	0xbc, 0x34, 0x9b, 0x33, 0x0f, 0x62, 0xb8, 0x41, 0x63, 0x83, 0xfb, 0xb5, 0x7a, 0x66, 0x76, 0x4a,
+	0x6a, 0xa8, 0x98, 0x28, 0x7d, 0xfa, 0x7f, 0x2c, 0xfd, 0x26, 0x58, 0x18, 0xeb, 0x13, 0x1f, 0x99,
+	0xe2, 0x7c, 0x72, 0x88, 0x31, 0xbb, 0xa4, 0x72, 0x80, 0xf4, 0xad, 0x00, 0x80, 0x4a, 0x23, 0xd9,
+	0xc5, 0xd8, 0x86, 0x5f, 0x83, 0x55, 0x03, 0x3b, 0xce, 0xc4, 0xb5, 0xc8, 0xa1, 0x36, 0xc6, 0xd8,
+	0x16, 0x05, 0x3a, 0x80, 0xae, 0xbe, 0xf2, 0x8e, 0x68, 0x22, 0x83, 0x5e, 0x13, 0x77, 0x03, 0xcf,
+	0x7e, 0x7e, 0x5e, 0x7e, 0x6f, 0x68, 0x91, 0xd1, 0x64, 0xbf, 0x6a, 0x60, 0xa7, 0xc6, 0xaf, 0x59,
+	0xf6, 0xef, 0x7d, 0xdf, 0x7c, 0xc8, 0xef, 0x67, 0xce, 0xf1, 0x7f, 0xfa, 0xfb, 0x97, 0x2d, 0x41,
+	0xcd, 0x45, 0xbb, 0x05, 0xdb, 0x4b, 0xbf, 0xa7, 0x41, 0x8e, 0xe7, 0x51, 0x45, 0x06, 0xf6, 0x4c,
+	0x78, 0x0d, 0xa4, 0x2c, 0x93, 0x16, 0x29, 0xd3, 0xc8, 0x9d, 0x4c, 0xcb, 0x59, 0x16, 0x86, 0x65,
+	0x4a, 0x6a, 0xca, 0x32, 0x83, 0x48, 0x47, 0xec, 0x14, 0x07, 0xf5, 0x49, 0xc7, 0x23, 0x1d, 0x85,
+	0xa7, 0x98, 0x7d, 0xc0, 0x4f, 0x40, 0xe6, 0x0d, 0x13, 0x7d, 0x99, 0x27, 0x3a, 0x3c, 0xae, 0x51,
+	0x8a, 0xa9, 0x00, 0xac, 0x81, 0x25, 0x9f, 0x17, 0x99, 0x76, 0x7f, 0xb6, 0x71, 0xe9, 0xf4, 0x12,
+	0x0b, 0x7f, 0x91, 0xd4, 0x08, 0x04, 0xbf, 0x02, 0x85, 0xd8, 0xd0, 0xd5, 0xe8, 0xe4, 0x98, 0xff,
+	0xaf, 0x13, 0xef, 0xca, 0xc9, 0xb4, 0x7c, 0xf9, 0xdc, 0x40, 0xd7, 0xd8, 0x2c, 0xc9, 0x9b, 0x67,
+	0xd1, 0xf0, 0x2e, 0x58, 0x8e, 0x99, 0xe8, 0x5d, 0x96, 0x6d, 0x6c, 0x9c, 0x4c, 0xcb, 0xf0, 0x9c,
+	0x84, 0xa4, 0xc6, 0xa1, 0xf0, 0x1e, 0x58, 0xe0, 0xaf, 0x83, 0xc5, 0x59, 0xaf, 0x83, 0xb7, 0x78,
+	0x9e, 0xc2, 0xfb, 0x91, 0x3f, 0x0a, 0x38, 0x7f, 0xeb, 0xc7, 0x14, 0xc8, 0x27, 0xa2, 0x80, 0x32,
+	0x28, 0x35, 0x95, 0x5e, 0xbf, 0xd5, 0xa9, 0xf7, 0x5b, 0xdd, 0x8e, 0xd6, 0x7f, 0xb0, 0xab, 0x68,
+	0xdb, 0x8a, 0xa2, 0xc9, 0xdd, 0x76, 0x5b, 0x91, 0xfb, 0x5d, 0xb5, 0x30, 0x57, 0x2c, 0x1f, 0x1d,
+	0x57, 0xae, 0x24, 0x88, 0xdb, 0x08, 0xc9, 0xd8, 0xb6, 0x91, 0x41, 0xb0, 0x07, 0x15, 0x50, 0x3e,
+	0x27, 0x22, 0x77, 0x77, 0x76, 0xf6, 0x3a, 0xad, 0xfe, 0x03, 0x6d, 0xb7, 0xdb, 0x6d, 0x17, 0x84,
+	0x62, 0xe5, 0xe8, 0xb8, 0x72, 0x35, 0xa1, 0x22, 0xc7, 0x9b, 0xed, 0x95, 0x32, 0x3b, 0xdd, 0xe6,
+	0x5e, 0x5b, 0xd1, 0xea, 0xb2, 0xdc, 0xdd, 0xeb, 0xf4, 0x0b, 0xa9, 0x57, 0xca, 0xec, 0xe0, 0xa0,
+	0xac, 0x75, 0xc3, 0xa0, 0x6f, 0x9e, 0xbb, 0x40, 0x3c, 0x27, 0x53, 0x6f, 0x36, 0x55, 0xa5, 0xd7,
+	0x2b, 0xa4, 0x8b, 0xc5, 0xa3, 0xe3, 0xca, 0x46, 0x82, 0x5f, 0x37, 0x4d, 0x0f, 0xf9, 0x7e, 0x31,
+	0xf3, 0xcd, 0x0f, 0xa5, 0xb9, 0xad, 0x3f, 0x04, 0x90, 0x8d, 0xae, 0x09, 0xb8, 0x05, 0xd6, 0xe4,
+	0x3d, 0xf5, 0x53, 0x85, 0xe9, 0xb4, 0x5b, 0x1d, 0xa5, 0x1e, 0xe4, 0xe4, 0xd2, 0xd1, 0x71, 0x25,
+	0x1f, 0xa1, 0xda, 0x96, 0x8b, 0x74, 0x0f, 0x7e, 0x08, 0x36, 0x62, 0x58, 0xe5, 0xfe, 0x6e, 0xb7,
+	0xa3, 0x74, 0xfa, 0xad, 0x7a, 0x10, 0xbe, 0x78, 0x74, 0x5c, 0x59, 0x8f, 0x08, 0xca, 0xe3, 0x31,
+	0x76, 0x91, 0x4b, 0x2c, 0xdd, 0x86, 0xef, 0x80, 0x7c, 0x8c, 0xd5, 0xeb, 0x2b, 0xbb, 0x85, 0x54,
+	0x71, 0xed, 0xe8, 0xb8, 0x92, 0x8b, 0xe0, 0x3d, 0x82, 0xc6, 0xf0, 0x23, 0x70, 0x39, 0x86, 0x93,
+	0xdb, 0xad, 0xed, 0xed, 0xd0, 0x9f, 0x74, 0x42, 0x5e, 0x0e, 0x46, 0x2c, 0x73, 0x8a, 0x05, 0xd5,
+	0xd8, 0x7e, 0xfa, 0xa2, 0x24, 0x3c, 0x7b, 0x51, 0x12, 0xfe, 0x7a, 0x51, 0x12, 0x9e, 0xbc, 0x2c,
+	0xcd, 0x3d, 0x7b, 0x59, 0x9a, 0xfb, 0xf3, 0x65, 0x69, 0xee, 0xb3, 0x5b, 0xb1, 0x19, 0x11, 0x3d,
+	0xe5, 0xa3, 0x8f, 0xc7, 0xd1, 0xab, 0x9e, 0x4e, 0x8b, 0xfd, 0x05, 0x7a, 0x3c, 0x3f, 0xf8, 0x77,
+	0x00, 0x89, 0x8c, 0x49, 0xe3, 0xf6, 0x0b, 0x00, 0x00,
 }
 
 func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
@@ -221,6 +706,38 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HaltAdjustment, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HaltAdjustment):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Curve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.Active {
 		i--
 		if m.Active {
@@ -231,20 +748,20 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -270,7 +787,7 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+func (m *ReleaseDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -280,35 +797,263 @@ func (m *RewardPool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReleaseDestination) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReleaseDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CommunityPool) > 0 {
-		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Weight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
+func (m *EmissionCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StepInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.HalfLife, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalfLife):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Release.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DestinationType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DestinationType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Schedule) > 0 {
+		i -= len(m.Schedule)
+		copy(dAtA[i:], m.Schedule)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schedule)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -335,6 +1080,75 @@ func (m *ReleaseSchedule) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	l = m.Curve.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HaltAdjustment)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ReleaseDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTypes(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *EmissionCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.HalfLife)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StepInterval)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Release.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -350,16 +1164,669 @@ func (m *RewardPool) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ReleaseRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Schedule)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DestinationType != 0 {
+		n += 1 + sovTypes(uint64(m.DestinationType))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Curve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, ReleaseDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HaltAdjustment, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.HalfLife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StepInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+func (m *Schedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -382,17 +1849,17 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -402,28 +1869,27 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Release", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -450,13 +1916,13 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Release.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -483,15 +1949,15 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastReleaseTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -501,30 +1967,67 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -534,12 +2037,26 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -561,7 +2078,7 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardPool) Unmarshal(dAtA []byte) error {
+func (m *ReleaseRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -584,15 +2101,53 @@ func (m *RewardPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -619,8 +2174,123 @@ func (m *RewardPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			m.DestinationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationType |= DestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex